@@ -20,6 +20,29 @@ const (
 	// OpenstackFloatingIPPoolReadyCondition reports on the current status of the floating ip pool. Ready indicates that the pool is ready to be used.
 	OpenstackFloatingIPPoolReadyCondition = "OpenstackFloatingIPPoolReadyCondition"
 
+	// OpenstackFloatingIPPoolMinAvailableIPsCondition reports whether the pool has at least MinAvailableIPs available floating IPs.
+	OpenstackFloatingIPPoolMinAvailableIPsCondition = "OpenstackFloatingIPPoolMinAvailableIPsCondition"
+
 	// MaxIPsReachedReason is set when the maximum number of floating IPs has been reached.
 	MaxIPsReachedReason = "MaxIPsReached"
+	// QuotaExceededReason is set when the floating IP quota of the project has been exhausted.
+	QuotaExceededReason = "QuotaExceeded"
+)
+
+const (
+	// OpenStackFixedIPPoolReadyCondition reports on the current status of the fixed ip pool. Ready indicates that the pool is ready to be used.
+	OpenStackFixedIPPoolReadyCondition = "OpenStackFixedIPPoolReadyCondition"
+
+	// PoolExhaustedReason is set when the fixed ip pool has no free address left.
+	PoolExhaustedReason = "PoolExhausted"
+)
+
+const (
+	// OpenStackImageReadyCondition reports on the current status of the glance image. Ready indicates that the image is active and can be used.
+	OpenStackImageReadyCondition = "OpenStackImageReadyCondition"
+
+	// ImageImportingReason is set while glance is importing the image content.
+	ImageImportingReason = "ImageImporting"
+	// ImageImportFailedReason is set when glance failed to import the image content.
+	ImageImportFailedReason = "ImageImportFailed"
 )
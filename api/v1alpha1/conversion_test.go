@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	testhelpers "sigs.k8s.io/cluster-api-provider-openstack/test/helpers"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+	// The test already ignores the data annotation added on up-conversion.
+	// Also ignore the data annotation added on down-conversion.
+	ignoreDataAnnotation := func(hub conversion.Hub) {
+		obj := hub.(metav1.Object)
+		delete(obj.GetAnnotations(), utilconversion.DataAnnotation)
+	}
+
+	filterInvalidTags := func(tags []infrav1.NeutronTag) []infrav1.NeutronTag {
+		var ret []infrav1.NeutronTag
+		for i := range tags {
+			s := string(tags[i])
+			if len(s) > 0 && !strings.Contains(s, ",") {
+				ret = append(ret, tags[i])
+			}
+		}
+		return ret
+	}
+
+	fuzzerFuncs := func(_ runtimeserializer.CodecFactory) []interface{} {
+		return []interface{}{
+			// v1alpha2 filter tags cannot contain commas and can't be empty.
+
+			func(filter *infrav1.SubnetFilter, c fuzz.Continue) {
+				c.FuzzNoCustom(filter)
+
+				filter.Tags = filterInvalidTags(filter.Tags)
+				filter.TagsAny = filterInvalidTags(filter.TagsAny)
+				filter.NotTags = filterInvalidTags(filter.NotTags)
+				filter.NotTagsAny = filterInvalidTags(filter.NotTagsAny)
+			},
+
+			func(filter *infrav1.NetworkFilter, c fuzz.Continue) {
+				c.FuzzNoCustom(filter)
+
+				filter.Tags = filterInvalidTags(filter.Tags)
+				filter.TagsAny = filterInvalidTags(filter.TagsAny)
+				filter.NotTags = filterInvalidTags(filter.NotTags)
+				filter.NotTagsAny = filterInvalidTags(filter.NotTagsAny)
+			},
+		}
+	}
+
+	t.Run("for OpenStackFloatingIPPool", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:              &infrav1alpha2.OpenStackFloatingIPPool{},
+		Spoke:            &OpenStackFloatingIPPool{},
+		HubAfterMutation: ignoreDataAnnotation,
+		FuzzerFuncs:      []fuzzer.FuzzerFuncs{fuzzerFuncs},
+	}))
+
+	t.Run("for OpenStackFloatingIPPool with mutate", testhelpers.FuzzMutateTestFunc(testhelpers.FuzzMutateTestFuncInput{
+		FuzzTestFuncInput: utilconversion.FuzzTestFuncInput{
+			Hub:              &infrav1alpha2.OpenStackFloatingIPPool{},
+			Spoke:            &OpenStackFloatingIPPool{},
+			HubAfterMutation: ignoreDataAnnotation,
+			FuzzerFuncs:      []fuzzer.FuzzerFuncs{fuzzerFuncs},
+		},
+		MutateFuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzerFuncs},
+	}))
+}
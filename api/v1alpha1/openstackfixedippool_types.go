@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	// See openstackfloatingippool_types.go for why v1alpha7 is used here.
+	infrav1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
+)
+
+const (
+	// OpenStackFixedIPPoolFinalizer allows ReconcileOpenStackFixedIPPool to clean up resources associated with OpenStackFixedIPPool before
+	// removing it from the apiserver.
+	OpenStackFixedIPPoolFinalizer = "openstackfixedippool.infrastructure.cluster.x-k8s.io"
+
+	OpenStackFixedIPPoolNameIndex = "spec.poolRef.name.openstackfixedippool"
+
+	// DeleteFixedIPFinalizer allows ReconcileOpenStackFixedIPPool to release the reservation of the fixed ip of an IPAddress
+	// before removing it from the apiserver.
+	DeleteFixedIPFinalizer = "openstackfixedippool.infrastructure.cluster.x-k8s.io/delete-fixed-ip"
+)
+
+// OpenStackFixedIPPoolSpec defines the desired state of OpenStackFixedIPPool.
+type OpenStackFixedIPPoolSpec struct {
+	// IdentityRef is a reference to a identity to be used when reconciling this pool.
+	// +optional
+	IdentityRef *infrav1alpha7.OpenStackIdentityReference `json:"identityRef,omitempty"`
+
+	// The name of the cloud to use from the clouds secret
+	// +optional
+	CloudName string `json:"cloudName"`
+
+	// Network is the network the fixed ips are reserved in. The filter must match exactly one network.
+	// +required
+	Network infrav1alpha7.NetworkFilter `json:"network"`
+
+	// Subnet is the subnet of Network the fixed ips are reserved in. The filter must match exactly one subnet.
+	// +required
+	Subnet infrav1alpha7.SubnetFilter `json:"subnet"`
+
+	// IPRanges restricts the fixed ips of the pool to the given address ranges, which must be within Subnet.
+	// If empty, fixed ips are allocated from the whole subnet.
+	// +optional
+	IPRanges []IPRange `json:"ipRanges,omitempty"`
+
+	// MaxIPs is the maximum number of fixed ips that can be allocated from this pool, if nil there is no limit.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MaxIPs *int `json:"maxIPs,omitempty"`
+}
+
+// OpenStackFixedIPPoolStatus defines the observed state of OpenStackFixedIPPool.
+type OpenStackFixedIPPoolStatus struct {
+	// ClaimedIPs contains the fixed ips allocated to an IPAddressClaim.
+	// +kubebuilder:default={}
+	// +optional
+	ClaimedIPs []string `json:"claimedIPs"`
+
+	// Network contains information about the network the fixed ips are reserved in.
+	// +optional
+	Network *infrav1alpha7.NetworkStatus `json:"network,omitempty"`
+
+	// Subnet contains information about the subnet the fixed ips are reserved in.
+	// +optional
+	Subnet *infrav1alpha7.Subnet `json:"subnet,omitempty"`
+
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// OpenStackFixedIPPool is the Schema for the openstackfixedippools API. It is an IPAM provider which reserves fixed
+// ips of a Neutron subnet with ports and allocates them to IPAddressClaims.
+type OpenStackFixedIPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackFixedIPPoolSpec   `json:"spec,omitempty"`
+	Status OpenStackFixedIPPoolStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OpenStackFixedIPPoolList contains a list of OpenStackFixedIPPool.
+type OpenStackFixedIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackFixedIPPool `json:"items"`
+}
+
+// GetConditions returns the observations of the operational state of the OpenStackFixedIPPool resource.
+func (r *OpenStackFixedIPPool) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackFixedIPPool to the predescribed clusterv1.Conditions.
+func (r *OpenStackFixedIPPool) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// GetFixedIPTag returns the tag of the ports reserving the fixed ips of the pool.
+func (r *OpenStackFixedIPPool) GetFixedIPTag() string {
+	return fmt.Sprintf("cluster-api-provider-openstack-fixed-ip-pool-%s", r.Name)
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackFixedIPPool{}, &OpenStackFixedIPPoolList{})
+}
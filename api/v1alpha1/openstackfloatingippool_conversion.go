@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
+	infrav1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/conversion"
+)
+
+var _ ctrlconversion.Convertible = &OpenStackFloatingIPPool{}
+
+func (r *OpenStackFloatingIPPool) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*infrav1alpha2.OpenStackFloatingIPPool)
+
+	return conversion.ConvertAndRestore(
+		r, dst,
+		Convert_v1alpha1_OpenStackFloatingIPPool_To_v1alpha2_OpenStackFloatingIPPool, Convert_v1alpha2_OpenStackFloatingIPPool_To_v1alpha1_OpenStackFloatingIPPool,
+		v1alpha1OpenStackFloatingIPPoolRestorer, v1alpha2OpenStackFloatingIPPoolRestorer,
+	)
+}
+
+func (r *OpenStackFloatingIPPool) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*infrav1alpha2.OpenStackFloatingIPPool)
+
+	return conversion.ConvertAndRestore(
+		src, r,
+		Convert_v1alpha2_OpenStackFloatingIPPool_To_v1alpha1_OpenStackFloatingIPPool, Convert_v1alpha1_OpenStackFloatingIPPool_To_v1alpha2_OpenStackFloatingIPPool,
+		v1alpha2OpenStackFloatingIPPoolRestorer, v1alpha1OpenStackFloatingIPPoolRestorer,
+	)
+}
+
+var _ ctrlconversion.Convertible = &OpenStackFloatingIPPoolList{}
+
+func (r *OpenStackFloatingIPPoolList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*infrav1alpha2.OpenStackFloatingIPPoolList)
+	return Convert_v1alpha1_OpenStackFloatingIPPoolList_To_v1alpha2_OpenStackFloatingIPPoolList(r, dst, nil)
+}
+
+func (r *OpenStackFloatingIPPoolList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*infrav1alpha2.OpenStackFloatingIPPoolList)
+	return Convert_v1alpha2_OpenStackFloatingIPPoolList_To_v1alpha1_OpenStackFloatingIPPoolList(src, r, nil)
+}
+
+/* Restorers */
+
+var v1alpha1OpenStackFloatingIPPoolRestorer = conversion.RestorerFor[*OpenStackFloatingIPPool]{
+	"spec": conversion.HashedFieldRestorer(
+		func(c *OpenStackFloatingIPPool) *OpenStackFloatingIPPoolSpec {
+			return &c.Spec
+		},
+		restorev1alpha1FloatingIPPoolSpec,
+	),
+}
+
+var v1alpha2OpenStackFloatingIPPoolRestorer = conversion.RestorerFor[*infrav1alpha2.OpenStackFloatingIPPool]{}
+
+/* OpenStackFloatingIPPoolSpec */
+
+func restorev1alpha1FloatingIPPoolSpec(previous *OpenStackFloatingIPPoolSpec, dst *OpenStackFloatingIPPoolSpec) {
+	// Conversion to v1alpha2 removes the Kind field, and
+	// CloudName is only kept if IdentityRef is set
+	dst.IdentityRef = previous.IdentityRef
+	dst.CloudName = previous.CloudName
+
+	restorev1alpha1NetworkFilter(&previous.FloatingIPNetwork, &dst.FloatingIPNetwork)
+	if len(dst.FloatingIPSubnets) == len(previous.FloatingIPSubnets) {
+		for i := range dst.FloatingIPSubnets {
+			restorev1alpha1SubnetFilter(&previous.FloatingIPSubnets[i], &dst.FloatingIPSubnets[i])
+		}
+	}
+}
+
+func Convert_v1alpha1_OpenStackFloatingIPPoolSpec_To_v1alpha2_OpenStackFloatingIPPoolSpec(in *OpenStackFloatingIPPoolSpec, out *infrav1alpha2.OpenStackFloatingIPPoolSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_OpenStackFloatingIPPoolSpec_To_v1alpha2_OpenStackFloatingIPPoolSpec(in, out, s); err != nil {
+		return err
+	}
+
+	if out.IdentityRef != nil {
+		out.IdentityRef.CloudName = in.CloudName
+	}
+	return nil
+}
+
+func Convert_v1alpha2_OpenStackFloatingIPPoolSpec_To_v1alpha1_OpenStackFloatingIPPoolSpec(in *infrav1alpha2.OpenStackFloatingIPPoolSpec, out *OpenStackFloatingIPPoolSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha2_OpenStackFloatingIPPoolSpec_To_v1alpha1_OpenStackFloatingIPPoolSpec(in, out, s); err != nil {
+		return err
+	}
+
+	if in.IdentityRef != nil {
+		out.CloudName = in.IdentityRef.CloudName
+	}
+	return nil
+}
+
+/* NetworkFilter */
+
+func restorev1alpha1NetworkFilter(previous *infrav1alpha7.NetworkFilter, dst *infrav1alpha7.NetworkFilter) {
+	// The edge cases with multiple commas are too tricky in this direction,
+	// so we just restore the whole thing.
+	dst.Tags = previous.Tags
+	dst.TagsAny = previous.TagsAny
+	dst.NotTags = previous.NotTags
+	dst.NotTagsAny = previous.NotTagsAny
+}
+
+/* SubnetFilter */
+
+func restorev1alpha1SubnetFilter(previous *infrav1alpha7.SubnetFilter, dst *infrav1alpha7.SubnetFilter) {
+	// The edge cases with multiple commas are too tricky in this direction,
+	// so we just restore the whole thing.
+	dst.Tags = previous.Tags
+	dst.TagsAny = previous.TagsAny
+	dst.NotTags = previous.NotTags
+	dst.NotTagsAny = previous.NotTagsAny
+}
@@ -61,6 +61,12 @@ type OpenStackFloatingIPPoolSpec struct {
 	// +optional
 	MaxIPs *int `json:"maxIPs,omitempty"`
 
+	// MinAvailableIPs is the minimum number of floating ips which the pool keeps available for new claims.
+	// Floating ips are allocated in advance to maintain this reserve, within the limit set by MaxIPs.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MinAvailableIPs *int `json:"minAvailableIPs,omitempty"`
+
 	// IdentityRef is a reference to a identity to be used when reconciling this pool.
 	// +optional
 	IdentityRef *infrav1alpha7.OpenStackIdentityReference `json:"identityRef,omitempty"`
@@ -69,6 +75,20 @@ type OpenStackFloatingIPPoolSpec struct {
 	// +optional
 	FloatingIPNetwork infrav1alpha7.NetworkFilter `json:"floatingIPNetwork"`
 
+	// FloatingIPSubnets restricts the floating ips of the pool to the subnets of FloatingIPNetwork matching one of the filters.
+	// The IP family of the floating ips can be selected with the ipVersion of the filters.
+	// If empty, floating ips are allocated from any subnet of FloatingIPNetwork.
+	// +optional
+	FloatingIPSubnets []infrav1alpha7.SubnetFilter `json:"floatingIPSubnets,omitempty"`
+
+	// IPRanges restricts the floating ips of the pool to the given address ranges.
+	// If empty, floating ips are allocated from the whole subnets.
+	// Floating ips are then created with a specific address, which the default
+	// Neutron policy only allows to admins, so the credentials of the pool
+	// usually need the admin role.
+	// +optional
+	IPRanges []IPRange `json:"ipRanges,omitempty"`
+
 	// The name of the cloud to use from the clouds secret
 	// +optional
 	CloudName string `json:"cloudName"`
@@ -79,6 +99,17 @@ type OpenStackFloatingIPPoolSpec struct {
 	ReclaimPolicy ReclaimPolicy `json:"reclaimPolicy"`
 }
 
+// IPRange is an inclusive range of IP addresses.
+type IPRange struct {
+	// Start is the lowest IP address of the range.
+	// +required
+	Start string `json:"start"`
+
+	// End is the highest IP address of the range.
+	// +required
+	End string `json:"end"`
+}
+
 // OpenStackFloatingIPPoolStatus defines the observed state of OpenStackFloatingIPPool.
 type OpenStackFloatingIPPoolStatus struct {
 	// +kubebuilder:default={}
@@ -97,11 +128,38 @@ type OpenStackFloatingIPPoolStatus struct {
 	// +optional
 	FloatingIPNetwork *infrav1alpha7.NetworkStatus `json:"floatingIPNetwork,omitempty"`
 
+	// floatingIPSubnets contains the subnets matching FloatingIPSubnets which floating ips are allocated from
+	// +optional
+	FloatingIPSubnets []infrav1alpha7.Subnet `json:"floatingIPSubnets,omitempty"`
+
+	// Statistics contains the number of floating ips in the pool and the remaining floating ip quota of the project.
+	// +optional
+	Statistics *OpenStackFloatingIPPoolStatistics `json:"statistics,omitempty"`
+
 	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
 }
 
+// OpenStackFloatingIPPoolStatistics contains the number of floating ips in the pool by state.
+type OpenStackFloatingIPPoolStatistics struct {
+	// TotalIPs is the number of claimed and available floating ips.
+	TotalIPs int `json:"totalIPs"`
+
+	// ClaimedIPs is the number of floating ips claimed by an IPAddressClaim.
+	ClaimedIPs int `json:"claimedIPs"`
+
+	// AvailableIPs is the number of floating ips available for new claims.
+	AvailableIPs int `json:"availableIPs"`
+
+	// FailedIPs is the number of floating ips that failed to be allocated.
+	FailedIPs int `json:"failedIPs"`
+
+	// RemainingQuota is the number of floating ips which can still be created in the project.
+	// It is not set if the floating ip quota of the project is unlimited or cannot be retrieved.
+	// +optional
+	RemainingQuota *int `json:"remainingQuota,omitempty"`
+}
+
 //+kubebuilder:object:root=true
-// +kubebuilder:storageversion
 //+kubebuilder:subresource:status
 
 // OpenStackFloatingIPPool is the Schema for the openstackfloatingippools API.
@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	// We use v1alpha7 here rather than anything newer because as of writing
+	// it is the newest API version we should no longer be making breaking
+	// changes to. If we bump this we need to look carefully for resulting
+	// CRD changes in v1alpha1 to ensure they are compatible.
+	infrav1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
+)
+
+const (
+	// OpenStackImageFinalizer allows ReconcileOpenStackImage to clean up the glance image associated with OpenStackImage before
+	// removing it from the apiserver.
+	OpenStackImageFinalizer = "openstackimage.infrastructure.cluster.x-k8s.io"
+)
+
+// ImageImportMethod describes how the image content is transferred into glance.
+type ImageImportMethod string
+
+const (
+	// ImageImportMethodWebDownload instructs glance to download the image content itself using the web-download import method.
+	ImageImportMethodWebDownload ImageImportMethod = "WebDownload"
+	// ImageImportMethodUpload instructs the controller to download the image content and upload it to glance using the
+	// glance-direct import method. This is useful when glance cannot reach the source URL. The download trusts the CA
+	// certificates of the identity and runs in the background of the controller.
+	ImageImportMethodUpload ImageImportMethod = "Upload"
+)
+
+// ImageContent describes the content of a glance image.
+type ImageContent struct {
+	// ContainerFormat is the format of the image container.
+	// +kubebuilder:validation:Enum=bare;ovf;aki;ari;ami;ova;docker
+	// +kubebuilder:default=bare
+	// +optional
+	ContainerFormat string `json:"containerFormat,omitempty"`
+
+	// DiskFormat is the format of the disk image.
+	// +kubebuilder:validation:Enum=raw;qcow2;vmdk;vhd;vhdx;vdi;iso;ploop;aki;ari;ami
+	// +kubebuilder:validation:Required
+	DiskFormat string `json:"diskFormat"`
+
+	// URL is the location the image content is downloaded from.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+
+	// ImportMethod determines how the image content is transferred into glance.
+	// +kubebuilder:validation:Enum=WebDownload;Upload
+	// +kubebuilder:default=WebDownload
+	// +optional
+	ImportMethod ImageImportMethod `json:"importMethod,omitempty"`
+}
+
+// ImageProperty is an additional property set on a glance image.
+type ImageProperty struct {
+	// Name is the name of the property.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Value is the value of the property.
+	Value string `json:"value"`
+}
+
+// OpenStackImageSpec defines the desired state of OpenStackImage.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type OpenStackImageSpec struct {
+	// Name is the name of the glance image. If not specified the name of
+	// the OpenStackImage object is used.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Content describes the content of the image and where it is imported from.
+	Content ImageContent `json:"content"`
+
+	// Properties is a list of additional properties set on the image.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Properties []ImageProperty `json:"properties,omitempty"`
+
+	// Tags is a list of tags set on the image.
+	// +listType=set
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// IdentityRef is a reference to a identity to be used when reconciling this image.
+	// +optional
+	IdentityRef *infrav1alpha7.OpenStackIdentityReference `json:"identityRef,omitempty"`
+
+	// The name of the cloud to use from the clouds secret
+	// +optional
+	CloudName string `json:"cloudName,omitempty"`
+
+	// The strategy to use for the glance image when the OpenStackImage is deleted.
+	// +kubebuilder:validation:Enum=Retain;Delete
+	// +kubebuilder:default=Delete
+	// +optional
+	ReclaimPolicy ReclaimPolicy `json:"reclaimPolicy,omitempty"`
+}
+
+// OpenStackImageStatus defines the observed state of OpenStackImage.
+type OpenStackImageStatus struct {
+	// ImageID is the ID of the glance image.
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+
+	// ImageStatus is the status of the glance image.
+	// +optional
+	ImageStatus string `json:"imageStatus,omitempty"`
+
+	// Ready is true when the glance image is active and can be used.
+	// +optional
+	Ready bool `json:"ready"`
+
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Image is active in glance"
+// +kubebuilder:printcolumn:name="ImageID",type="string",JSONPath=".status.imageID",description="Glance image ID"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of OpenStackImage"
+
+// OpenStackImage is the Schema for the openstackimages API.
+type OpenStackImage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackImageSpec   `json:"spec,omitempty"`
+	Status OpenStackImageStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OpenStackImageList contains a list of OpenStackImage.
+type OpenStackImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackImage `json:"items"`
+}
+
+// GetConditions returns the observations of the operational state of the OpenStackImage resource.
+func (r *OpenStackImage) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackImage to the predescribed clusterv1.Conditions.
+func (r *OpenStackImage) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// GetImageName returns the name of the glance image.
+func (r *OpenStackImage) GetImageName() string {
+	if r.Spec.Name != "" {
+		return r.Spec.Name
+	}
+	return r.Name
+}
+
+// GetImageTag returns the tag used to identify the glance image created for this OpenStackImage.
+// It uses the namespace and name rather than the UID, which changes when the object is moved.
+func (r *OpenStackImage) GetImageTag() string {
+	return fmt.Sprintf("cluster-api-provider-openstack-image-%s/%s", r.Namespace, r.Name)
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackImage{}, &OpenStackImageList{})
+}
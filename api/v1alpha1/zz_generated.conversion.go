@@ -0,0 +1,324 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
+	v1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
+	v1beta1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	apiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*IPRange)(nil), (*v1alpha2.IPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPRange_To_v1alpha2_IPRange(a.(*IPRange), b.(*v1alpha2.IPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPRange)(nil), (*IPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPRange_To_v1alpha1_IPRange(a.(*v1alpha2.IPRange), b.(*IPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenStackFloatingIPPool)(nil), (*v1alpha2.OpenStackFloatingIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpenStackFloatingIPPool_To_v1alpha2_OpenStackFloatingIPPool(a.(*OpenStackFloatingIPPool), b.(*v1alpha2.OpenStackFloatingIPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.OpenStackFloatingIPPool)(nil), (*OpenStackFloatingIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_OpenStackFloatingIPPool_To_v1alpha1_OpenStackFloatingIPPool(a.(*v1alpha2.OpenStackFloatingIPPool), b.(*OpenStackFloatingIPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenStackFloatingIPPoolList)(nil), (*v1alpha2.OpenStackFloatingIPPoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpenStackFloatingIPPoolList_To_v1alpha2_OpenStackFloatingIPPoolList(a.(*OpenStackFloatingIPPoolList), b.(*v1alpha2.OpenStackFloatingIPPoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.OpenStackFloatingIPPoolList)(nil), (*OpenStackFloatingIPPoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_OpenStackFloatingIPPoolList_To_v1alpha1_OpenStackFloatingIPPoolList(a.(*v1alpha2.OpenStackFloatingIPPoolList), b.(*OpenStackFloatingIPPoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenStackFloatingIPPoolStatistics)(nil), (*v1alpha2.OpenStackFloatingIPPoolStatistics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpenStackFloatingIPPoolStatistics_To_v1alpha2_OpenStackFloatingIPPoolStatistics(a.(*OpenStackFloatingIPPoolStatistics), b.(*v1alpha2.OpenStackFloatingIPPoolStatistics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.OpenStackFloatingIPPoolStatistics)(nil), (*OpenStackFloatingIPPoolStatistics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_OpenStackFloatingIPPoolStatistics_To_v1alpha1_OpenStackFloatingIPPoolStatistics(a.(*v1alpha2.OpenStackFloatingIPPoolStatistics), b.(*OpenStackFloatingIPPoolStatistics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenStackFloatingIPPoolStatus)(nil), (*v1alpha2.OpenStackFloatingIPPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpenStackFloatingIPPoolStatus_To_v1alpha2_OpenStackFloatingIPPoolStatus(a.(*OpenStackFloatingIPPoolStatus), b.(*v1alpha2.OpenStackFloatingIPPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.OpenStackFloatingIPPoolStatus)(nil), (*OpenStackFloatingIPPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_OpenStackFloatingIPPoolStatus_To_v1alpha1_OpenStackFloatingIPPoolStatus(a.(*v1alpha2.OpenStackFloatingIPPoolStatus), b.(*OpenStackFloatingIPPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*OpenStackFloatingIPPoolSpec)(nil), (*v1alpha2.OpenStackFloatingIPPoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpenStackFloatingIPPoolSpec_To_v1alpha2_OpenStackFloatingIPPoolSpec(a.(*OpenStackFloatingIPPoolSpec), b.(*v1alpha2.OpenStackFloatingIPPoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha2.OpenStackFloatingIPPoolSpec)(nil), (*OpenStackFloatingIPPoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_OpenStackFloatingIPPoolSpec_To_v1alpha1_OpenStackFloatingIPPoolSpec(a.(*v1alpha2.OpenStackFloatingIPPoolSpec), b.(*OpenStackFloatingIPPoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_IPRange_To_v1alpha2_IPRange(in *IPRange, out *v1alpha2.IPRange, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha1_IPRange_To_v1alpha2_IPRange is an autogenerated conversion function.
+func Convert_v1alpha1_IPRange_To_v1alpha2_IPRange(in *IPRange, out *v1alpha2.IPRange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPRange_To_v1alpha2_IPRange(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPRange_To_v1alpha1_IPRange(in *v1alpha2.IPRange, out *IPRange, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha2_IPRange_To_v1alpha1_IPRange is an autogenerated conversion function.
+func Convert_v1alpha2_IPRange_To_v1alpha1_IPRange(in *v1alpha2.IPRange, out *IPRange, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPRange_To_v1alpha1_IPRange(in, out, s)
+}
+
+func autoConvert_v1alpha1_OpenStackFloatingIPPool_To_v1alpha2_OpenStackFloatingIPPool(in *OpenStackFloatingIPPool, out *v1alpha2.OpenStackFloatingIPPool, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_OpenStackFloatingIPPoolSpec_To_v1alpha2_OpenStackFloatingIPPoolSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_OpenStackFloatingIPPoolStatus_To_v1alpha2_OpenStackFloatingIPPoolStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_OpenStackFloatingIPPool_To_v1alpha2_OpenStackFloatingIPPool is an autogenerated conversion function.
+func Convert_v1alpha1_OpenStackFloatingIPPool_To_v1alpha2_OpenStackFloatingIPPool(in *OpenStackFloatingIPPool, out *v1alpha2.OpenStackFloatingIPPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OpenStackFloatingIPPool_To_v1alpha2_OpenStackFloatingIPPool(in, out, s)
+}
+
+func autoConvert_v1alpha2_OpenStackFloatingIPPool_To_v1alpha1_OpenStackFloatingIPPool(in *v1alpha2.OpenStackFloatingIPPool, out *OpenStackFloatingIPPool, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_OpenStackFloatingIPPoolSpec_To_v1alpha1_OpenStackFloatingIPPoolSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_OpenStackFloatingIPPoolStatus_To_v1alpha1_OpenStackFloatingIPPoolStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_OpenStackFloatingIPPool_To_v1alpha1_OpenStackFloatingIPPool is an autogenerated conversion function.
+func Convert_v1alpha2_OpenStackFloatingIPPool_To_v1alpha1_OpenStackFloatingIPPool(in *v1alpha2.OpenStackFloatingIPPool, out *OpenStackFloatingIPPool, s conversion.Scope) error {
+	return autoConvert_v1alpha2_OpenStackFloatingIPPool_To_v1alpha1_OpenStackFloatingIPPool(in, out, s)
+}
+
+func autoConvert_v1alpha1_OpenStackFloatingIPPoolList_To_v1alpha2_OpenStackFloatingIPPoolList(in *OpenStackFloatingIPPoolList, out *v1alpha2.OpenStackFloatingIPPoolList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha2.OpenStackFloatingIPPool, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_OpenStackFloatingIPPool_To_v1alpha2_OpenStackFloatingIPPool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_OpenStackFloatingIPPoolList_To_v1alpha2_OpenStackFloatingIPPoolList is an autogenerated conversion function.
+func Convert_v1alpha1_OpenStackFloatingIPPoolList_To_v1alpha2_OpenStackFloatingIPPoolList(in *OpenStackFloatingIPPoolList, out *v1alpha2.OpenStackFloatingIPPoolList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OpenStackFloatingIPPoolList_To_v1alpha2_OpenStackFloatingIPPoolList(in, out, s)
+}
+
+func autoConvert_v1alpha2_OpenStackFloatingIPPoolList_To_v1alpha1_OpenStackFloatingIPPoolList(in *v1alpha2.OpenStackFloatingIPPoolList, out *OpenStackFloatingIPPoolList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackFloatingIPPool, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_OpenStackFloatingIPPool_To_v1alpha1_OpenStackFloatingIPPool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_OpenStackFloatingIPPoolList_To_v1alpha1_OpenStackFloatingIPPoolList is an autogenerated conversion function.
+func Convert_v1alpha2_OpenStackFloatingIPPoolList_To_v1alpha1_OpenStackFloatingIPPoolList(in *v1alpha2.OpenStackFloatingIPPoolList, out *OpenStackFloatingIPPoolList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_OpenStackFloatingIPPoolList_To_v1alpha1_OpenStackFloatingIPPoolList(in, out, s)
+}
+
+func autoConvert_v1alpha1_OpenStackFloatingIPPoolSpec_To_v1alpha2_OpenStackFloatingIPPoolSpec(in *OpenStackFloatingIPPoolSpec, out *v1alpha2.OpenStackFloatingIPPoolSpec, s conversion.Scope) error {
+	out.PreAllocatedFloatingIPs = *(*[]string)(unsafe.Pointer(&in.PreAllocatedFloatingIPs))
+	out.MaxIPs = (*int)(unsafe.Pointer(in.MaxIPs))
+	out.MinAvailableIPs = (*int)(unsafe.Pointer(in.MinAvailableIPs))
+	if in.IdentityRef != nil {
+		in, out := &in.IdentityRef, &out.IdentityRef
+		*out = new(v1beta1.OpenStackIdentityReference)
+		if err := v1alpha7.Convert_v1alpha7_OpenStackIdentityReference_To_v1beta1_OpenStackIdentityReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IdentityRef = nil
+	}
+	if err := v1alpha7.Convert_v1alpha7_NetworkFilter_To_v1beta1_NetworkFilter(&in.FloatingIPNetwork, &out.FloatingIPNetwork, s); err != nil {
+		return err
+	}
+	if in.FloatingIPSubnets != nil {
+		in, out := &in.FloatingIPSubnets, &out.FloatingIPSubnets
+		*out = make([]v1beta1.SubnetFilter, len(*in))
+		for i := range *in {
+			if err := v1alpha7.Convert_v1alpha7_SubnetFilter_To_v1beta1_SubnetFilter(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FloatingIPSubnets = nil
+	}
+	out.IPRanges = *(*[]v1alpha2.IPRange)(unsafe.Pointer(&in.IPRanges))
+	// WARNING: in.CloudName requires manual conversion: does not exist in peer-type
+	out.ReclaimPolicy = v1alpha2.ReclaimPolicy(in.ReclaimPolicy)
+	return nil
+}
+
+func autoConvert_v1alpha2_OpenStackFloatingIPPoolSpec_To_v1alpha1_OpenStackFloatingIPPoolSpec(in *v1alpha2.OpenStackFloatingIPPoolSpec, out *OpenStackFloatingIPPoolSpec, s conversion.Scope) error {
+	out.PreAllocatedFloatingIPs = *(*[]string)(unsafe.Pointer(&in.PreAllocatedFloatingIPs))
+	out.MaxIPs = (*int)(unsafe.Pointer(in.MaxIPs))
+	out.MinAvailableIPs = (*int)(unsafe.Pointer(in.MinAvailableIPs))
+	if in.IdentityRef != nil {
+		in, out := &in.IdentityRef, &out.IdentityRef
+		*out = new(v1alpha7.OpenStackIdentityReference)
+		if err := v1alpha7.Convert_v1beta1_OpenStackIdentityReference_To_v1alpha7_OpenStackIdentityReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IdentityRef = nil
+	}
+	if err := v1alpha7.Convert_v1beta1_NetworkFilter_To_v1alpha7_NetworkFilter(&in.FloatingIPNetwork, &out.FloatingIPNetwork, s); err != nil {
+		return err
+	}
+	if in.FloatingIPSubnets != nil {
+		in, out := &in.FloatingIPSubnets, &out.FloatingIPSubnets
+		*out = make([]v1alpha7.SubnetFilter, len(*in))
+		for i := range *in {
+			if err := v1alpha7.Convert_v1beta1_SubnetFilter_To_v1alpha7_SubnetFilter(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FloatingIPSubnets = nil
+	}
+	out.IPRanges = *(*[]IPRange)(unsafe.Pointer(&in.IPRanges))
+	out.ReclaimPolicy = ReclaimPolicy(in.ReclaimPolicy)
+	return nil
+}
+
+func autoConvert_v1alpha1_OpenStackFloatingIPPoolStatistics_To_v1alpha2_OpenStackFloatingIPPoolStatistics(in *OpenStackFloatingIPPoolStatistics, out *v1alpha2.OpenStackFloatingIPPoolStatistics, s conversion.Scope) error {
+	out.TotalIPs = in.TotalIPs
+	out.ClaimedIPs = in.ClaimedIPs
+	out.AvailableIPs = in.AvailableIPs
+	out.FailedIPs = in.FailedIPs
+	out.RemainingQuota = (*int)(unsafe.Pointer(in.RemainingQuota))
+	return nil
+}
+
+// Convert_v1alpha1_OpenStackFloatingIPPoolStatistics_To_v1alpha2_OpenStackFloatingIPPoolStatistics is an autogenerated conversion function.
+func Convert_v1alpha1_OpenStackFloatingIPPoolStatistics_To_v1alpha2_OpenStackFloatingIPPoolStatistics(in *OpenStackFloatingIPPoolStatistics, out *v1alpha2.OpenStackFloatingIPPoolStatistics, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OpenStackFloatingIPPoolStatistics_To_v1alpha2_OpenStackFloatingIPPoolStatistics(in, out, s)
+}
+
+func autoConvert_v1alpha2_OpenStackFloatingIPPoolStatistics_To_v1alpha1_OpenStackFloatingIPPoolStatistics(in *v1alpha2.OpenStackFloatingIPPoolStatistics, out *OpenStackFloatingIPPoolStatistics, s conversion.Scope) error {
+	out.TotalIPs = in.TotalIPs
+	out.ClaimedIPs = in.ClaimedIPs
+	out.AvailableIPs = in.AvailableIPs
+	out.FailedIPs = in.FailedIPs
+	out.RemainingQuota = (*int)(unsafe.Pointer(in.RemainingQuota))
+	return nil
+}
+
+// Convert_v1alpha2_OpenStackFloatingIPPoolStatistics_To_v1alpha1_OpenStackFloatingIPPoolStatistics is an autogenerated conversion function.
+func Convert_v1alpha2_OpenStackFloatingIPPoolStatistics_To_v1alpha1_OpenStackFloatingIPPoolStatistics(in *v1alpha2.OpenStackFloatingIPPoolStatistics, out *OpenStackFloatingIPPoolStatistics, s conversion.Scope) error {
+	return autoConvert_v1alpha2_OpenStackFloatingIPPoolStatistics_To_v1alpha1_OpenStackFloatingIPPoolStatistics(in, out, s)
+}
+
+func autoConvert_v1alpha1_OpenStackFloatingIPPoolStatus_To_v1alpha2_OpenStackFloatingIPPoolStatus(in *OpenStackFloatingIPPoolStatus, out *v1alpha2.OpenStackFloatingIPPoolStatus, s conversion.Scope) error {
+	out.ClaimedIPs = *(*[]string)(unsafe.Pointer(&in.ClaimedIPs))
+	out.AvailableIPs = *(*[]string)(unsafe.Pointer(&in.AvailableIPs))
+	out.FailedIPs = *(*[]string)(unsafe.Pointer(&in.FailedIPs))
+	out.FloatingIPNetwork = (*v1beta1.NetworkStatus)(unsafe.Pointer(in.FloatingIPNetwork))
+	out.FloatingIPSubnets = *(*[]v1beta1.Subnet)(unsafe.Pointer(&in.FloatingIPSubnets))
+	out.Statistics = (*v1alpha2.OpenStackFloatingIPPoolStatistics)(unsafe.Pointer(in.Statistics))
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_OpenStackFloatingIPPoolStatus_To_v1alpha2_OpenStackFloatingIPPoolStatus is an autogenerated conversion function.
+func Convert_v1alpha1_OpenStackFloatingIPPoolStatus_To_v1alpha2_OpenStackFloatingIPPoolStatus(in *OpenStackFloatingIPPoolStatus, out *v1alpha2.OpenStackFloatingIPPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OpenStackFloatingIPPoolStatus_To_v1alpha2_OpenStackFloatingIPPoolStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_OpenStackFloatingIPPoolStatus_To_v1alpha1_OpenStackFloatingIPPoolStatus(in *v1alpha2.OpenStackFloatingIPPoolStatus, out *OpenStackFloatingIPPoolStatus, s conversion.Scope) error {
+	out.ClaimedIPs = *(*[]string)(unsafe.Pointer(&in.ClaimedIPs))
+	out.AvailableIPs = *(*[]string)(unsafe.Pointer(&in.AvailableIPs))
+	out.FailedIPs = *(*[]string)(unsafe.Pointer(&in.FailedIPs))
+	out.FloatingIPNetwork = (*v1alpha7.NetworkStatus)(unsafe.Pointer(in.FloatingIPNetwork))
+	out.FloatingIPSubnets = *(*[]v1alpha7.Subnet)(unsafe.Pointer(&in.FloatingIPSubnets))
+	out.Statistics = (*OpenStackFloatingIPPoolStatistics)(unsafe.Pointer(in.Statistics))
+	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha2_OpenStackFloatingIPPoolStatus_To_v1alpha1_OpenStackFloatingIPPoolStatus is an autogenerated conversion function.
+func Convert_v1alpha2_OpenStackFloatingIPPoolStatus_To_v1alpha1_OpenStackFloatingIPPoolStatus(in *v1alpha2.OpenStackFloatingIPPoolStatus, out *OpenStackFloatingIPPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_OpenStackFloatingIPPoolStatus_To_v1alpha1_OpenStackFloatingIPPoolStatus(in, out, s)
+}
@@ -26,6 +26,179 @@ import (
 	"sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRange) DeepCopyInto(out *IPRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRange.
+func (in *IPRange) DeepCopy() *IPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageContent) DeepCopyInto(out *ImageContent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageContent.
+func (in *ImageContent) DeepCopy() *ImageContent {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageProperty) DeepCopyInto(out *ImageProperty) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageProperty.
+func (in *ImageProperty) DeepCopy() *ImageProperty {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageProperty)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackFixedIPPool) DeepCopyInto(out *OpenStackFixedIPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFixedIPPool.
+func (in *OpenStackFixedIPPool) DeepCopy() *OpenStackFixedIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackFixedIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackFixedIPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackFixedIPPoolList) DeepCopyInto(out *OpenStackFixedIPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackFixedIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFixedIPPoolList.
+func (in *OpenStackFixedIPPoolList) DeepCopy() *OpenStackFixedIPPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackFixedIPPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackFixedIPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackFixedIPPoolSpec) DeepCopyInto(out *OpenStackFixedIPPoolSpec) {
+	*out = *in
+	if in.IdentityRef != nil {
+		in, out := &in.IdentityRef, &out.IdentityRef
+		*out = new(v1alpha7.OpenStackIdentityReference)
+		**out = **in
+	}
+	out.Network = in.Network
+	out.Subnet = in.Subnet
+	if in.IPRanges != nil {
+		in, out := &in.IPRanges, &out.IPRanges
+		*out = make([]IPRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxIPs != nil {
+		in, out := &in.MaxIPs, &out.MaxIPs
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFixedIPPoolSpec.
+func (in *OpenStackFixedIPPoolSpec) DeepCopy() *OpenStackFixedIPPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackFixedIPPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackFixedIPPoolStatus) DeepCopyInto(out *OpenStackFixedIPPoolStatus) {
+	*out = *in
+	if in.ClaimedIPs != nil {
+		in, out := &in.ClaimedIPs, &out.ClaimedIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(v1alpha7.NetworkStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(v1alpha7.Subnet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFixedIPPoolStatus.
+func (in *OpenStackFixedIPPoolStatus) DeepCopy() *OpenStackFixedIPPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackFixedIPPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackFloatingIPPool) DeepCopyInto(out *OpenStackFloatingIPPool) {
 	*out = *in
@@ -98,12 +271,27 @@ func (in *OpenStackFloatingIPPoolSpec) DeepCopyInto(out *OpenStackFloatingIPPool
 		*out = new(int)
 		**out = **in
 	}
+	if in.MinAvailableIPs != nil {
+		in, out := &in.MinAvailableIPs, &out.MinAvailableIPs
+		*out = new(int)
+		**out = **in
+	}
 	if in.IdentityRef != nil {
 		in, out := &in.IdentityRef, &out.IdentityRef
 		*out = new(v1alpha7.OpenStackIdentityReference)
 		**out = **in
 	}
 	out.FloatingIPNetwork = in.FloatingIPNetwork
+	if in.FloatingIPSubnets != nil {
+		in, out := &in.FloatingIPSubnets, &out.FloatingIPSubnets
+		*out = make([]v1alpha7.SubnetFilter, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPRanges != nil {
+		in, out := &in.IPRanges, &out.IPRanges
+		*out = make([]IPRange, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFloatingIPPoolSpec.
@@ -116,6 +304,26 @@ func (in *OpenStackFloatingIPPoolSpec) DeepCopy() *OpenStackFloatingIPPoolSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackFloatingIPPoolStatistics) DeepCopyInto(out *OpenStackFloatingIPPoolStatistics) {
+	*out = *in
+	if in.RemainingQuota != nil {
+		in, out := &in.RemainingQuota, &out.RemainingQuota
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFloatingIPPoolStatistics.
+func (in *OpenStackFloatingIPPoolStatistics) DeepCopy() *OpenStackFloatingIPPoolStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackFloatingIPPoolStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackFloatingIPPoolStatus) DeepCopyInto(out *OpenStackFloatingIPPoolStatus) {
 	*out = *in
@@ -139,6 +347,18 @@ func (in *OpenStackFloatingIPPoolStatus) DeepCopyInto(out *OpenStackFloatingIPPo
 		*out = new(v1alpha7.NetworkStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FloatingIPSubnets != nil {
+		in, out := &in.FloatingIPSubnets, &out.FloatingIPSubnets
+		*out = make([]v1alpha7.Subnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(OpenStackFloatingIPPoolStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -157,3 +377,115 @@ func (in *OpenStackFloatingIPPoolStatus) DeepCopy() *OpenStackFloatingIPPoolStat
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackImage) DeepCopyInto(out *OpenStackImage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackImage.
+func (in *OpenStackImage) DeepCopy() *OpenStackImage {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackImage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackImageList) DeepCopyInto(out *OpenStackImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackImageList.
+func (in *OpenStackImageList) DeepCopy() *OpenStackImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackImageSpec) DeepCopyInto(out *OpenStackImageSpec) {
+	*out = *in
+	out.Content = in.Content
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]ImageProperty, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IdentityRef != nil {
+		in, out := &in.IdentityRef, &out.IdentityRef
+		*out = new(v1alpha7.OpenStackIdentityReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackImageSpec.
+func (in *OpenStackImageSpec) DeepCopy() *OpenStackImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackImageStatus) DeepCopyInto(out *OpenStackImageStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackImageStatus.
+func (in *OpenStackImageStatus) DeepCopy() *OpenStackImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
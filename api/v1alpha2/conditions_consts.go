@@ -0,0 +1,33 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+const (
+	// OpenstackFloatingIPPoolReadyCondition reports on the current status of the floating ip pool. Ready indicates that the pool is ready to be used.
+	OpenstackFloatingIPPoolReadyCondition = "OpenstackFloatingIPPoolReadyCondition"
+
+	// OpenstackFloatingIPPoolMinAvailableIPsCondition reports whether the pool has at least MinAvailableIPs available floating IPs.
+	OpenstackFloatingIPPoolMinAvailableIPsCondition = "OpenstackFloatingIPPoolMinAvailableIPsCondition"
+
+	// MaxIPsReachedReason is set when the maximum number of floating IPs has been reached.
+	MaxIPsReachedReason = "MaxIPsReached"
+	// QuotaExceededReason is set when the floating IP quota of the project has been exhausted.
+	QuotaExceededReason = "QuotaExceeded"
+	// FloatingIPAddressForbiddenReason is set when the credentials of the pool are not allowed to
+	// create floating IPs with a specific address, which is required by the IP ranges of the pool.
+	FloatingIPAddressForbiddenReason = "FloatingIPAddressForbidden"
+)
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// OpenStackClusterFloatingIPPoolFinalizer allows ReconcileOpenStackFloatingIPPool to clean up resources associated with
+	// OpenStackClusterFloatingIPPool before removing it from the apiserver.
+	OpenStackClusterFloatingIPPoolFinalizer = "openstackclusterfloatingippool.infrastructure.cluster.x-k8s.io"
+
+	OpenStackClusterFloatingIPPoolNameIndex = "spec.poolRef.name.openstackclusterfloatingippool"
+)
+
+// OpenStackClusterFloatingIPPoolSpec defines the desired state of OpenStackClusterFloatingIPPool.
+type OpenStackClusterFloatingIPPoolSpec struct {
+	OpenStackFloatingIPPoolSpec `json:",inline"`
+
+	// IdentityRefNamespace is the namespace of the secret referenced by IdentityRef.
+	// It must be set if IdentityRef is set, because OpenStackClusterFloatingIPPool is not namespaced.
+	// +optional
+	IdentityRefNamespace string `json:"identityRefNamespace,omitempty"`
+
+	// NamespaceSelector restricts the namespaces whose IPAddressClaims are served by the pool to the namespaces
+	// matching the selector. If not set, no IPAddressClaims are served. An empty selector matches all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:subresource:status
+
+// OpenStackClusterFloatingIPPool is the Schema for the openstackclusterfloatingippools API. It is a cluster-scoped
+// floating ip pool which serves IPAddressClaims from any namespace allowed by its NamespaceSelector.
+type OpenStackClusterFloatingIPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackClusterFloatingIPPoolSpec `json:"spec,omitempty"`
+	Status OpenStackFloatingIPPoolStatus      `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OpenStackClusterFloatingIPPoolList contains a list of OpenStackClusterFloatingIPPool.
+type OpenStackClusterFloatingIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackClusterFloatingIPPool `json:"items"`
+}
+
+var _ FloatingIPPool = &OpenStackClusterFloatingIPPool{}
+
+// GetConditions returns the observations of the operational state of the OpenStackClusterFloatingIPPool resource.
+func (r *OpenStackClusterFloatingIPPool) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackClusterFloatingIPPool to the predescribed clusterv1.Conditions.
+func (r *OpenStackClusterFloatingIPPool) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// GetFloatingIPPoolSpec returns the spec of the OpenStackClusterFloatingIPPool shared with OpenStackFloatingIPPool.
+func (r *OpenStackClusterFloatingIPPool) GetFloatingIPPoolSpec() *OpenStackFloatingIPPoolSpec {
+	return &r.Spec.OpenStackFloatingIPPoolSpec
+}
+
+// GetFloatingIPPoolStatus returns the status of the OpenStackClusterFloatingIPPool.
+func (r *OpenStackClusterFloatingIPPool) GetFloatingIPPoolStatus() *OpenStackFloatingIPPoolStatus {
+	return &r.Status
+}
+
+// GetIdentityRefNamespace returns the namespace of the secret referenced by IdentityRef.
+func (r *OpenStackClusterFloatingIPPool) GetIdentityRefNamespace() string {
+	return r.Spec.IdentityRefNamespace
+}
+
+func (r *OpenStackClusterFloatingIPPool) GetFloatingIPTag() string {
+	return fmt.Sprintf("cluster-api-provider-openstack-cluster-fip-pool-%s", r.Name)
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackClusterFloatingIPPool{}, &OpenStackClusterFloatingIPPoolList{})
+}
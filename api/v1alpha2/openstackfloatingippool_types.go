@@ -0,0 +1,231 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+const (
+	// OpenStackFloatingIPPoolFinalizer allows ReconcileOpenStackFloatingIPPool to clean up resources associated with OpenStackFloatingIPPool before
+	// removing it from the apiserver.
+	OpenStackFloatingIPPoolFinalizer = "openstackfloatingippool.infrastructure.cluster.x-k8s.io"
+
+	OpenStackFloatingIPPoolNameIndex = "spec.poolRef.name"
+
+	// OpenStackFloatingIPPoolIP.
+	DeleteFloatingIPFinalizer = "openstackfloatingippool.infrastructure.cluster.x-k8s.io/delete-floating-ip"
+)
+
+// ReclaimPolicy is a string type alias to represent reclaim policies for floating ips.
+type ReclaimPolicy string
+
+const (
+	// ReclaimDelete is the reclaim policy for floating ips.
+	ReclaimDelete ReclaimPolicy = "Delete"
+	// ReclaimRetain is the reclaim policy for floating ips.
+	ReclaimRetain ReclaimPolicy = "Retain"
+)
+
+// OpenStackFloatingIPPoolSpec defines the desired state of OpenStackFloatingIPPool.
+type OpenStackFloatingIPPoolSpec struct {
+	// PreAllocatedFloatingIPs is a list of floating IPs precreated in OpenStack that should be used by this pool.
+	// These are used before allocating new ones and are not deleted from OpenStack when the pool is deleted.
+	PreAllocatedFloatingIPs []string `json:"preAllocatedFloatingIPs,omitempty"`
+
+	// MaxIPs is the maximum number of floating ips that can be allocated from this pool, if nil there is no limit.
+	// If set, the pool will stop allocating floating ips when it reaches this number of ClaimedIPs.
+	// +optional
+	MaxIPs *int `json:"maxIPs,omitempty"`
+
+	// MinAvailableIPs is the minimum number of floating ips which the pool keeps available for new claims.
+	// Floating ips are allocated in advance to maintain this reserve, within the limit set by MaxIPs.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MinAvailableIPs *int `json:"minAvailableIPs,omitempty"`
+
+	// IdentityRef is a reference to a secret holding OpenStack credentials
+	// to be used when reconciling this pool.
+	// +optional
+	IdentityRef *infrav1.OpenStackIdentityReference `json:"identityRef,omitempty"`
+
+	// FloatingIPNetwork is the external network to use for floating ips, if there's only one external network it will be used by default
+	// +optional
+	FloatingIPNetwork infrav1.NetworkFilter `json:"floatingIPNetwork"`
+
+	// FloatingIPSubnets restricts the floating ips of the pool to the subnets of FloatingIPNetwork matching one of the filters.
+	// The IP family of the floating ips can be selected with the ipVersion of the filters.
+	// If empty, floating ips are allocated from any subnet of FloatingIPNetwork.
+	// +optional
+	FloatingIPSubnets []infrav1.SubnetFilter `json:"floatingIPSubnets,omitempty"`
+
+	// IPRanges restricts the floating ips of the pool to the given address ranges.
+	// If empty, floating ips are allocated from the whole subnets.
+	// Floating ips are then created with a specific address, which the default
+	// Neutron policy only allows to admins, so the credentials of the pool
+	// usually need the admin role.
+	// +optional
+	IPRanges []IPRange `json:"ipRanges,omitempty"`
+
+	// The stratergy to use for reclaiming floating ips when they are released from a machine
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Retain;Delete
+	ReclaimPolicy ReclaimPolicy `json:"reclaimPolicy"`
+}
+
+// IPRange is an inclusive range of IP addresses.
+type IPRange struct {
+	// Start is the lowest IP address of the range.
+	// +required
+	Start string `json:"start"`
+
+	// End is the highest IP address of the range.
+	// +required
+	End string `json:"end"`
+}
+
+// OpenStackFloatingIPPoolStatus defines the observed state of OpenStackFloatingIPPool.
+type OpenStackFloatingIPPoolStatus struct {
+	// +kubebuilder:default={}
+	// +optional
+	ClaimedIPs []string `json:"claimedIPs"`
+
+	// +kubebuilder:default={}
+	// +optional
+	AvailableIPs []string `json:"availableIPs"`
+
+	// FailedIPs contains a list of floating ips that failed to be allocated
+	// +optional
+	FailedIPs []string `json:"failedIPs,omitempty"`
+
+	// floatingIPNetwork contains information about the network used for floating ips
+	// +optional
+	FloatingIPNetwork *infrav1.NetworkStatus `json:"floatingIPNetwork,omitempty"`
+
+	// floatingIPSubnets contains the subnets matching FloatingIPSubnets which floating ips are allocated from
+	// +optional
+	FloatingIPSubnets []infrav1.Subnet `json:"floatingIPSubnets,omitempty"`
+
+	// Statistics contains the number of floating ips in the pool and the remaining floating ip quota of the project.
+	// +optional
+	Statistics *OpenStackFloatingIPPoolStatistics `json:"statistics,omitempty"`
+
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// OpenStackFloatingIPPoolStatistics contains the number of floating ips in the pool by state.
+type OpenStackFloatingIPPoolStatistics struct {
+	// TotalIPs is the number of claimed and available floating ips.
+	TotalIPs int `json:"totalIPs"`
+
+	// ClaimedIPs is the number of floating ips claimed by an IPAddressClaim.
+	ClaimedIPs int `json:"claimedIPs"`
+
+	// AvailableIPs is the number of floating ips available for new claims.
+	AvailableIPs int `json:"availableIPs"`
+
+	// FailedIPs is the number of floating ips that failed to be allocated.
+	FailedIPs int `json:"failedIPs"`
+
+	// RemainingQuota is the number of floating ips which can still be created in the project.
+	// It is not set if the floating ip quota of the project is unlimited or cannot be retrieved.
+	// +optional
+	RemainingQuota *int `json:"remainingQuota,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+// +kubebuilder:storageversion
+//+kubebuilder:subresource:status
+
+// OpenStackFloatingIPPool is the Schema for the openstackfloatingippools API.
+type OpenStackFloatingIPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackFloatingIPPoolSpec   `json:"spec,omitempty"`
+	Status OpenStackFloatingIPPoolStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OpenStackFloatingIPPoolList contains a list of OpenStackFloatingIPPool.
+type OpenStackFloatingIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackFloatingIPPool `json:"items"`
+}
+
+// FloatingIPPool is implemented by OpenStackFloatingIPPool and
+// OpenStackClusterFloatingIPPool, so that both can be reconciled the same way.
+// +kubebuilder:object:generate=false
+type FloatingIPPool interface {
+	metav1.Object
+	runtime.Object
+
+	GetConditions() clusterv1.Conditions
+	SetConditions(clusterv1.Conditions)
+
+	// GetFloatingIPPoolSpec returns the spec of the pool.
+	GetFloatingIPPoolSpec() *OpenStackFloatingIPPoolSpec
+	// GetFloatingIPPoolStatus returns the status of the pool.
+	GetFloatingIPPoolStatus() *OpenStackFloatingIPPoolStatus
+	// GetIdentityRefNamespace returns the namespace of the secret referenced by the IdentityRef of the pool.
+	GetIdentityRefNamespace() string
+	// GetFloatingIPTag returns the tag of the floating ips allocated by the pool.
+	GetFloatingIPTag() string
+}
+
+var _ FloatingIPPool = &OpenStackFloatingIPPool{}
+
+// GetConditions returns the observations of the operational state of the OpenStackFloatingIPPool resource.
+func (r *OpenStackFloatingIPPool) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackFloatingIPPool to the predescribed clusterv1.Conditions.
+func (r *OpenStackFloatingIPPool) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// GetFloatingIPPoolSpec returns the spec of the OpenStackFloatingIPPool.
+func (r *OpenStackFloatingIPPool) GetFloatingIPPoolSpec() *OpenStackFloatingIPPoolSpec {
+	return &r.Spec
+}
+
+// GetFloatingIPPoolStatus returns the status of the OpenStackFloatingIPPool.
+func (r *OpenStackFloatingIPPool) GetFloatingIPPoolStatus() *OpenStackFloatingIPPoolStatus {
+	return &r.Status
+}
+
+// GetIdentityRefNamespace returns the namespace of the OpenStackFloatingIPPool, which holds the secret referenced by IdentityRef.
+func (r *OpenStackFloatingIPPool) GetIdentityRefNamespace() string {
+	return r.Namespace
+}
+
+func (r *OpenStackFloatingIPPool) GetFloatingIPTag() string {
+	return fmt.Sprintf("cluster-api-provider-openstack-fip-pool-%s", r.Name)
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackFloatingIPPool{}, &OpenStackFloatingIPPoolList{})
+}
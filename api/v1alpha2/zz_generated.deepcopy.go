@@ -0,0 +1,304 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	apiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRange) DeepCopyInto(out *IPRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRange.
+func (in *IPRange) DeepCopy() *IPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackClusterFloatingIPPool) DeepCopyInto(out *OpenStackClusterFloatingIPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackClusterFloatingIPPool.
+func (in *OpenStackClusterFloatingIPPool) DeepCopy() *OpenStackClusterFloatingIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackClusterFloatingIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackClusterFloatingIPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackClusterFloatingIPPoolList) DeepCopyInto(out *OpenStackClusterFloatingIPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackClusterFloatingIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackClusterFloatingIPPoolList.
+func (in *OpenStackClusterFloatingIPPoolList) DeepCopy() *OpenStackClusterFloatingIPPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackClusterFloatingIPPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackClusterFloatingIPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackClusterFloatingIPPoolSpec) DeepCopyInto(out *OpenStackClusterFloatingIPPoolSpec) {
+	*out = *in
+	in.OpenStackFloatingIPPoolSpec.DeepCopyInto(&out.OpenStackFloatingIPPoolSpec)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackClusterFloatingIPPoolSpec.
+func (in *OpenStackClusterFloatingIPPoolSpec) DeepCopy() *OpenStackClusterFloatingIPPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackClusterFloatingIPPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackFloatingIPPool) DeepCopyInto(out *OpenStackFloatingIPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFloatingIPPool.
+func (in *OpenStackFloatingIPPool) DeepCopy() *OpenStackFloatingIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackFloatingIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackFloatingIPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackFloatingIPPoolList) DeepCopyInto(out *OpenStackFloatingIPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackFloatingIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFloatingIPPoolList.
+func (in *OpenStackFloatingIPPoolList) DeepCopy() *OpenStackFloatingIPPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackFloatingIPPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackFloatingIPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackFloatingIPPoolSpec) DeepCopyInto(out *OpenStackFloatingIPPoolSpec) {
+	*out = *in
+	if in.PreAllocatedFloatingIPs != nil {
+		in, out := &in.PreAllocatedFloatingIPs, &out.PreAllocatedFloatingIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxIPs != nil {
+		in, out := &in.MaxIPs, &out.MaxIPs
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinAvailableIPs != nil {
+		in, out := &in.MinAvailableIPs, &out.MinAvailableIPs
+		*out = new(int)
+		**out = **in
+	}
+	if in.IdentityRef != nil {
+		in, out := &in.IdentityRef, &out.IdentityRef
+		*out = new(v1beta1.OpenStackIdentityReference)
+		**out = **in
+	}
+	in.FloatingIPNetwork.DeepCopyInto(&out.FloatingIPNetwork)
+	if in.FloatingIPSubnets != nil {
+		in, out := &in.FloatingIPSubnets, &out.FloatingIPSubnets
+		*out = make([]v1beta1.SubnetFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IPRanges != nil {
+		in, out := &in.IPRanges, &out.IPRanges
+		*out = make([]IPRange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFloatingIPPoolSpec.
+func (in *OpenStackFloatingIPPoolSpec) DeepCopy() *OpenStackFloatingIPPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackFloatingIPPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackFloatingIPPoolStatistics) DeepCopyInto(out *OpenStackFloatingIPPoolStatistics) {
+	*out = *in
+	if in.RemainingQuota != nil {
+		in, out := &in.RemainingQuota, &out.RemainingQuota
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFloatingIPPoolStatistics.
+func (in *OpenStackFloatingIPPoolStatistics) DeepCopy() *OpenStackFloatingIPPoolStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackFloatingIPPoolStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackFloatingIPPoolStatus) DeepCopyInto(out *OpenStackFloatingIPPoolStatus) {
+	*out = *in
+	if in.ClaimedIPs != nil {
+		in, out := &in.ClaimedIPs, &out.ClaimedIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AvailableIPs != nil {
+		in, out := &in.AvailableIPs, &out.AvailableIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailedIPs != nil {
+		in, out := &in.FailedIPs, &out.FailedIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FloatingIPNetwork != nil {
+		in, out := &in.FloatingIPNetwork, &out.FloatingIPNetwork
+		*out = new(v1beta1.NetworkStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FloatingIPSubnets != nil {
+		in, out := &in.FloatingIPSubnets, &out.FloatingIPSubnets
+		*out = make([]v1beta1.Subnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(OpenStackFloatingIPPoolStatistics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(apiv1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFloatingIPPoolStatus.
+func (in *OpenStackFloatingIPPoolStatus) DeepCopy() *OpenStackFloatingIPPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackFloatingIPPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -381,6 +381,11 @@ func Convert_v1alpha5_OpenStackMachineSpec_To_v1beta1_OpenStackMachineSpec(in *O
 	return nil
 }
 
+func Convert_v1beta1_FixedIP_To_v1alpha5_FixedIP(in *infrav1.FixedIP, out *FixedIP, s conversion.Scope) error {
+	// IPAddressPoolRef has been added in v1beta1 but has no equivalent in v1alpha5
+	return autoConvert_v1beta1_FixedIP_To_v1alpha5_FixedIP(in, out, s)
+}
+
 func Convert_v1beta1_APIServerLoadBalancer_To_v1alpha5_APIServerLoadBalancer(in *infrav1.APIServerLoadBalancer, out *APIServerLoadBalancer, s conversion.Scope) error {
 	// Provider was originally added in v1beta1, but was backported to v1alpha6, but has no equivalent in v1alpha5
 	return autoConvert_v1beta1_APIServerLoadBalancer_To_v1alpha5_APIServerLoadBalancer(in, out, s)
@@ -635,6 +640,11 @@ func Convert_v1alpha5_OpenStackClusterStatus_To_v1beta1_OpenStackClusterStatus(i
 	return nil
 }
 
+func Convert_v1beta1_OpenStackMachineTemplate_To_v1alpha5_OpenStackMachineTemplate(in *infrav1.OpenStackMachineTemplate, out *OpenStackMachineTemplate, s conversion.Scope) error {
+	// Status is not present in v1alpha5
+	return autoConvert_v1beta1_OpenStackMachineTemplate_To_v1alpha5_OpenStackMachineTemplate(in, out, s)
+}
+
 func Convert_v1beta1_OpenStackMachineSpec_To_v1alpha5_OpenStackMachineSpec(in *infrav1.OpenStackMachineSpec, out *OpenStackMachineSpec, s conversion.Scope) error {
 	err := autoConvert_v1beta1_OpenStackMachineSpec_To_v1alpha5_OpenStackMachineSpec(in, out, s)
 	if err != nil {
@@ -193,9 +193,18 @@ func restorev1beta1ClusterSpec(previous *infrav1.OpenStackClusterSpec, dst *infr
 	}
 
 	dst.ManagedSubnets = previous.ManagedSubnets
+	dst.ManagedRouter = previous.ManagedRouter
+	dst.ManagedNetwork = previous.ManagedNetwork
+	dst.ResourceNaming = previous.ResourceNaming
+	dst.APIServerVIP = previous.APIServerVIP
+	dst.DNSRecords = previous.DNSRecords
 
 	if previous.ManagedSecurityGroups != nil {
 		dst.ManagedSecurityGroups.AllNodesSecurityGroupRules = previous.ManagedSecurityGroups.AllNodesSecurityGroupRules
+		dst.ManagedSecurityGroups.ControlPlaneNodesSecurityGroupRules = previous.ManagedSecurityGroups.ControlPlaneNodesSecurityGroupRules
+		dst.ManagedSecurityGroups.WorkerNodesSecurityGroupRules = previous.ManagedSecurityGroups.WorkerNodesSecurityGroupRules
+		dst.ManagedSecurityGroups.BastionSecurityGroupRules = previous.ManagedSecurityGroups.BastionSecurityGroupRules
+		dst.ManagedSecurityGroups.Stateful = previous.ManagedSecurityGroups.Stateful
 	}
 
 	if dst.APIServerLoadBalancer != nil && previous.APIServerLoadBalancer != nil {
@@ -203,6 +212,8 @@ func restorev1beta1ClusterSpec(previous *infrav1.OpenStackClusterSpec, dst *infr
 			dst.APIServerLoadBalancer.Enabled = previous.APIServerLoadBalancer.Enabled
 		}
 		optional.RestoreString(&previous.APIServerLoadBalancer.Provider, &dst.APIServerLoadBalancer.Provider)
+		dst.APIServerLoadBalancer.Listeners = previous.APIServerLoadBalancer.Listeners
+		dst.APIServerLoadBalancer.LoadBalancer = previous.APIServerLoadBalancer.LoadBalancer
 	}
 	if dst.APIServerLoadBalancer.IsZero() {
 		dst.APIServerLoadBalancer = previous.APIServerLoadBalancer
@@ -373,6 +384,15 @@ func restorev1beta1ClusterStatus(previous *infrav1.OpenStackClusterStatus, dst *
 	if previous.Bastion != nil && previous.Bastion.DependentResources.Ports != nil {
 		dst.Bastion.DependentResources.Ports = previous.Bastion.DependentResources.Ports
 	}
+	if previous.Bastion != nil {
+		dst.Bastion.DependentResources.Trunks = previous.Bastion.DependentResources.Trunks
+		dst.Bastion.DependentResources.Volumes = previous.Bastion.DependentResources.Volumes
+		dst.Bastion.PortForwarding = previous.Bastion.PortForwarding
+	}
+
+	dst.APIServerVIP = previous.APIServerVIP
+	dst.APIServerDNSRecord = previous.APIServerDNSRecord
+	dst.Conditions = previous.Conditions
 }
 
 func Convert_v1beta1_OpenStackClusterStatus_To_v1alpha6_OpenStackClusterStatus(in *infrav1.OpenStackClusterStatus, out *OpenStackClusterStatus, s apiconversion.Scope) error {
@@ -414,6 +434,8 @@ func Convert_v1alpha6_OpenStackClusterStatus_To_v1beta1_OpenStackClusterStatus(i
 func restorev1beta1Bastion(previous **infrav1.Bastion, dst **infrav1.Bastion) {
 	if *previous != nil && *dst != nil {
 		restorev1beta1MachineSpec(&(*previous).Instance, &(*dst).Instance)
+		(*dst).AllowedCIDRs = (*previous).AllowedCIDRs
+		(*dst).PortForwarding = (*previous).PortForwarding
 	}
 }
 
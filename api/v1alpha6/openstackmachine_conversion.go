@@ -100,6 +100,12 @@ var v1beta1OpenStackMachineRestorer = conversion.RestorerFor[*infrav1.OpenStackM
 			return &c.Status.ReferencedResources
 		},
 	),
+	// No equivalent in v1alpha6
+	"dnsrecord": conversion.UnconditionalFieldRestorer(
+		func(c *infrav1.OpenStackMachine) **infrav1.DNSRecordStatus {
+			return &c.Status.DNSRecord
+		},
+	),
 }
 
 /* OpenStackMachineSpec */
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha6
 
 import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
@@ -75,4 +76,14 @@ var v1beta1OpenStackMachineTemplateRestorer = conversion.RestorerFor[*infrav1.Op
 		},
 		restorev1beta1MachineSpec,
 	),
+	"status": conversion.UnconditionalFieldRestorer(
+		func(c *infrav1.OpenStackMachineTemplate) *infrav1.OpenStackMachineTemplateStatus {
+			return &c.Status
+		},
+	),
+}
+
+func Convert_v1beta1_OpenStackMachineTemplate_To_v1alpha6_OpenStackMachineTemplate(in *infrav1.OpenStackMachineTemplate, out *OpenStackMachineTemplate, s apiconversion.Scope) error {
+	// Status is not present in v1alpha6
+	return autoConvert_v1beta1_OpenStackMachineTemplate_To_v1alpha6_OpenStackMachineTemplate(in, out, s)
 }
@@ -306,6 +306,12 @@ func Convert_v1beta1_BindingProfile_To_Map_string_To_Interface(in *infrav1.Bindi
 }
 
 /* FixedIP */
+
+func Convert_v1beta1_FixedIP_To_v1alpha6_FixedIP(in *infrav1.FixedIP, out *FixedIP, s apiconversion.Scope) error {
+	// IPAddressPoolRef has been added in v1beta1 and is restored with the ports
+	return autoConvert_v1beta1_FixedIP_To_v1alpha6_FixedIP(in, out, s)
+}
+
 /* AddressPair */
 /* Instance */
 /* RootVolume */
@@ -426,6 +432,12 @@ func Convert_v1alpha6_SecurityGroup_To_v1beta1_SecurityGroupStatus(in *SecurityG
 
 /* SecurityGroupRule */
 /* APIServerLoadBalancer */
+
+func Convert_v1beta1_APIServerLoadBalancer_To_v1alpha6_APIServerLoadBalancer(in *infrav1.APIServerLoadBalancer, out *APIServerLoadBalancer, s apiconversion.Scope) error {
+	// Listeners and LoadBalancer have no equivalent in v1alpha6
+	return autoConvert_v1beta1_APIServerLoadBalancer_To_v1alpha6_APIServerLoadBalancer(in, out, s)
+}
+
 /* ValueSpec */
 /* OpenStackIdentityReference */
 
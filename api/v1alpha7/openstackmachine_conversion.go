@@ -87,6 +87,12 @@ var v1beta1OpenStackMachineRestorer = conversion.RestorerFor[*infrav1.OpenStackM
 			return &c.Status.ReferencedResources
 		},
 	),
+	// No equivalent in v1alpha7
+	"dnsrecord": conversion.UnconditionalFieldRestorer(
+		func(c *infrav1.OpenStackMachine) **infrav1.DNSRecordStatus {
+			return &c.Status.DNSRecord
+		},
+	),
 }
 
 /* OpenStackMachineSpec */
@@ -143,6 +149,12 @@ func restorev1beta1MachineSpec(previous *infrav1.OpenStackMachineSpec, dst *infr
 		}
 	}
 	dst.FloatingIPPoolRef = previous.FloatingIPPoolRef
+
+	if len(dst.AdditionalBlockDevices) == len(previous.AdditionalBlockDevices) {
+		for i := range dst.AdditionalBlockDevices {
+			dst.AdditionalBlockDevices[i].Storage.ExistingVolume = previous.AdditionalBlockDevices[i].Storage.ExistingVolume
+		}
+	}
 }
 
 func Convert_v1alpha7_OpenStackMachineSpec_To_v1beta1_OpenStackMachineSpec(in *OpenStackMachineSpec, out *infrav1.OpenStackMachineSpec, s apiconversion.Scope) error {
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha7
 
 import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
@@ -75,8 +76,18 @@ var v1beta1OpenStackMachineTemplateRestorer = conversion.RestorerFor[*infrav1.Op
 		},
 		restorev1beta1MachineSpec,
 	),
+	"status": conversion.UnconditionalFieldRestorer(
+		func(c *infrav1.OpenStackMachineTemplate) *infrav1.OpenStackMachineTemplateStatus {
+			return &c.Status
+		},
+	),
 }
 
 func restorev1alpha7MachineTemplateSpec(previous *OpenStackMachineTemplateSpec, dst *OpenStackMachineTemplateSpec) {
 	restorev1alpha7MachineSpec(&previous.Template.Spec, &dst.Template.Spec)
 }
+
+func Convert_v1beta1_OpenStackMachineTemplate_To_v1alpha7_OpenStackMachineTemplate(in *infrav1.OpenStackMachineTemplate, out *OpenStackMachineTemplate, s apiconversion.Scope) error {
+	// Status is not present in v1alpha7
+	return autoConvert_v1beta1_OpenStackMachineTemplate_To_v1alpha7_OpenStackMachineTemplate(in, out, s)
+}
@@ -147,8 +147,11 @@ func restorev1beta1SecurityGroupStatus(previous *infrav1.SecurityGroupStatus, ds
 		return
 	}
 
+	dst.Stateful = previous.Stateful
+
 	for i := range dst.Rules {
 		dstRule := &dst.Rules[i]
+		dstRule.RemoteAddressGroupID = previous.Rules[i].RemoteAddressGroupID
 
 		// Conversion from scalar to *scalar is lossy for zero values. We need to restore only nil values.
 		if dstRule.Description != nil && *dstRule.Description == "" {
@@ -213,6 +216,7 @@ func restorev1beta1Port(previous *infrav1.PortOpts, dst *infrav1.PortOpts) {
 			if dstFixedIP.IPAddress == nil || *dstFixedIP.IPAddress == "" {
 				dstFixedIP.IPAddress = prevFixedIP.IPAddress
 			}
+			dstFixedIP.IPAddressPoolRef = prevFixedIP.IPAddressPoolRef
 		}
 	}
 
@@ -230,6 +234,10 @@ func restorev1beta1Port(previous *infrav1.PortOpts, dst *infrav1.PortOpts) {
 	optional.RestoreString(&previous.HostID, &dst.HostID)
 	optional.RestoreString(&previous.VNICType, &dst.VNICType)
 
+	dst.QoSPolicy = previous.QoSPolicy
+	dst.DNSName = previous.DNSName
+	dst.DNSDomain = previous.DNSDomain
+
 	if dst.Profile == nil && previous.Profile != nil {
 		dst.Profile = &infrav1.BindingProfile{}
 	}
@@ -295,6 +303,13 @@ func Convert_v1beta1_PortOpts_To_v1alpha7_PortOpts(in *infrav1.PortOpts, out *Po
 	return nil
 }
 
+/* FixedIP */
+
+func Convert_v1beta1_FixedIP_To_v1alpha7_FixedIP(in *infrav1.FixedIP, out *FixedIP, s apiconversion.Scope) error {
+	// IPAddressPoolRef has been added in v1beta1 and is restored with the ports
+	return autoConvert_v1beta1_FixedIP_To_v1alpha7_FixedIP(in, out, s)
+}
+
 /* SecurityGroup */
 
 func Convert_v1alpha7_SecurityGroup_To_v1beta1_SecurityGroupStatus(in *SecurityGroup, out *infrav1.SecurityGroupStatus, _ apiconversion.Scope) error {
@@ -352,6 +367,13 @@ func Convert_v1beta1_SecurityGroupStatus_To_v1alpha7_SecurityGroup(in *infrav1.S
 	return nil
 }
 
+/* APIServerLoadBalancer */
+
+func Convert_v1beta1_APIServerLoadBalancer_To_v1alpha7_APIServerLoadBalancer(in *infrav1.APIServerLoadBalancer, out *APIServerLoadBalancer, s apiconversion.Scope) error {
+	// Listeners and LoadBalancer have no equivalent in v1alpha7
+	return autoConvert_v1beta1_APIServerLoadBalancer_To_v1alpha7_APIServerLoadBalancer(in, out, s)
+}
+
 /* OpenStackIdentityReference */
 
 func Convert_v1alpha7_OpenStackIdentityReference_To_v1beta1_OpenStackIdentityReference(in *OpenStackIdentityReference, out *infrav1.OpenStackIdentityReference, s apiconversion.Scope) error {
@@ -362,3 +384,10 @@ func Convert_v1beta1_OpenStackIdentityReference_To_v1alpha7_OpenStackIdentityRef
 	out.Name = in.Name
 	return nil
 }
+
+/* BlockDeviceStorage */
+
+func Convert_v1beta1_BlockDeviceStorage_To_v1alpha7_BlockDeviceStorage(in *infrav1.BlockDeviceStorage, out *BlockDeviceStorage, s apiconversion.Scope) error {
+	// ExistingVolume has been added in v1beta1 and is restored with the machine spec
+	return autoConvert_v1beta1_BlockDeviceStorage_To_v1alpha7_BlockDeviceStorage(in, out, s)
+}
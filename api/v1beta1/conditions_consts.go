@@ -28,6 +28,10 @@ const (
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
 	// InvalidMachineSpecReason used when the machine spec is invalid.
 	InvalidMachineSpecReason = "InvalidMachineSpec"
+	// WaitingForImageReason used when machine is waiting for the referenced OpenStackImage to be ready before proceeding.
+	WaitingForImageReason = "WaitingForImage"
+	// WaitingForExistingVolumeReason used when machine is waiting for an existing volume to become available before proceeding.
+	WaitingForExistingVolumeReason = "WaitingForExistingVolume"
 	// InstanceCreateFailedReason used when creating the instance failed.
 	InstanceCreateFailedReason = "InstanceCreateFailed"
 	// InstanceNotFoundReason used when the instance couldn't be retrieved.
@@ -52,6 +56,31 @@ const (
 	LoadBalancerMemberErrorReason = "LoadBalancerMemberError"
 	// FloatingIPErrorReason used when the floating ip could not be created or attached.
 	FloatingIPErrorReason = "FloatingIPError"
+	// APIServerVIPErrorReason used when the API server VIP could not be added to or removed from the ports of the instance.
+	APIServerVIPErrorReason = "APIServerVIPError"
+)
+
+const (
+	// APIServerFloatingIPReadyCondition reports on the placement of the API server floating IP of a cluster without an
+	// API server load balancer. Ready indicates that the floating IP is associated with a control plane machine, which is
+	// named in the message of the condition.
+	APIServerFloatingIPReadyCondition clusterv1.ConditionType = "APIServerFloatingIPReady"
+
+	// FloatingIPAssociatedReason used when the floating ip is associated with a control plane machine.
+	FloatingIPAssociatedReason = "FloatingIPAssociated"
+	// WaitingForControlPlaneMachineReason used when there is no control plane machine the floating ip can be associated with.
+	WaitingForControlPlaneMachineReason = "WaitingForControlPlaneMachine"
+)
+
+const (
+	// InstanceAdoptedCondition reports on the adoption of an existing server by an OpenStackMachine. Ready indicates that
+	// the adopted server matches the spec of the machine.
+	InstanceAdoptedCondition clusterv1.ConditionType = "InstanceAdopted"
+
+	// InstanceAdoptFailedReason used when the server could not be adopted.
+	InstanceAdoptFailedReason = "InstanceAdoptFailed"
+	// InstanceSpecMismatchReason used when the adopted server differs from the spec of the machine.
+	InstanceSpecMismatchReason = "InstanceSpecMismatch"
 )
 
 const (
@@ -62,3 +91,22 @@ const (
 	// FloatingAddressFromPoolErrorReason is used when there is an error attaching an IP from the pool to an machine.
 	FloatingAddressFromPoolErrorReason = "FloatingIPError"
 )
+
+const (
+	// CapacityReadyCondition reports on the current status of the capacity reported by an OpenStackMachineTemplate.
+	CapacityReadyCondition clusterv1.ConditionType = "CapacityReady"
+
+	// FlavorNotFoundReason used when the flavor of a template could not be resolved.
+	FlavorNotFoundReason = "FlavorNotFound"
+)
+
+const (
+	// ReplicasReadyCondition reports on the current status of the servers of an OpenStackMachinePool. Ready indicates that
+	// the desired number of servers are active and up to date.
+	ReplicasReadyCondition clusterv1.ConditionType = "ReplicasReady"
+
+	// ScalingReason used when servers are being created or deleted to reach the desired number of replicas.
+	ScalingReason = "Scaling"
+	// RollingUpdateReason used when outdated servers are being replaced.
+	RollingUpdateReason = "RollingUpdate"
+)
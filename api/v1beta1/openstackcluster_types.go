@@ -30,6 +30,13 @@ const (
 	ClusterFinalizer = "openstackcluster.infrastructure.cluster.x-k8s.io"
 )
 
+const (
+	// ClusterUIDAnnotation is set by the controller on an OpenStackCluster to the UID its OpenStack resources are
+	// tagged with. It keeps the resources of the cluster identifiable when the UID of the OpenStackCluster changes,
+	// e.g. when the cluster is moved to another management cluster with clusterctl move.
+	ClusterUIDAnnotation = "infrastructure.cluster.x-k8s.io/cluster-uid"
+)
+
 // OpenStackClusterSpec defines the desired state of OpenStackCluster.
 type OpenStackClusterSpec struct {
 	// ManagedSubnets describe OpenStack Subnets to be created. Cluster actuator will create a network,
@@ -61,18 +68,29 @@ type OpenStackClusterSpec struct {
 	Subnets []SubnetFilter `json:"subnets,omitempty"`
 
 	// NetworkMTU sets the maximum transmission unit (MTU) value to address fragmentation for the private network ID.
-	// This value will be used only if the Cluster actuator creates the network.
+	// This value will be used only if the Cluster actuator creates the network. Changing it updates the MTU of the network.
 	// If left empty, the network will have the default MTU defined in Openstack network service.
 	// To use this field, the Openstack installation requires the net-mtu neutron API extension.
 	// +optional
 	NetworkMTU optional.Int `json:"networkMTU,omitempty"`
 
+	// ManagedNetwork defines the properties of the network created for the cluster.
+	// It is only used if ManagedSubnets are specified.
+	// +optional
+	ManagedNetwork *ManagedNetworkOptions `json:"managedNetwork,omitempty"`
+
 	// ExternalRouterIPs is an array of externalIPs on the respective subnets.
 	// This is necessary if the router needs a fixed ip in a specific subnet.
 	// +listType=atomic
 	// +optional
 	ExternalRouterIPs []ExternalRouterIPParam `json:"externalRouterIPs,omitempty"`
 
+	// ManagedRouter defines the properties of the router created for the cluster.
+	// It cannot be used together with Router, as a pre-existing router is not modified.
+	// The extra routes of the router are only reconciled while ManagedRouter is set.
+	// +optional
+	ManagedRouter *ManagedRouterOptions `json:"managedRouter,omitempty"`
+
 	// ExternalNetwork is the OpenStack Network to be used to get public internet to the VMs.
 	// This option is ignored if DisableExternalNetwork is set to true.
 	//
@@ -108,12 +126,23 @@ type OpenStackClusterSpec struct {
 	// This option requires that the API server use a VIP on the cluster network so that the
 	// underlying machines can change without changing ControlPlaneEndpoint.Host.
 	// When using a managed load balancer, this VIP will be managed automatically.
-	// If not using a managed load balancer, cluster configuration will fail without additional
-	// configuration to manage the VIP on the control plane machines, which falls outside of
-	// the scope of this controller.
+	// If not using a managed load balancer or APIServerVIP, cluster configuration will fail
+	// without additional configuration to manage the VIP on the control plane machines, which
+	// falls outside of the scope of this controller.
 	// +optional
 	DisableAPIServerFloatingIP optional.Bool `json:"disableAPIServerFloatingIP,omitempty"`
 
+	// APIServerVIP configures a virtual IP for the API server which is held by
+	// a VIP manager such as kube-vip or keepalived running on the control plane
+	// machines. A port reserving the VIP is created on the cluster network and
+	// the VIP is added to the allowed address pairs of the ports of the control
+	// plane machines on the cluster network. The fixed IP of the port is
+	// APIServerFixedIP if specified. Unless DisableAPIServerFloatingIP is set,
+	// a floating IP is associated with the port.
+	// APIServerVIP cannot be used together with APIServerLoadBalancer.
+	// +optional
+	APIServerVIP *APIServerVIP `json:"apiServerVIP,omitempty"`
+
 	// APIServerFloatingIP is the floatingIP which will be associated with the API server.
 	// The floatingIP will be created if it does not already exist.
 	// If not specified, a new floatingIP is allocated.
@@ -157,6 +186,11 @@ type OpenStackClusterSpec struct {
 	// +optional
 	Tags []string `json:"tags,omitempty"`
 
+	// ResourceNaming defines templates for the names of the OpenStack resources
+	// created for the cluster. It cannot be changed after the cluster has been created.
+	// +optional
+	ResourceNaming *ResourceNaming `json:"resourceNaming,omitempty"`
+
 	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
 	// It is normally populated automatically by the OpenStackCluster
 	// controller during cluster provisioning. If it is set on creation the
@@ -166,6 +200,14 @@ type OpenStackClusterSpec struct {
 	// +optional
 	ControlPlaneEndpoint *clusterv1.APIEndpoint `json:"controlPlaneEndpoint,omitempty"`
 
+	// DNSRecords configures DNS records for the control plane endpoint and
+	// optionally for the machines of the cluster in OpenStack Designate.
+	// If specified, the control plane endpoint is a DNS name rather than an
+	// IP address. It cannot be used with a ControlPlaneEndpoint whose host is
+	// an IP address.
+	// +optional
+	DNSRecords *DNSRecords `json:"dnsRecords,omitempty"`
+
 	// ControlPlaneAvailabilityZones is the set of availability zones which
 	// control plane machines may be deployed to.
 	// +listType=set
@@ -216,6 +258,15 @@ type OpenStackClusterStatus struct {
 	// +optional
 	APIServerLoadBalancer *LoadBalancer `json:"apiServerLoadBalancer,omitempty"`
 
+	// APIServerVIP describes the API server VIP if APIServerVIP is enabled.
+	// +optional
+	APIServerVIP *APIServerVIPStatus `json:"apiServerVIP,omitempty"`
+
+	// APIServerDNSRecord describes the DNS records of the control plane
+	// endpoint if DNSRecords is specified.
+	// +optional
+	APIServerDNSRecord *DNSRecordStatus `json:"apiServerDNSRecord,omitempty"`
+
 	// FailureDomains represent OpenStack availability zones
 	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
 
@@ -276,6 +327,10 @@ type OpenStackClusterStatus struct {
 	// and/or logged in the controller's output.
 	// +optional
 	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// Conditions defines current service state of the OpenStackCluster.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
 }
 
 // +genclient
@@ -319,12 +374,55 @@ type ManagedSecurityGroups struct {
 	// +optional
 	AllNodesSecurityGroupRules []SecurityGroupRuleSpec `json:"allNodesSecurityGroupRules,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
+	// controlPlaneNodesSecurityGroupRules defines the rules that should be applied to control plane nodes.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ControlPlaneNodesSecurityGroupRules []SecurityGroupRuleSpec `json:"controlPlaneNodesSecurityGroupRules,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// workerNodesSecurityGroupRules defines the rules that should be applied to worker nodes.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	WorkerNodesSecurityGroupRules []SecurityGroupRuleSpec `json:"workerNodesSecurityGroupRules,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// bastionSecurityGroupRules defines the rules that should be applied to the bastion.
+	// They are only applied if the bastion is enabled.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	BastionSecurityGroupRules []SecurityGroupRuleSpec `json:"bastionSecurityGroupRules,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// stateful defines whether the managed security groups are stateful.
+	// Stateless security groups do not track connections, so rules must also
+	// allow the return traffic. This requires the stateful-security-group
+	// extension. If not set, security groups are created with the default of
+	// the cloud, which is stateful, and the attribute is not reconciled.
+	// +optional
+	Stateful *bool `json:"stateful,omitempty"`
+
 	// AllowAllInClusterTraffic allows all ingress and egress traffic between cluster nodes when set to true.
 	// +kubebuilder:default=false
 	// +kubebuilder:validation:Required
 	AllowAllInClusterTraffic bool `json:"allowAllInClusterTraffic"`
 }
 
+// GetConditions returns the observations of the operational state of the OpenStackCluster resource.
+func (r *OpenStackCluster) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackCluster to the predescribed clusterv1.Conditions.
+func (r *OpenStackCluster) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
 func init() {
 	objectTypes = append(objectTypes, &OpenStackCluster{}, &OpenStackClusterList{})
 }
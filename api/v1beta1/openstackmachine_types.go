@@ -31,6 +31,12 @@ const (
 	IPClaimMachineFinalizer = "openstackmachine.infrastructure.cluster.x-k8s.io/ip-claim"
 )
 
+const (
+	// AdoptServerAnnotation is set on an OpenStackMachine to the ID of an existing server to be adopted by the machine
+	// instead of creating a new one.
+	AdoptServerAnnotation = "infrastructure.cluster.x-k8s.io/adopt-server-id"
+)
+
 // OpenStackMachineSpec defines the desired state of OpenStackMachine.
 type OpenStackMachineSpec struct {
 	// ProviderID is the unique identifier as specified by the cloud provider.
@@ -128,6 +134,11 @@ type OpenStackMachineStatus struct {
 	// DependentResources contains resolved dependent resources that were created by the machine.
 	DependentResources DependentMachineResources `json:"dependentResources,omitempty"`
 
+	// DNSRecord describes the DNS records of the machine if the cluster
+	// manages DNS records for its machines.
+	// +optional
+	DNSRecord *DNSRecordStatus `json:"dnsRecord,omitempty"`
+
 	FailureReason *errors.MachineStatusError `json:"failureReason,omitempty"`
 
 	// FailureMessage will be set in the event that there is a terminal problem
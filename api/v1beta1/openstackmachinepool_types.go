@@ -0,0 +1,183 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// MachinePoolFinalizer allows ReconcileOpenStackMachinePool to clean up OpenStack resources associated with
+	// OpenStackMachinePool before removing it from the apiserver.
+	MachinePoolFinalizer = "openstackmachinepool.infrastructure.cluster.x-k8s.io"
+)
+
+// OpenStackMachinePoolSpec defines the desired state of OpenStackMachinePool.
+type OpenStackMachinePoolSpec struct {
+	// ProviderIDList contains the provider IDs of the servers in the pool.
+	// It is maintained by the controller and consumed by the Cluster API
+	// MachinePool controller to match the servers with their nodes.
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// Template is the specification of the servers in the pool.
+	// Changing the template replaces the servers in the pool using a rolling update.
+	Template OpenStackMachineSpec `json:"template"`
+
+	// MaxSurge is the maximum number of servers that can be created above the
+	// desired number of replicas while the pool is being updated.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:default:=1
+	// +optional
+	MaxSurge int32 `json:"maxSurge,omitempty"`
+
+	// APIServerLoadBalancerMember specifies whether the servers in the pool are
+	// added as members of the API server load balancer of the cluster.
+	// +optional
+	APIServerLoadBalancerMember bool `json:"apiServerLoadBalancerMember,omitempty"`
+}
+
+// OpenStackMachinePoolInstanceStatus describes a server in the pool.
+type OpenStackMachinePoolInstanceStatus struct {
+	// Name is the name of the server.
+	Name string `json:"name"`
+
+	// InstanceID is the OpenStack ID of the server.
+	// +optional
+	InstanceID string `json:"instanceID,omitempty"`
+
+	// ProviderID is the provider ID of the server.
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+
+	// InstanceState is the state of the server.
+	// +optional
+	InstanceState *InstanceState `json:"instanceState,omitempty"`
+
+	// Ready is true when the server is active.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// TemplateHash is the hash of the template the server was created from.
+	TemplateHash string `json:"templateHash"`
+
+	// FailureDomain is the failure domain the server was created in.
+	// +optional
+	FailureDomain string `json:"failureDomain,omitempty"`
+
+	// DependentResources contains resolved dependent resources that were created for the server.
+	// +optional
+	DependentResources DependentMachineResources `json:"dependentResources,omitempty"`
+}
+
+// OpenStackMachinePoolTemplateRevision records a template the servers of the
+// pool were created from, so that they can be deleted after the template has
+// changed.
+type OpenStackMachinePoolTemplateRevision struct {
+	// TemplateHash is the hash of the template.
+	TemplateHash string `json:"templateHash"`
+
+	// Template is the specification the servers were created from.
+	Template OpenStackMachineSpec `json:"template"`
+
+	// ReferencedResources contains resolved references to resources required by the template.
+	// +optional
+	ReferencedResources ReferencedMachineResources `json:"referencedResources,omitempty"`
+}
+
+// OpenStackMachinePoolStatus defines the observed state of OpenStackMachinePool.
+type OpenStackMachinePoolStatus struct {
+	// Ready is true when the desired number of servers are active.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Replicas is the number of active servers in the pool.
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// ProviderIDList contains the provider IDs of the servers in the pool.
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// TemplateHash is the hash of the current template.
+	// +optional
+	TemplateHash string `json:"templateHash,omitempty"`
+
+	// ReferencedResources contains resolved references to resources required by the current template.
+	// +optional
+	ReferencedResources ReferencedMachineResources `json:"referencedResources,omitempty"`
+
+	// TemplateRevisions contains the templates which servers in the pool
+	// were created from. A revision is removed once no server uses it.
+	// +listType=map
+	// +listMapKey=templateHash
+	// +optional
+	TemplateRevisions []OpenStackMachinePoolTemplateRevision `json:"templateRevisions,omitempty"`
+
+	// Instances contains the servers in the pool.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Instances []OpenStackMachinePoolInstanceStatus `json:"instances,omitempty"`
+
+	// Conditions defines current service state of the OpenStackMachinePool.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:path=openstackmachinepools,scope=Namespaced,categories=cluster-api,shortName=osmp
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this OpenStackMachinePool belongs"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Number of active servers"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="OpenStackMachinePool ready status"
+// +kubebuilder:printcolumn:name="MachinePool",type="string",JSONPath=".metadata.ownerReferences[?(@.kind==\"MachinePool\")].name",description="MachinePool object which owns this OpenStackMachinePool"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of OpenStackMachinePool"
+
+// OpenStackMachinePool is the Schema for the openstackmachinepools API.
+type OpenStackMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackMachinePoolSpec   `json:"spec,omitempty"`
+	Status OpenStackMachinePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenStackMachinePoolList contains a list of OpenStackMachinePool.
+type OpenStackMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackMachinePool `json:"items"`
+}
+
+// GetConditions returns the observations of the operational state of the OpenStackMachinePool resource.
+func (r *OpenStackMachinePool) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackMachinePool to the predescribed clusterv1.Conditions.
+func (r *OpenStackMachinePool) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+func init() {
+	objectTypes = append(objectTypes, &OpenStackMachinePool{}, &OpenStackMachinePoolList{})
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 // OpenStackMachineTemplateSpec defines the desired state of OpenStackMachineTemplate.
@@ -25,18 +27,38 @@ type OpenStackMachineTemplateSpec struct {
 	Template OpenStackMachineTemplateResource `json:"template"`
 }
 
+// OpenStackMachineTemplateStatus defines the observed state of OpenStackMachineTemplate.
+type OpenStackMachineTemplateStatus struct {
+	// Capacity defines the resource capacity of a machine created from this template.
+	// This value is used for autoscaling from zero operations as defined in:
+	// https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20210310-opt-in-autoscaling-from-zero.md
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// NodeLabels contains the well-known labels which will be set on a node
+	// created from this template, such as its instance type and topology zone.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
+	// Conditions defines current service state of the OpenStackMachineTemplate.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
 // +genclient
 // +genclient:Namespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:resource:path=openstackmachinetemplates,scope=Namespaced,categories=cluster-api,shortName=osmt
+// +kubebuilder:subresource:status
 
 // OpenStackMachineTemplate is the Schema for the openstackmachinetemplates API.
 type OpenStackMachineTemplate struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec OpenStackMachineTemplateSpec `json:"spec,omitempty"`
+	Spec   OpenStackMachineTemplateSpec   `json:"spec,omitempty"`
+	Status OpenStackMachineTemplateStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -48,6 +70,16 @@ type OpenStackMachineTemplateList struct {
 	Items           []OpenStackMachineTemplate `json:"items"`
 }
 
+// GetConditions returns the observations of the operational state of the OpenStackMachineTemplate resource.
+func (r *OpenStackMachineTemplate) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackMachineTemplate to the predescribed clusterv1.Conditions.
+func (r *OpenStackMachineTemplate) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
 func init() {
 	objectTypes = append(objectTypes, &OpenStackMachineTemplate{}, &OpenStackMachineTemplateList{})
 }
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RemediationPhase is the remediation step currently being performed.
+type RemediationPhase string
+
+const (
+	// RemediationPhaseSoftReboot means the server was soft rebooted.
+	RemediationPhaseSoftReboot RemediationPhase = "SoftReboot"
+	// RemediationPhaseHardReboot means the server was hard rebooted.
+	RemediationPhaseHardReboot RemediationPhase = "HardReboot"
+	// RemediationPhaseRebuild means the server was rebuilt from its image.
+	RemediationPhaseRebuild RemediationPhase = "Rebuild"
+	// RemediationPhaseDeleteMachine means the remediation failed and the machine was deleted.
+	RemediationPhaseDeleteMachine RemediationPhase = "DeleteMachine"
+)
+
+const (
+	defaultSoftRebootTimeout = 5 * time.Minute
+	defaultHardRebootTimeout = 5 * time.Minute
+	defaultRebuildTimeout    = 15 * time.Minute
+)
+
+// OpenStackRemediationSpec defines the desired state of OpenStackRemediation.
+type OpenStackRemediationSpec struct {
+	// SoftRebootTimeout is the time to wait for the machine to become healthy
+	// after a soft reboot of its server. A value of 0 skips the soft reboot.
+	// +kubebuilder:default:="5m"
+	// +optional
+	SoftRebootTimeout *metav1.Duration `json:"softRebootTimeout,omitempty"`
+
+	// HardRebootTimeout is the time to wait for the machine to become healthy
+	// after a hard reboot of its server. A value of 0 skips the hard reboot.
+	// +kubebuilder:default:="5m"
+	// +optional
+	HardRebootTimeout *metav1.Duration `json:"hardRebootTimeout,omitempty"`
+
+	// RebuildTimeout is the time to wait for the machine to become healthy
+	// after its server is rebuilt from its image. A value of 0 skips the rebuild.
+	// Servers booted from volume are never rebuilt.
+	// +kubebuilder:default:="15m"
+	// +optional
+	RebuildTimeout *metav1.Duration `json:"rebuildTimeout,omitempty"`
+}
+
+// GetTimeout returns the time to wait after the given remediation phase.
+// It returns 0 if the phase is disabled.
+func (s *OpenStackRemediationSpec) GetTimeout(phase RemediationPhase) time.Duration {
+	timeout := func(d *metav1.Duration, defaultTimeout time.Duration) time.Duration {
+		if d == nil {
+			return defaultTimeout
+		}
+		return d.Duration
+	}
+
+	switch phase {
+	case RemediationPhaseSoftReboot:
+		return timeout(s.SoftRebootTimeout, defaultSoftRebootTimeout)
+	case RemediationPhaseHardReboot:
+		return timeout(s.HardRebootTimeout, defaultHardRebootTimeout)
+	case RemediationPhaseRebuild:
+		return timeout(s.RebuildTimeout, defaultRebuildTimeout)
+	}
+	return 0
+}
+
+// OpenStackRemediationStatus defines the observed state of OpenStackRemediation.
+type OpenStackRemediationStatus struct {
+	// Phase is the last remediation step which was performed.
+	// +optional
+	Phase RemediationPhase `json:"phase,omitempty"`
+
+	// LastRemediated is the time at which the last remediation step was performed.
+	// +optional
+	LastRemediated *metav1.Time `json:"lastRemediated,omitempty"`
+
+	// Message describes the outcome of the last remediation step, and of the steps which failed before it.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:path=openstackremediations,scope=Namespaced,categories=cluster-api,shortName=osr
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Last remediation step performed"
+// +kubebuilder:printcolumn:name="Last Remediated",type="date",JSONPath=".status.lastRemediated",description="Time of the last remediation step"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of OpenStackRemediation"
+
+// OpenStackRemediation is the Schema for the openstackremediations API.
+// It is created by a MachineHealthCheck from an OpenStackRemediationTemplate
+// to remediate the unhealthy Machine with the same name.
+type OpenStackRemediation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackRemediationSpec   `json:"spec,omitempty"`
+	Status OpenStackRemediationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenStackRemediationList contains a list of OpenStackRemediation.
+type OpenStackRemediationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackRemediation `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &OpenStackRemediation{}, &OpenStackRemediationList{})
+}
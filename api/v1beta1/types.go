@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
 
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/optional"
@@ -28,8 +29,9 @@ type OpenStackMachineTemplateResource struct {
 	Spec OpenStackMachineSpec `json:"spec"`
 }
 
-// ImageFilter describes the data needed to identify which image to use. If ID is provided it is required that all other fields are unset.
+// ImageFilter describes the data needed to identify which image to use. If ID or ImageRef is provided it is required that all other fields are unset.
 // +kubebuilder:validation:XValidation:rule="(has(self.id) && !has(self.name) && !has(self.tags)) || !has(self.id)",message="when ID is set you cannot set other options"
+// +kubebuilder:validation:XValidation:rule="!has(self.imageRef) || (!has(self.id) && !has(self.name) && !has(self.tags))",message="when imageRef is set you cannot set other options"
 type ImageFilter struct {
 	// The ID of the desired image. If ID is provided, the other filters cannot be provided. Must be in UUID format.
 	// +kubebuilder:validation:Format:=uuid
@@ -42,6 +44,17 @@ type ImageFilter struct {
 	// +listType=set
 	// +optional
 	Tags []string `json:"tags,omitempty"`
+	// ImageRef is a reference to an OpenStackImage in the same namespace. The image is used once the OpenStackImage is ready.
+	// If ImageRef is provided, the other filters cannot be provided.
+	// +optional
+	ImageRef *ResourceReference `json:"imageRef,omitempty"`
+}
+
+// ResourceReference is a reference to a resource in the same namespace.
+type ResourceReference struct {
+	// Name is the name of the referenced resource.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
 }
 
 type ExternalRouterIPParam struct {
@@ -51,6 +64,150 @@ type ExternalRouterIPParam struct {
 	Subnet SubnetFilter `json:"subnet"`
 }
 
+// ResourceNaming defines Go templates for the names of the OpenStack resources
+// created for a cluster. A resource created without a template keeps its default name.
+// The following data is available to all templates:
+//   - .ClusterNamespace: the namespace of the cluster
+//   - .ClusterName: the name of the Cluster, as used in the default names
+//
+// Templates of resources belonging to a machine can additionally use:
+//   - .MachineName: the name of the machine
+//   - .Role: the name suffix of the port, or the name of the volume, e.g. root
+//   - .Index: the index of the port
+//
+// The security group template can use .Role, which is one of controlplane,
+// worker or bastion. Rendered names must not be empty, must not exceed the
+// length limits of the OpenStack services, and must be distinct for each
+// resource the template is used for.
+type ResourceNaming struct {
+	// Network is the template of the name of the cluster network.
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// Subnet is the template of the name of the cluster subnet.
+	// +optional
+	Subnet *string `json:"subnet,omitempty"`
+
+	// Router is the template of the name of the cluster router.
+	// +optional
+	Router *string `json:"router,omitempty"`
+
+	// SecurityGroup is the template of the names of the managed security groups.
+	// It must use .Role to render a distinct name for each security group.
+	// +optional
+	SecurityGroup *string `json:"securityGroup,omitempty"`
+
+	// LoadBalancer is the template of the name of the API server load balancer.
+	// +optional
+	LoadBalancer *string `json:"loadBalancer,omitempty"`
+
+	// Port is the template of the names of the ports of machines and the bastion.
+	// It must use .Index or .Role to render a distinct name for each port.
+	// It is also used for the API server VIP port, with .ClusterName as .MachineName
+	// and api-server-vip as .Role.
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// Volume is the template of the names of the volumes of machines and the bastion.
+	// It must use .Role to render a distinct name for each volume.
+	// +optional
+	Volume *string `json:"volume,omitempty"`
+
+	// Bastion is the template of the name of the bastion server.
+	// As it is used as the hostname of the bastion, it must not exceed 63 characters.
+	// +optional
+	Bastion *string `json:"bastion,omitempty"`
+}
+
+// ManagedNetworkOptions defines the properties of the network created for the cluster.
+// All properties are only applied when the network is created.
+type ManagedNetworkOptions struct {
+	// Provider specifies the physical network the network is mapped to.
+	// Setting it is usually restricted to administrators by the Neutron policy.
+	// To use this field, the Openstack installation requires the provider neutron API extension.
+	// +optional
+	Provider *ProviderNetwork `json:"provider,omitempty"`
+
+	// AvailabilityZoneHints are the availability zones the DHCP agents of the network are scheduled to.
+	// To use this field, the Openstack installation requires the network_availability_zone neutron API extension.
+	// +listType=set
+	// +optional
+	AvailabilityZoneHints []string `json:"availabilityZoneHints,omitempty"`
+
+	// DNSDomain is the DNS domain of the network, used to publish the DNS names of its ports.
+	// It must be a fully qualified domain name ending with a dot, e.g. cluster.example.com.
+	// To use this field, the Openstack installation requires the dns-integration neutron API extension.
+	// +kubebuilder:validation:Pattern:=`^.*\.$`
+	// +optional
+	DNSDomain string `json:"dnsDomain,omitempty"`
+}
+
+// ProviderNetwork specifies the physical network a network is mapped to.
+// +kubebuilder:validation:XValidation:rule="!has(self.segmentationID) || !(self.networkType in ['flat', 'local'])",message="segmentationID cannot be set for flat and local networks"
+type ProviderNetwork struct {
+	// NetworkType is the type of the physical network, e.g. flat, vlan, vxlan or geneve.
+	// +kubebuilder:validation:MinLength:=1
+	NetworkType string `json:"networkType"`
+
+	// PhysicalNetwork is the name of the physical network the network is mapped to.
+	// It is required for flat and vlan networks.
+	// +optional
+	PhysicalNetwork string `json:"physicalNetwork,omitempty"`
+
+	// SegmentationID is the ID of the segment of the physical network, e.g. the VLAN ID.
+	// If not set, Neutron allocates one.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	SegmentationID *int `json:"segmentationID,omitempty"`
+}
+
+// ManagedRouterOptions defines the properties of the router created for the cluster.
+type ManagedRouterOptions struct {
+	// EnableSNAT specifies whether source NAT is enabled on the external gateway of the router.
+	// Disabling it is typically required when the cluster subnets are routed directly via an
+	// address scope. If not set, the Neutron default is used. Changing it updates the router.
+	// To use this field, the Openstack installation requires the ext-gw-mode neutron API extension.
+	// +optional
+	EnableSNAT *bool `json:"enableSNAT,omitempty"`
+
+	// ExtraRoutes are static routes added to the router, e.g. towards on-premise networks.
+	// Changing them updates the router.
+	// To use this field, the Openstack installation requires the extraroute neutron API extension.
+	// +listType=atomic
+	// +optional
+	ExtraRoutes []RouterRoute `json:"extraRoutes,omitempty"`
+
+	// HA specifies whether a highly available router is requested. It is only
+	// applied when the router is created.
+	// To use this field, the Openstack installation requires the l3-ha neutron API extension.
+	// +optional
+	HA *bool `json:"ha,omitempty"`
+
+	// Distributed specifies whether a distributed (DVR) router is requested. It is
+	// only applied when the router is created.
+	// To use this field, the Openstack installation requires the dvr neutron API extension.
+	// +optional
+	Distributed *bool `json:"distributed,omitempty"`
+
+	// AvailabilityZoneHints are the availability zones the router is scheduled to.
+	// They are only applied when the router is created.
+	// To use this field, the Openstack installation requires the router_availability_zone neutron API extension.
+	// +listType=set
+	// +optional
+	AvailabilityZoneHints []string `json:"availabilityZoneHints,omitempty"`
+}
+
+// RouterRoute is a static route of a router.
+type RouterRoute struct {
+	// Destination is the destination CIDR of the route, e.g. 192.168.100.0/24.
+	// +kubebuilder:validation:MinLength:=1
+	Destination string `json:"destination"`
+
+	// NextHop is the IP address the traffic to Destination is forwarded to.
+	// +kubebuilder:validation:MinLength:=1
+	NextHop string `json:"nextHop"`
+}
+
 // NeutronTag represents a tag on a Neutron resource.
 // It may not be empty and may not contain commas.
 // +kubebuilder:validation:Pattern:="^[^,]+$"
@@ -93,6 +250,24 @@ type SecurityGroupFilter struct {
 	FilterByNeutronTags `json:",inline"`
 }
 
+// QoSPolicyFilter specifies a Neutron QoS policy.
+type QoSPolicyFilter struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ProjectID   string `json:"projectID,omitempty"`
+
+	FilterByNeutronTags `json:",inline"`
+}
+
+// AddressGroupFilter specifies a Neutron address group.
+type AddressGroupFilter struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ProjectID   string `json:"projectID,omitempty"`
+}
+
 type NetworkFilter struct {
 	Name        string `json:"name,omitempty"`
 	Description string `json:"description,omitempty"`
@@ -139,19 +314,47 @@ type RouterFilter struct {
 	FilterByNeutronTags `json:",inline"`
 }
 
+// SubnetPoolFilter specifies a query to select an OpenStack subnet pool. At least one property must be set.
+// +kubebuilder:validation:MinProperties:=1
+type SubnetPoolFilter struct {
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	ProjectID      string `json:"projectID,omitempty"`
+	AddressScopeID string `json:"addressScopeID,omitempty"`
+
+	FilterByNeutronTags `json:",inline"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.cidr) != has(self.subnetPool)",message="exactly one of cidr and subnetPool must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.prefixLength) || has(self.subnetPool)",message="prefixLength can only be set with subnetPool"
 type SubnetSpec struct {
 	// CIDR is representing the IP address range used to create the subnet, e.g. 10.0.0.0/24.
-	// This field is required when defining a subnet.
-	// +required
-	CIDR string `json:"cidr"`
+	// Exactly one of CIDR and SubnetPool must be set.
+	// +optional
+	CIDR string `json:"cidr,omitempty"`
+
+	// SubnetPool is a query for the Neutron subnet pool the CIDR of the subnet is allocated from.
+	// The query must return a single subnet pool. The allocated CIDR is recorded in the
+	// status of the cluster. Exactly one of CIDR and SubnetPool must be set.
+	// +optional
+	SubnetPool *SubnetPoolFilter `json:"subnetPool,omitempty"`
+
+	// PrefixLength is the prefix length of the CIDR allocated from SubnetPool.
+	// If not set, the default prefix length of the subnet pool is used.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=128
+	// +optional
+	PrefixLength *int `json:"prefixLength,omitempty"`
 
 	// DNSNameservers holds a list of DNS server addresses that will be provided when creating
 	// the subnet. These addresses need to have the same IP version as CIDR.
+	// Changing the list updates the DNS server addresses of the subnet.
 	DNSNameservers []string `json:"dnsNameservers,omitempty"`
 
 	// AllocationPools is an array of AllocationPool objects that will be applied to OpenStack Subnet being created.
 	// If set, OpenStack will only allocate these IPs for Machines. It will still be possible to create ports from
-	// outside of these ranges manually.
+	// outside of these ranges manually. Changing the list updates the allocation pools of the subnet.
 	AllocationPools []AllocationPool `json:"allocationPools,omitempty"`
 }
 
@@ -245,6 +448,22 @@ type PortOpts struct {
 	// +optional
 	PropagateUplinkStatus *bool `json:"propagateUplinkStatus,omitempty"`
 
+	// QoSPolicy is a query for the Neutron QoS policy to apply to the port.
+	// It must match exactly one policy. This requires the qos extension.
+	// +optional
+	QoSPolicy *QoSPolicyFilter `json:"qosPolicy,omitempty"`
+
+	// DNSName is the DNS name of the port, which Neutron publishes to an
+	// external DNS service. This requires the dns-integration extension.
+	// +optional
+	DNSName optional.String `json:"dnsName,omitempty"`
+
+	// DNSDomain is the DNS domain the DNS name of the port is published in.
+	// If not set, the DNS domain of the network is used. This requires the
+	// dns-domain-ports extension.
+	// +optional
+	DNSDomain optional.String `json:"dnsDomain,omitempty"`
+
 	// Tags applied to the port (and corresponding trunk, if a trunk is configured.)
 	// These tags are applied in addition to the instance's tags, which will also be applied to the port.
 	// +listType=set
@@ -288,6 +507,15 @@ type FixedIP struct {
 	// address in any subnet of the port's network.
 	// +optional
 	IPAddress optional.String `json:"ipAddress,omitempty"`
+
+	// IPAddressPoolRef is a reference to an IPAM pool, e.g. an
+	// OpenStackFixedIPPool, which the IP address is claimed from with an
+	// IPAddressClaim when the port is created. It cannot be used together
+	// with IPAddress. The address is released when the machine is deleted.
+	// It is only supported by OpenStackMachines and OpenStackMachineTemplates,
+	// and not for the bastion or OpenStackMachinePools.
+	// +optional
+	IPAddressPoolRef *corev1.TypedLocalObjectReference `json:"ipAddressPoolRef,omitempty"`
 }
 
 type AddressPair struct {
@@ -312,6 +540,25 @@ type BastionStatus struct {
 	FloatingIP          string                     `json:"floatingIP,omitempty"`
 	ReferencedResources ReferencedMachineResources `json:"referencedResources,omitempty"`
 	DependentResources  DependentMachineResources  `json:"dependentResources,omitempty"`
+
+	// PortForwarding is the port forwarding exposing the bastion, if any.
+	// +optional
+	PortForwarding *BastionPortForwardingStatus `json:"portForwarding,omitempty"`
+}
+
+// BastionPortForwardingStatus represents the port forwarding exposing the bastion.
+type BastionPortForwardingStatus struct {
+	// ID is the ID of the port forwarding.
+	ID string `json:"id"`
+
+	// FloatingIPID is the ID of the floating IP of the port forwarding.
+	FloatingIPID string `json:"floatingIPID"`
+
+	// FloatingIP is the address of the floating IP of the port forwarding.
+	FloatingIP string `json:"floatingIP"`
+
+	// ExternalPort is the port of the floating IP forwarded to the bastion.
+	ExternalPort int `json:"externalPort"`
 }
 
 type RootVolume struct {
@@ -327,7 +574,7 @@ type RootVolume struct {
 //nolint:godot
 type BlockDeviceStorage struct {
 	// Type is the type of block device to create.
-	// This can be either "Volume" or "Local".
+	// This can be either "Volume", "Local" or "ExistingVolume".
 	// +unionDiscriminator
 	Type BlockDeviceType `json:"type"`
 
@@ -335,6 +582,12 @@ type BlockDeviceStorage struct {
 	// +optional
 	// +unionMember,optional
 	Volume *BlockDeviceVolume `json:"volume,omitempty"`
+
+	// ExistingVolume references the existing volume to attach for an existing volume block device.
+	// It must be set if Type is "ExistingVolume".
+	// +optional
+	// +unionMember,optional
+	ExistingVolume *BlockDeviceExistingVolume `json:"existingVolume,omitempty"`
 }
 
 // BlockDeviceVolume contains additional storage options for a volume block device.
@@ -354,6 +607,35 @@ type BlockDeviceVolume struct {
 	AvailabilityZone string `json:"availabilityZone,omitempty"`
 }
 
+// BlockDeviceExistingVolume references an existing Cinder volume, either with a filter or with
+// a name template. Exactly one of Filter and NameTemplate must be set.
+// The volume is never created or deleted by CAPO. It is detached when the machine is deleted, so
+// that it can be attached to the machine replacing it. The server is only created once the volume
+// is available, so a replacement machine waits until the machine it replaces has been deleted.
+// Unless the volume is multiattach, an OpenStackMachineTemplate referencing it must only be used
+// for a single replica, which is deleted before it is replaced, e.g. with maxSurge set to 0.
+// +kubebuilder:validation:XValidation:rule="has(self.filter) != has(self.nameTemplate)",message="exactly one of filter and nameTemplate must be set"
+type BlockDeviceExistingVolume struct {
+	// Filter selects the volume by ID or name. It must match exactly one volume.
+	// +optional
+	Filter *VolumeFilter `json:"filter,omitempty"`
+
+	// NameTemplate is a Go template rendering the name of the volume.
+	// It can use .ClusterNamespace, .ClusterName, .Role, which is the name of the block device,
+	// and .FailureDomain, which is the failure domain of the machine. It cannot use .MachineName,
+	// as it changes when the machine is replaced. Templates referencing a volume per machine can
+	// use .FailureDomain when each failure domain has a single machine, e.g. for control planes.
+	// +optional
+	NameTemplate *string `json:"nameTemplate,omitempty"`
+}
+
+// VolumeFilter specifies a query to select an OpenStack volume. At least one property must be set.
+// +kubebuilder:validation:MinProperties:=1
+type VolumeFilter struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
 // AdditionalBlockDevice is a block device to attach to the server.
 type AdditionalBlockDevice struct {
 	// Name of the block device in the context of a machine.
@@ -365,6 +647,7 @@ type AdditionalBlockDevice struct {
 	Name string `json:"name"`
 
 	// SizeGiB is the size of the block device in gibibytes (GiB).
+	// An existing volume must be at least this size.
 	SizeGiB int `json:"sizeGiB"`
 
 	// Storage specifies the storage type of the block device and
@@ -386,6 +669,10 @@ const (
 
 	// VolumeBlockDevice is a volume block device attached to the server.
 	VolumeBlockDevice BlockDeviceType = "Volume"
+
+	// ExistingVolumeBlockDevice is an existing volume attached to the server.
+	// The volume is not deleted with the server.
+	ExistingVolumeBlockDevice BlockDeviceType = "ExistingVolume"
 )
 
 // NetworkStatus contains basic information about an existing neutron network.
@@ -449,6 +736,11 @@ type SecurityGroupStatus struct {
 	// +kubebuilder:validation:Required
 	ID string `json:"id"`
 
+	// stateful is whether the security group is stateful. It is not set if
+	// the cloud does not support stateless security groups.
+	// +optional
+	Stateful *bool `json:"stateful,omitempty"`
+
 	// list of security group rules
 	// +optional
 	Rules []SecurityGroupRuleStatus `json:"rules,omitempty"`
@@ -456,12 +748,12 @@ type SecurityGroupStatus struct {
 
 // SecurityGroupRuleSpec represent the basic information of the associated OpenStack
 // Security Group Role.
-// For now this is only used for the allNodesSecurityGroupRules but when we add
-// other security groups, we'll need to add a validation because
-// Remote* fields are mutually exclusive.
+// The Remote* fields are mutually exclusive.
 type SecurityGroupRuleSpec struct {
 	// name of the security group rule.
 	// It's used to identify the rule so it can be patched and will not be sent to the OpenStack API.
+	// A rule for a single role replaces the default rules and the rules for all nodes with the same name.
+	// Rules for all nodes never replace other rules.
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
 
@@ -499,19 +791,25 @@ type SecurityGroupRuleSpec struct {
 	Protocol *string `json:"protocol,omitempty"`
 
 	// remoteGroupID is the remote group ID to be associated with this security group rule.
-	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups.
+	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.
 	// +optional
 	RemoteGroupID *string `json:"remoteGroupID,omitempty"`
 
 	// remoteIPPrefix is the remote IP prefix to be associated with this security group rule.
-	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups.
+	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.
 	// +optional
 	RemoteIPPrefix *string `json:"remoteIPPrefix,omitempty"`
 
 	// remoteManagedGroups is the remote managed groups to be associated with this security group rule.
-	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups.
+	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.
 	// +optional
 	RemoteManagedGroups []ManagedSecurityGroupName `json:"remoteManagedGroups,omitempty"`
+
+	// remoteAddressGroup is the Neutron address group to be associated with this security group rule.
+	// It must match exactly one address group. This requires the address-group extension.
+	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups or remoteAddressGroup.
+	// +optional
+	RemoteAddressGroup *AddressGroupFilter `json:"remoteAddressGroup,omitempty"`
 }
 
 type SecurityGroupRuleStatus struct {
@@ -561,6 +859,10 @@ type SecurityGroupRuleStatus struct {
 	// You can specify either remoteGroupID or remoteIPPrefix or remoteManagedGroups.
 	// +optional
 	RemoteIPPrefix *string `json:"remoteIPPrefix,omitempty"`
+
+	// remoteAddressGroupID is the ID of the remote address group associated with this security group rule.
+	// +optional
+	RemoteAddressGroupID *string `json:"remoteAddressGroupID,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=bastion;controlplane;worker
@@ -611,6 +913,35 @@ type Bastion struct {
 	// The floating IP should already exist and should not be associated with a port.
 	//+optional
 	FloatingIP string `json:"floatingIP,omitempty"`
+
+	// AllowedCIDRs restricts the SSH access to the bastion to the given address CIDRs.
+	// It only applies to the managed bastion security group. SSH access is
+	// allowed from anywhere if it is empty.
+	// +optional
+	// +listType=set
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+
+	// PortForwarding exposes the SSH port of the bastion through a port
+	// forwarding on an existing floating IP, which can be shared with other
+	// resources, instead of associating a floating IP with the bastion.
+	// It cannot be used with FloatingIP.
+	// To use this field, the Openstack installation requires the floating-ip-port-forwarding neutron API extension.
+	// +optional
+	PortForwarding *BastionPortForwarding `json:"portForwarding,omitempty"`
+}
+
+// BastionPortForwarding defines the port forwarding exposing the bastion.
+type BastionPortForwarding struct {
+	// FloatingIP is the address of the existing floating IP on which the port forwarding is created.
+	// +kubebuilder:validation:MinLength:=1
+	FloatingIP string `json:"floatingIP"`
+
+	// ExternalPort is the port of the floating IP which is forwarded to the
+	// SSH port of the bastion. Defaults to 22.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	// +optional
+	ExternalPort *int `json:"externalPort,omitempty"`
 }
 
 type APIServerLoadBalancer struct {
@@ -635,15 +966,112 @@ type APIServerLoadBalancer struct {
 	// +listType=set
 	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
 
+	// Listeners configures the listeners of the load balancer individually.
+	// Each entry applies to the listener on the given port, which is either
+	// the API server port or one of AdditionalPorts. Listeners without an
+	// entry use the Octavia defaults.
+	// +optional
+	// +listType=map
+	// +listMapKey=port
+	Listeners []APIServerLoadBalancerListener `json:"listeners,omitempty"`
+
 	// Provider specifies name of a specific Octavia provider to use for the
 	// API load balancer. The Octavia default will be used if it is not
 	// specified.
 	// +optional
 	Provider optional.String `json:"provider,omitempty"`
+
+	// LoadBalancer specifies an existing load balancer to use for the API
+	// server instead of creating one. Only the listeners, pools, monitors and
+	// members of the cluster are managed on it, and it is not deleted together
+	// with the cluster. No floating IP is allocated for an existing load
+	// balancer: the control plane endpoint is the floating IP associated with
+	// its VIP, if any, otherwise the VIP address.
+	// +optional
+	LoadBalancer *LoadBalancerFilter `json:"loadBalancer,omitempty"`
+}
+
+// APIServerLoadBalancerListener configures the listener and pool of the API
+// server load balancer on a single port. Settings which are not specified are
+// left unchanged on an existing listener.
+type APIServerLoadBalancerListener struct {
+	// Port is the port of the listener.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	Port int `json:"port"`
+
+	// TimeoutClientData is the frontend client inactivity timeout in
+	// milliseconds.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	TimeoutClientData *int `json:"timeoutClientData,omitempty"`
+
+	// TimeoutMemberData is the backend member inactivity timeout in
+	// milliseconds.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	TimeoutMemberData *int `json:"timeoutMemberData,omitempty"`
+
+	// TimeoutMemberConnect is the backend member connection timeout in
+	// milliseconds.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	TimeoutMemberConnect *int `json:"timeoutMemberConnect,omitempty"`
+
+	// TimeoutTCPInspect is the time in milliseconds to wait for additional
+	// TCP packets for content inspection.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	TimeoutTCPInspect *int `json:"timeoutTCPInspect,omitempty"`
+
+	// ConnectionLimit is the maximum number of connections permitted for
+	// the listener. -1 means unlimited.
+	// +kubebuilder:validation:Minimum:=-1
+	// +optional
+	ConnectionLimit *int `json:"connectionLimit,omitempty"`
+
+	// AllowedCIDRs restricts access to the listener to the given address
+	// CIDRs instead of APIServerLoadBalancer.AllowedCIDRs.
+	// +optional
+	// +listType=set
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+
+	// PoolAlgorithm is the load balancing algorithm of the pool. If not
+	// specified, SOURCE_IP_PORT is used with the ovn provider and
+	// ROUND_ROBIN otherwise.
+	// +kubebuilder:validation:Enum=ROUND_ROBIN;LEAST_CONNECTIONS;SOURCE_IP;SOURCE_IP_PORT
+	// +optional
+	PoolAlgorithm string `json:"poolAlgorithm,omitempty"`
+
+	// PoolProtocol is the protocol used by the pool to connect to the
+	// members. PROXY and PROXYV2 send the client address to the members
+	// using the PROXY protocol. It defaults to TCP and cannot be changed
+	// once the pool has been created.
+	// +kubebuilder:validation:Enum=TCP;PROXY;PROXYV2
+	// +optional
+	PoolProtocol string `json:"poolProtocol,omitempty"`
+
+	// SessionPersistence is the session persistence type of the pool.
+	// SOURCE_IP sends all connections from a client to the same member.
+	// +kubebuilder:validation:Enum=SOURCE_IP
+	// +optional
+	SessionPersistence string `json:"sessionPersistence,omitempty"`
+}
+
+// LoadBalancerFilter specifies an existing Octavia load balancer by ID or name.
+// +kubebuilder:validation:XValidation:rule="has(self.id) != has(self.name)",message="exactly one of id and name must be set"
+type LoadBalancerFilter struct {
+	// ID is the ID of the load balancer.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the load balancer. It must be unique in the project.
+	// +optional
+	Name string `json:"name,omitempty"`
 }
 
 func (s *APIServerLoadBalancer) IsZero() bool {
-	return s == nil || ((s.Enabled == nil || !*s.Enabled) && len(s.AdditionalPorts) == 0 && len(s.AllowedCIDRs) == 0 && pointer.StringDeref(s.Provider, "") == "")
+	return s == nil || ((s.Enabled == nil || !*s.Enabled) && len(s.AdditionalPorts) == 0 && len(s.AllowedCIDRs) == 0 && len(s.Listeners) == 0 && pointer.StringDeref(s.Provider, "") == "" && s.LoadBalancer == nil)
 }
 
 func (s *APIServerLoadBalancer) IsEnabled() bool {
@@ -651,6 +1079,96 @@ func (s *APIServerLoadBalancer) IsEnabled() bool {
 	return s != nil && (s.Enabled == nil || *s.Enabled)
 }
 
+// APIServerVIP configures a virtual IP for the API server which is managed by
+// a VIP manager running on the control plane machines.
+type APIServerVIP struct {
+	// Enabled defines whether the VIP should be managed. This value defaults
+	// to true if an APIServerVIP is given.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:default:=true
+	Enabled *bool `json:"enabled"`
+}
+
+func (s *APIServerVIP) IsEnabled() bool {
+	// The CRD default value for Enabled is true, so if the field is nil, it should be considered as true.
+	return s != nil && (s.Enabled == nil || *s.Enabled)
+}
+
+// APIServerVIPStatus represents the port reserving the API server VIP.
+type APIServerVIPStatus struct {
+	// PortID is the ID of the port reserving the VIP.
+	// +kubebuilder:validation:Required
+	PortID string `json:"portID"`
+
+	// NetworkID is the ID of the network of the port.
+	// +kubebuilder:validation:Required
+	NetworkID string `json:"networkID"`
+
+	// IP is the fixed IP of the port.
+	// +kubebuilder:validation:Required
+	IP string `json:"ip"`
+
+	// FloatingIP is the floating IP associated with the port, if any.
+	// +optional
+	FloatingIP string `json:"floatingIP,omitempty"`
+}
+
+// DNSRecords configures the DNS records which are managed in Designate for the cluster.
+type DNSRecords struct {
+	// Zone is the Designate zone in which the records are created.
+	// +kubebuilder:validation:Required
+	Zone DNSZoneFilter `json:"zone"`
+
+	// APIServerRecordName is the name of the record of the control plane
+	// endpoint, relative to the zone. If not specified, it defaults to
+	// `api.<cluster name>.<cluster namespace>`.
+	// The control plane endpoint is set to the fully qualified domain name
+	// of the record.
+	// +optional
+	APIServerRecordName optional.String `json:"apiServerRecordName,omitempty"`
+
+	// MachineRecords specifies whether a record is also created for each
+	// machine of the cluster. The record of a machine is named
+	// `<machine name>.<cluster name>.<cluster namespace>`, relative to the
+	// zone, and resolves to the internal addresses of the machine.
+	// +optional
+	MachineRecords optional.Bool `json:"machineRecords,omitempty"`
+
+	// TTL is the time to live of the records in seconds. If not specified,
+	// the default TTL of the zone is used.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TTL *int `json:"ttl,omitempty"`
+}
+
+// DNSZoneFilter specifies a Designate zone by ID or by name.
+// +kubebuilder:validation:XValidation:rule="has(self.id) != has(self.name)",message="exactly one of id and name must be set"
+type DNSZoneFilter struct {
+	// ID is the ID of the zone.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the zone, e.g. `example.com.`.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// DNSRecordStatus represents the DNS records of a resource.
+type DNSRecordStatus struct {
+	// ZoneID is the ID of the zone containing the records.
+	// +required
+	ZoneID string `json:"zoneID"`
+
+	// Name is the fully qualified domain name of the records.
+	// +required
+	Name string `json:"name"`
+
+	// Addresses are the addresses the records resolve to.
+	// +listType=atomic
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+}
+
 // ReferencedMachineResources contains resolved references to resources required by the machine.
 type ReferencedMachineResources struct {
 	// ServerGroupID is the ID of the server group the machine should be added to and is calculated based on ServerGroupFilter.
@@ -670,6 +1188,32 @@ type DependentMachineResources struct {
 	// Ports is the status of the ports created for the machine.
 	// +optional
 	Ports []PortStatus `json:"ports,omitempty"`
+
+	// Trunks is the status of the trunks of the machine. It is only populated for adopted servers.
+	// It is informational only: the trunks are deleted together with their parent ports.
+	// +optional
+	Trunks []TrunkStatus `json:"trunks,omitempty"`
+
+	// Volumes is the status of the volumes attached to the machine. It is only populated for adopted servers.
+	// It is informational only: the volumes are never deleted by CAPO.
+	// +optional
+	Volumes []VolumeStatus `json:"volumes,omitempty"`
+}
+
+type TrunkStatus struct {
+	// ID is the unique identifier of the trunk.
+	// +required
+	ID string `json:"id"`
+
+	// PortID is the unique identifier of the parent port of the trunk.
+	// +required
+	PortID string `json:"portID"`
+}
+
+type VolumeStatus struct {
+	// ID is the unique identifier of the volume.
+	// +required
+	ID string `json:"id"`
 }
 
 // ValueSpec represents a single value_spec key-value pair.
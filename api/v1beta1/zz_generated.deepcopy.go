@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/errors"
@@ -45,11 +46,23 @@ func (in *APIServerLoadBalancer) DeepCopyInto(out *APIServerLoadBalancer) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]APIServerLoadBalancerListener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Provider != nil {
 		in, out := &in.Provider, &out.Provider
 		*out = new(string)
 		**out = **in
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerFilter)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLoadBalancer.
@@ -62,6 +75,86 @@ func (in *APIServerLoadBalancer) DeepCopy() *APIServerLoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerLoadBalancerListener) DeepCopyInto(out *APIServerLoadBalancerListener) {
+	*out = *in
+	if in.TimeoutClientData != nil {
+		in, out := &in.TimeoutClientData, &out.TimeoutClientData
+		*out = new(int)
+		**out = **in
+	}
+	if in.TimeoutMemberData != nil {
+		in, out := &in.TimeoutMemberData, &out.TimeoutMemberData
+		*out = new(int)
+		**out = **in
+	}
+	if in.TimeoutMemberConnect != nil {
+		in, out := &in.TimeoutMemberConnect, &out.TimeoutMemberConnect
+		*out = new(int)
+		**out = **in
+	}
+	if in.TimeoutTCPInspect != nil {
+		in, out := &in.TimeoutTCPInspect, &out.TimeoutTCPInspect
+		*out = new(int)
+		**out = **in
+	}
+	if in.ConnectionLimit != nil {
+		in, out := &in.ConnectionLimit, &out.ConnectionLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLoadBalancerListener.
+func (in *APIServerLoadBalancerListener) DeepCopy() *APIServerLoadBalancerListener {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerLoadBalancerListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerVIP) DeepCopyInto(out *APIServerVIP) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerVIP.
+func (in *APIServerVIP) DeepCopy() *APIServerVIP {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerVIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerVIPStatus) DeepCopyInto(out *APIServerVIPStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerVIPStatus.
+func (in *APIServerVIPStatus) DeepCopy() *APIServerVIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerVIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdditionalBlockDevice) DeepCopyInto(out *AdditionalBlockDevice) {
 	*out = *in
@@ -78,6 +171,21 @@ func (in *AdditionalBlockDevice) DeepCopy() *AdditionalBlockDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressGroupFilter) DeepCopyInto(out *AddressGroupFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressGroupFilter.
+func (in *AddressGroupFilter) DeepCopy() *AddressGroupFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressGroupFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddressPair) DeepCopyInto(out *AddressPair) {
 	*out = *in
@@ -117,6 +225,16 @@ func (in *AllocationPool) DeepCopy() *AllocationPool {
 func (in *Bastion) DeepCopyInto(out *Bastion) {
 	*out = *in
 	in.Instance.DeepCopyInto(&out.Instance)
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PortForwarding != nil {
+		in, out := &in.PortForwarding, &out.PortForwarding
+		*out = new(BastionPortForwarding)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bastion.
@@ -129,11 +247,51 @@ func (in *Bastion) DeepCopy() *Bastion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionPortForwarding) DeepCopyInto(out *BastionPortForwarding) {
+	*out = *in
+	if in.ExternalPort != nil {
+		in, out := &in.ExternalPort, &out.ExternalPort
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionPortForwarding.
+func (in *BastionPortForwarding) DeepCopy() *BastionPortForwarding {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionPortForwarding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionPortForwardingStatus) DeepCopyInto(out *BastionPortForwardingStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionPortForwardingStatus.
+func (in *BastionPortForwardingStatus) DeepCopy() *BastionPortForwardingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionPortForwardingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
 	*out = *in
 	in.ReferencedResources.DeepCopyInto(&out.ReferencedResources)
 	in.DependentResources.DeepCopyInto(&out.DependentResources)
+	if in.PortForwarding != nil {
+		in, out := &in.PortForwarding, &out.PortForwarding
+		*out = new(BastionPortForwardingStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionStatus.
@@ -171,6 +329,31 @@ func (in *BindingProfile) DeepCopy() *BindingProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockDeviceExistingVolume) DeepCopyInto(out *BlockDeviceExistingVolume) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(VolumeFilter)
+		**out = **in
+	}
+	if in.NameTemplate != nil {
+		in, out := &in.NameTemplate, &out.NameTemplate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockDeviceExistingVolume.
+func (in *BlockDeviceExistingVolume) DeepCopy() *BlockDeviceExistingVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockDeviceExistingVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockDeviceStorage) DeepCopyInto(out *BlockDeviceStorage) {
 	*out = *in
@@ -179,6 +362,11 @@ func (in *BlockDeviceStorage) DeepCopyInto(out *BlockDeviceStorage) {
 		*out = new(BlockDeviceVolume)
 		**out = **in
 	}
+	if in.ExistingVolume != nil {
+		in, out := &in.ExistingVolume, &out.ExistingVolume
+		*out = new(BlockDeviceExistingVolume)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockDeviceStorage.
@@ -206,6 +394,72 @@ func (in *BlockDeviceVolume) DeepCopy() *BlockDeviceVolume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordStatus) DeepCopyInto(out *DNSRecordStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordStatus.
+func (in *DNSRecordStatus) DeepCopy() *DNSRecordStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecords) DeepCopyInto(out *DNSRecords) {
+	*out = *in
+	out.Zone = in.Zone
+	if in.APIServerRecordName != nil {
+		in, out := &in.APIServerRecordName, &out.APIServerRecordName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MachineRecords != nil {
+		in, out := &in.MachineRecords, &out.MachineRecords
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecords.
+func (in *DNSRecords) DeepCopy() *DNSRecords {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecords)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSZoneFilter) DeepCopyInto(out *DNSZoneFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSZoneFilter.
+func (in *DNSZoneFilter) DeepCopy() *DNSZoneFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSZoneFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DependentMachineResources) DeepCopyInto(out *DependentMachineResources) {
 	*out = *in
@@ -214,6 +468,16 @@ func (in *DependentMachineResources) DeepCopyInto(out *DependentMachineResources
 		*out = make([]PortStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Trunks != nil {
+		in, out := &in.Trunks, &out.Trunks
+		*out = make([]TrunkStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VolumeStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependentMachineResources.
@@ -290,6 +554,11 @@ func (in *FixedIP) DeepCopyInto(out *FixedIP) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPAddressPoolRef != nil {
+		in, out := &in.IPAddressPoolRef, &out.IPAddressPoolRef
+		*out = new(v1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedIP.
@@ -320,6 +589,11 @@ func (in *ImageFilter) DeepCopyInto(out *ImageFilter) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ImageRef != nil {
+		in, out := &in.ImageRef, &out.ImageRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFilter.
@@ -357,6 +631,86 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerFilter) DeepCopyInto(out *LoadBalancerFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerFilter.
+func (in *LoadBalancerFilter) DeepCopy() *LoadBalancerFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedNetworkOptions) DeepCopyInto(out *ManagedNetworkOptions) {
+	*out = *in
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(ProviderNetwork)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AvailabilityZoneHints != nil {
+		in, out := &in.AvailabilityZoneHints, &out.AvailabilityZoneHints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedNetworkOptions.
+func (in *ManagedNetworkOptions) DeepCopy() *ManagedNetworkOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedNetworkOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRouterOptions) DeepCopyInto(out *ManagedRouterOptions) {
+	*out = *in
+	if in.EnableSNAT != nil {
+		in, out := &in.EnableSNAT, &out.EnableSNAT
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExtraRoutes != nil {
+		in, out := &in.ExtraRoutes, &out.ExtraRoutes
+		*out = make([]RouterRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.HA != nil {
+		in, out := &in.HA, &out.HA
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Distributed != nil {
+		in, out := &in.Distributed, &out.Distributed
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AvailabilityZoneHints != nil {
+		in, out := &in.AvailabilityZoneHints, &out.AvailabilityZoneHints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRouterOptions.
+func (in *ManagedRouterOptions) DeepCopy() *ManagedRouterOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRouterOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSecurityGroups) DeepCopyInto(out *ManagedSecurityGroups) {
 	*out = *in
@@ -367,6 +721,32 @@ func (in *ManagedSecurityGroups) DeepCopyInto(out *ManagedSecurityGroups) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ControlPlaneNodesSecurityGroupRules != nil {
+		in, out := &in.ControlPlaneNodesSecurityGroupRules, &out.ControlPlaneNodesSecurityGroupRules
+		*out = make([]SecurityGroupRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WorkerNodesSecurityGroupRules != nil {
+		in, out := &in.WorkerNodesSecurityGroupRules, &out.WorkerNodesSecurityGroupRules
+		*out = make([]SecurityGroupRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BastionSecurityGroupRules != nil {
+		in, out := &in.BastionSecurityGroupRules, &out.BastionSecurityGroupRules
+		*out = make([]SecurityGroupRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Stateful != nil {
+		in, out := &in.Stateful, &out.Stateful
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSecurityGroups.
@@ -529,13 +909,23 @@ func (in *OpenStackClusterSpec) DeepCopyInto(out *OpenStackClusterSpec) {
 		*out = new(int)
 		**out = **in
 	}
-	if in.ExternalRouterIPs != nil {
+	if in.ManagedNetwork != nil {
+		in, out := &in.ManagedNetwork, &out.ManagedNetwork
+		*out = new(ManagedNetworkOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalRouterIPs != nil {
 		in, out := &in.ExternalRouterIPs, &out.ExternalRouterIPs
 		*out = make([]ExternalRouterIPParam, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ManagedRouter != nil {
+		in, out := &in.ManagedRouter, &out.ManagedRouter
+		*out = new(ManagedRouterOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExternalNetwork != nil {
 		in, out := &in.ExternalNetwork, &out.ExternalNetwork
 		*out = new(NetworkFilter)
@@ -556,6 +946,11 @@ func (in *OpenStackClusterSpec) DeepCopyInto(out *OpenStackClusterSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.APIServerVIP != nil {
+		in, out := &in.APIServerVIP, &out.APIServerVIP
+		*out = new(APIServerVIP)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.APIServerFloatingIP != nil {
 		in, out := &in.APIServerFloatingIP, &out.APIServerFloatingIP
 		*out = new(string)
@@ -586,11 +981,21 @@ func (in *OpenStackClusterSpec) DeepCopyInto(out *OpenStackClusterSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResourceNaming != nil {
+		in, out := &in.ResourceNaming, &out.ResourceNaming
+		*out = new(ResourceNaming)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ControlPlaneEndpoint != nil {
 		in, out := &in.ControlPlaneEndpoint, &out.ControlPlaneEndpoint
 		*out = new(apiv1beta1.APIEndpoint)
 		**out = **in
 	}
+	if in.DNSRecords != nil {
+		in, out := &in.DNSRecords, &out.DNSRecords
+		*out = new(DNSRecords)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ControlPlaneAvailabilityZones != nil {
 		in, out := &in.ControlPlaneAvailabilityZones, &out.ControlPlaneAvailabilityZones
 		*out = make([]string, len(*in))
@@ -642,6 +1047,16 @@ func (in *OpenStackClusterStatus) DeepCopyInto(out *OpenStackClusterStatus) {
 		*out = new(LoadBalancer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.APIServerVIP != nil {
+		in, out := &in.APIServerVIP, &out.APIServerVIP
+		*out = new(APIServerVIPStatus)
+		**out = **in
+	}
+	if in.APIServerDNSRecord != nil {
+		in, out := &in.APIServerDNSRecord, &out.APIServerDNSRecord
+		*out = new(DNSRecordStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FailureDomains != nil {
 		in, out := &in.FailureDomains, &out.FailureDomains
 		*out = make(apiv1beta1.FailureDomains, len(*in))
@@ -679,6 +1094,13 @@ func (in *OpenStackClusterStatus) DeepCopyInto(out *OpenStackClusterStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(apiv1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackClusterStatus.
@@ -855,6 +1277,166 @@ func (in *OpenStackMachineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachinePool) DeepCopyInto(out *OpenStackMachinePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachinePool.
+func (in *OpenStackMachinePool) DeepCopy() *OpenStackMachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackMachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachinePoolInstanceStatus) DeepCopyInto(out *OpenStackMachinePoolInstanceStatus) {
+	*out = *in
+	if in.InstanceState != nil {
+		in, out := &in.InstanceState, &out.InstanceState
+		*out = new(InstanceState)
+		**out = **in
+	}
+	in.DependentResources.DeepCopyInto(&out.DependentResources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachinePoolInstanceStatus.
+func (in *OpenStackMachinePoolInstanceStatus) DeepCopy() *OpenStackMachinePoolInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachinePoolInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachinePoolList) DeepCopyInto(out *OpenStackMachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackMachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachinePoolList.
+func (in *OpenStackMachinePoolList) DeepCopy() *OpenStackMachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackMachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachinePoolSpec) DeepCopyInto(out *OpenStackMachinePoolSpec) {
+	*out = *in
+	if in.ProviderIDList != nil {
+		in, out := &in.ProviderIDList, &out.ProviderIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachinePoolSpec.
+func (in *OpenStackMachinePoolSpec) DeepCopy() *OpenStackMachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachinePoolStatus) DeepCopyInto(out *OpenStackMachinePoolStatus) {
+	*out = *in
+	if in.ProviderIDList != nil {
+		in, out := &in.ProviderIDList, &out.ProviderIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ReferencedResources.DeepCopyInto(&out.ReferencedResources)
+	if in.TemplateRevisions != nil {
+		in, out := &in.TemplateRevisions, &out.TemplateRevisions
+		*out = make([]OpenStackMachinePoolTemplateRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]OpenStackMachinePoolInstanceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(apiv1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachinePoolStatus.
+func (in *OpenStackMachinePoolStatus) DeepCopy() *OpenStackMachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachinePoolTemplateRevision) DeepCopyInto(out *OpenStackMachinePoolTemplateRevision) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	in.ReferencedResources.DeepCopyInto(&out.ReferencedResources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachinePoolTemplateRevision.
+func (in *OpenStackMachinePoolTemplateRevision) DeepCopy() *OpenStackMachinePoolTemplateRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachinePoolTemplateRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackMachineSpec) DeepCopyInto(out *OpenStackMachineSpec) {
 	*out = *in
@@ -903,104 +1485,344 @@ func (in *OpenStackMachineSpec) DeepCopyInto(out *OpenStackMachineSpec) {
 		*out = new(RootVolume)
 		**out = **in
 	}
-	if in.AdditionalBlockDevices != nil {
-		in, out := &in.AdditionalBlockDevices, &out.AdditionalBlockDevices
-		*out = make([]AdditionalBlockDevice, len(*in))
+	if in.AdditionalBlockDevices != nil {
+		in, out := &in.AdditionalBlockDevices, &out.AdditionalBlockDevices
+		*out = make([]AdditionalBlockDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServerGroup != nil {
+		in, out := &in.ServerGroup, &out.ServerGroup
+		*out = new(ServerGroupFilter)
+		**out = **in
+	}
+	if in.IdentityRef != nil {
+		in, out := &in.IdentityRef, &out.IdentityRef
+		*out = new(OpenStackIdentityReference)
+		**out = **in
+	}
+	if in.FloatingIPPoolRef != nil {
+		in, out := &in.FloatingIPPoolRef, &out.FloatingIPPoolRef
+		*out = new(v1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineSpec.
+func (in *OpenStackMachineSpec) DeepCopy() *OpenStackMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachineStatus) DeepCopyInto(out *OpenStackMachineStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]v1.NodeAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceState != nil {
+		in, out := &in.InstanceState, &out.InstanceState
+		*out = new(InstanceState)
+		**out = **in
+	}
+	in.ReferencedResources.DeepCopyInto(&out.ReferencedResources)
+	in.DependentResources.DeepCopyInto(&out.DependentResources)
+	if in.DNSRecord != nil {
+		in, out := &in.DNSRecord, &out.DNSRecord
+		*out = new(DNSRecordStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(errors.MachineStatusError)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(apiv1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineStatus.
+func (in *OpenStackMachineStatus) DeepCopy() *OpenStackMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachineTemplate) DeepCopyInto(out *OpenStackMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineTemplate.
+func (in *OpenStackMachineTemplate) DeepCopy() *OpenStackMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachineTemplateList) DeepCopyInto(out *OpenStackMachineTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackMachineTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineTemplateList.
+func (in *OpenStackMachineTemplateList) DeepCopy() *OpenStackMachineTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachineTemplateResource) DeepCopyInto(out *OpenStackMachineTemplateResource) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineTemplateResource.
+func (in *OpenStackMachineTemplateResource) DeepCopy() *OpenStackMachineTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachineTemplateSpec) DeepCopyInto(out *OpenStackMachineTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineTemplateSpec.
+func (in *OpenStackMachineTemplateSpec) DeepCopy() *OpenStackMachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackMachineTemplateStatus) DeepCopyInto(out *OpenStackMachineTemplateStatus) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(apiv1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineTemplateStatus.
+func (in *OpenStackMachineTemplateStatus) DeepCopy() *OpenStackMachineTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackMachineTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackRemediation) DeepCopyInto(out *OpenStackRemediation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackRemediation.
+func (in *OpenStackRemediation) DeepCopy() *OpenStackRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackRemediation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackRemediationList) DeepCopyInto(out *OpenStackRemediationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackRemediation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ServerGroup != nil {
-		in, out := &in.ServerGroup, &out.ServerGroup
-		*out = new(ServerGroupFilter)
-		**out = **in
-	}
-	if in.IdentityRef != nil {
-		in, out := &in.IdentityRef, &out.IdentityRef
-		*out = new(OpenStackIdentityReference)
-		**out = **in
-	}
-	if in.FloatingIPPoolRef != nil {
-		in, out := &in.FloatingIPPoolRef, &out.FloatingIPPoolRef
-		*out = new(v1.TypedLocalObjectReference)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineSpec.
-func (in *OpenStackMachineSpec) DeepCopy() *OpenStackMachineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackRemediationList.
+func (in *OpenStackRemediationList) DeepCopy() *OpenStackRemediationList {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenStackMachineSpec)
+	out := new(OpenStackRemediationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackRemediationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenStackMachineStatus) DeepCopyInto(out *OpenStackMachineStatus) {
+func (in *OpenStackRemediationSpec) DeepCopyInto(out *OpenStackRemediationSpec) {
 	*out = *in
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]v1.NodeAddress, len(*in))
-		copy(*out, *in)
-	}
-	if in.InstanceState != nil {
-		in, out := &in.InstanceState, &out.InstanceState
-		*out = new(InstanceState)
+	if in.SoftRebootTimeout != nil {
+		in, out := &in.SoftRebootTimeout, &out.SoftRebootTimeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	in.ReferencedResources.DeepCopyInto(&out.ReferencedResources)
-	in.DependentResources.DeepCopyInto(&out.DependentResources)
-	if in.FailureReason != nil {
-		in, out := &in.FailureReason, &out.FailureReason
-		*out = new(errors.MachineStatusError)
+	if in.HardRebootTimeout != nil {
+		in, out := &in.HardRebootTimeout, &out.HardRebootTimeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.FailureMessage != nil {
-		in, out := &in.FailureMessage, &out.FailureMessage
-		*out = new(string)
+	if in.RebuildTimeout != nil {
+		in, out := &in.RebuildTimeout, &out.RebuildTimeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make(apiv1beta1.Conditions, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackRemediationSpec.
+func (in *OpenStackRemediationSpec) DeepCopy() *OpenStackRemediationSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(OpenStackRemediationSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineStatus.
-func (in *OpenStackMachineStatus) DeepCopy() *OpenStackMachineStatus {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackRemediationStatus) DeepCopyInto(out *OpenStackRemediationStatus) {
+	*out = *in
+	if in.LastRemediated != nil {
+		in, out := &in.LastRemediated, &out.LastRemediated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackRemediationStatus.
+func (in *OpenStackRemediationStatus) DeepCopy() *OpenStackRemediationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenStackMachineStatus)
+	out := new(OpenStackRemediationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenStackMachineTemplate) DeepCopyInto(out *OpenStackMachineTemplate) {
+func (in *OpenStackRemediationTemplate) DeepCopyInto(out *OpenStackRemediationTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineTemplate.
-func (in *OpenStackMachineTemplate) DeepCopy() *OpenStackMachineTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackRemediationTemplate.
+func (in *OpenStackRemediationTemplate) DeepCopy() *OpenStackRemediationTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenStackMachineTemplate)
+	out := new(OpenStackRemediationTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenStackMachineTemplate) DeepCopyObject() runtime.Object {
+func (in *OpenStackRemediationTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1008,31 +1830,31 @@ func (in *OpenStackMachineTemplate) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenStackMachineTemplateList) DeepCopyInto(out *OpenStackMachineTemplateList) {
+func (in *OpenStackRemediationTemplateList) DeepCopyInto(out *OpenStackRemediationTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OpenStackMachineTemplate, len(*in))
+		*out = make([]OpenStackRemediationTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineTemplateList.
-func (in *OpenStackMachineTemplateList) DeepCopy() *OpenStackMachineTemplateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackRemediationTemplateList.
+func (in *OpenStackRemediationTemplateList) DeepCopy() *OpenStackRemediationTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenStackMachineTemplateList)
+	out := new(OpenStackRemediationTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenStackMachineTemplateList) DeepCopyObject() runtime.Object {
+func (in *OpenStackRemediationTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1040,33 +1862,33 @@ func (in *OpenStackMachineTemplateList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenStackMachineTemplateResource) DeepCopyInto(out *OpenStackMachineTemplateResource) {
+func (in *OpenStackRemediationTemplateResource) DeepCopyInto(out *OpenStackRemediationTemplateResource) {
 	*out = *in
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineTemplateResource.
-func (in *OpenStackMachineTemplateResource) DeepCopy() *OpenStackMachineTemplateResource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackRemediationTemplateResource.
+func (in *OpenStackRemediationTemplateResource) DeepCopy() *OpenStackRemediationTemplateResource {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenStackMachineTemplateResource)
+	out := new(OpenStackRemediationTemplateResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenStackMachineTemplateSpec) DeepCopyInto(out *OpenStackMachineTemplateSpec) {
+func (in *OpenStackRemediationTemplateSpec) DeepCopyInto(out *OpenStackRemediationTemplateSpec) {
 	*out = *in
 	in.Template.DeepCopyInto(&out.Template)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMachineTemplateSpec.
-func (in *OpenStackMachineTemplateSpec) DeepCopy() *OpenStackMachineTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackRemediationTemplateSpec.
+func (in *OpenStackRemediationTemplateSpec) DeepCopy() *OpenStackRemediationTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenStackMachineTemplateSpec)
+	out := new(OpenStackRemediationTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1150,6 +1972,21 @@ func (in *PortOpts) DeepCopyInto(out *PortOpts) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.QoSPolicy != nil {
+		in, out := &in.QoSPolicy, &out.QoSPolicy
+		*out = new(QoSPolicyFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSName != nil {
+		in, out := &in.DNSName, &out.DNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSDomain != nil {
+		in, out := &in.DNSDomain, &out.DNSDomain
+		*out = new(string)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]string, len(*in))
@@ -1187,6 +2024,42 @@ func (in *PortStatus) DeepCopy() *PortStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderNetwork) DeepCopyInto(out *ProviderNetwork) {
+	*out = *in
+	if in.SegmentationID != nil {
+		in, out := &in.SegmentationID, &out.SegmentationID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderNetwork.
+func (in *ProviderNetwork) DeepCopy() *ProviderNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicyFilter) DeepCopyInto(out *QoSPolicyFilter) {
+	*out = *in
+	in.FilterByNeutronTags.DeepCopyInto(&out.FilterByNeutronTags)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicyFilter.
+func (in *QoSPolicyFilter) DeepCopy() *QoSPolicyFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicyFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReferencedMachineResources) DeepCopyInto(out *ReferencedMachineResources) {
 	*out = *in
@@ -1209,6 +2082,76 @@ func (in *ReferencedMachineResources) DeepCopy() *ReferencedMachineResources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceNaming) DeepCopyInto(out *ResourceNaming) {
+	*out = *in
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.Router != nil {
+		in, out := &in.Router, &out.Router
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroup != nil {
+		in, out := &in.SecurityGroup, &out.SecurityGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(string)
+		**out = **in
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(string)
+		**out = **in
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceNaming.
+func (in *ResourceNaming) DeepCopy() *ResourceNaming {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceNaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
+func (in *ResourceReference) DeepCopy() *ResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RootVolume) DeepCopyInto(out *RootVolume) {
 	*out = *in
@@ -1265,6 +2208,21 @@ func (in *RouterFilter) DeepCopy() *RouterFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterRoute) DeepCopyInto(out *RouterRoute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterRoute.
+func (in *RouterRoute) DeepCopy() *RouterRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroupFilter) DeepCopyInto(out *SecurityGroupFilter) {
 	*out = *in
@@ -1324,6 +2282,11 @@ func (in *SecurityGroupRuleSpec) DeepCopyInto(out *SecurityGroupRuleSpec) {
 		*out = make([]ManagedSecurityGroupName, len(*in))
 		copy(*out, *in)
 	}
+	if in.RemoteAddressGroup != nil {
+		in, out := &in.RemoteAddressGroup, &out.RemoteAddressGroup
+		*out = new(AddressGroupFilter)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleSpec.
@@ -1374,6 +2337,11 @@ func (in *SecurityGroupRuleStatus) DeepCopyInto(out *SecurityGroupRuleStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RemoteAddressGroupID != nil {
+		in, out := &in.RemoteAddressGroupID, &out.RemoteAddressGroupID
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleStatus.
@@ -1389,6 +2357,11 @@ func (in *SecurityGroupRuleStatus) DeepCopy() *SecurityGroupRuleStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
 	*out = *in
+	if in.Stateful != nil {
+		in, out := &in.Stateful, &out.Stateful
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]SecurityGroupRuleStatus, len(*in))
@@ -1474,9 +2447,35 @@ func (in *SubnetFilter) DeepCopy() *SubnetFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetPoolFilter) DeepCopyInto(out *SubnetPoolFilter) {
+	*out = *in
+	in.FilterByNeutronTags.DeepCopyInto(&out.FilterByNeutronTags)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPoolFilter.
+func (in *SubnetPoolFilter) DeepCopy() *SubnetPoolFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetPoolFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
+	if in.SubnetPool != nil {
+		in, out := &in.SubnetPool, &out.SubnetPool
+		*out = new(SubnetPoolFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrefixLength != nil {
+		in, out := &in.PrefixLength, &out.PrefixLength
+		*out = new(int)
+		**out = **in
+	}
 	if in.DNSNameservers != nil {
 		in, out := &in.DNSNameservers, &out.DNSNameservers
 		*out = make([]string, len(*in))
@@ -1499,6 +2498,21 @@ func (in *SubnetSpec) DeepCopy() *SubnetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrunkStatus) DeepCopyInto(out *TrunkStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrunkStatus.
+func (in *TrunkStatus) DeepCopy() *TrunkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrunkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValueSpec) DeepCopyInto(out *ValueSpec) {
 	*out = *in
@@ -1513,3 +2527,33 @@ func (in *ValueSpec) DeepCopy() *ValueSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeFilter) DeepCopyInto(out *VolumeFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeFilter.
+func (in *VolumeFilter) DeepCopy() *VolumeFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStatus.
+func (in *VolumeStatus) DeepCopy() *VolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
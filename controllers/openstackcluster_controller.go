@@ -20,9 +20,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"reflect"
 	"time"
 
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
@@ -36,6 +38,7 @@ import (
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -50,15 +53,20 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/dns"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/loadbalancer"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
 	utils "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/controllers"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
 
 const (
 	BastionInstanceHashAnnotation = "infrastructure.cluster.x-k8s.io/bastion-hash"
+
+	// bastionSSHPort is the port of the bastion exposed by a port forwarding.
+	bastionSSHPort = 22
 )
 
 // OpenStackClusterReconciler reconciles a OpenStackCluster object.
@@ -73,6 +81,7 @@ type OpenStackClusterReconciler struct {
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
 
 func (r *OpenStackClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -129,8 +138,27 @@ func (r *OpenStackClusterReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return r.reconcileDelete(ctx, scope, cluster, openStackCluster)
 	}
 
+	// Resolve and store the OpenStackImage referenced by the bastion, if any
+	if bastion := openStackCluster.Spec.Bastion; bastion != nil && bastion.Enabled {
+		if openStackCluster.Status.Bastion == nil {
+			openStackCluster.Status.Bastion = &infrav1.BastionStatus{}
+		}
+		changed, err := resolveImageRef(ctx, r.Client, openStackCluster.Namespace, &bastion.Instance.Image, &openStackCluster.Status.Bastion.ReferencedResources)
+		if errors.Is(err, errImageNotReady) {
+			scope.Logger().Info("Waiting for the OpenStackImage of the bastion to be ready", "reason", err.Error())
+			return reconcile.Result{RequeueAfter: waitForImageActiveInterval}, nil
+		}
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if changed {
+			// If the referenced resources have changed, we need to update the OpenStackCluster status now.
+			return reconcile.Result{}, nil
+		}
+	}
+
 	// Handle non-deleted clusters
-	return reconcileNormal(scope, cluster, openStackCluster)
+	return r.reconcileNormal(ctx, scope, cluster, openStackCluster)
 }
 
 func (r *OpenStackClusterReconciler) reconcileDelete(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) (ctrl.Result, error) {
@@ -170,6 +198,37 @@ func (r *OpenStackClusterReconciler) reconcileDelete(ctx context.Context, scope
 
 	clusterName := fmt.Sprintf("%s-%s", cluster.Namespace, cluster.Name)
 
+	// The API server floating IP is not deleted together with the control
+	// plane machines. Delete it unless it was given explicitly.
+	if usesAPIServerFloatingIP(openStackCluster) && openStackCluster.Spec.APIServerFloatingIP == nil {
+		if address := getControlPlaneEndpointAddress(openStackCluster); net.ParseIP(address) != nil {
+			if err = networkingService.DeleteFloatingIP(openStackCluster, address); err != nil {
+				handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete API server floating IP: %w", err))
+				return reconcile.Result{}, fmt.Errorf("failed to delete API server floating IP: %w", err)
+			}
+		}
+	}
+
+	if openStackCluster.Spec.APIServerVIP.IsEnabled() || openStackCluster.Status.APIServerVIP != nil {
+		if err = networkingService.DeleteAPIServerVIP(openStackCluster, clusterName); err != nil {
+			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete API server VIP: %w", err))
+			return reconcile.Result{}, fmt.Errorf("failed to delete API server VIP: %w", err)
+		}
+	}
+
+	if openStackCluster.Status.APIServerDNSRecord != nil {
+		dnsService, err := dns.NewService(scope)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if err = dnsService.DeleteRecords(openStackCluster, clusterName, openStackCluster.Status.APIServerDNSRecord); err != nil {
+			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete DNS records: %w", err))
+			return reconcile.Result{}, fmt.Errorf("failed to delete DNS records: %w", err)
+		}
+		openStackCluster.Status.APIServerDNSRecord = nil
+	}
+
 	if openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() {
 		loadBalancerService, err := loadbalancer.NewService(scope)
 		if err != nil {
@@ -234,7 +293,11 @@ func resolveBastionResources(scope *scope.WithLogger, openStackCluster *infrav1.
 			return true, nil
 		}
 
-		changed, err = compute.ResolveDependentBastionResources(scope, openStackCluster, bastionName(openStackCluster.Name))
+		name, err := bastionName(openStackCluster, openStackCluster.Name)
+		if err != nil {
+			return false, err
+		}
+		changed, err = compute.ResolveDependentBastionResources(scope, openStackCluster, name)
 		if err != nil {
 			return false, err
 		}
@@ -258,11 +321,12 @@ func deleteBastion(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStac
 		return err
 	}
 
-	if openStackCluster.Status.Bastion != nil && openStackCluster.Status.Bastion.FloatingIP != "" {
-		if err = networkingService.DeleteFloatingIP(openStackCluster, openStackCluster.Status.Bastion.FloatingIP); err != nil {
-			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete floating IP: %w", err))
-			return fmt.Errorf("failed to delete floating IP: %w", err)
-		}
+	if err = deleteBastionPortForwarding(openStackCluster, networkingService); err != nil {
+		return err
+	}
+
+	if err = deleteBastionFloatingIP(openStackCluster, networkingService); err != nil {
+		return err
 	}
 
 	var instanceStatus *compute.InstanceStatus
@@ -272,7 +336,11 @@ func deleteBastion(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStac
 			return err
 		}
 	} else {
-		instanceStatus, err = computeService.GetInstanceStatusByName(openStackCluster, bastionName(cluster.Name))
+		name, err := bastionName(openStackCluster, cluster.Name)
+		if err != nil {
+			return err
+		}
+		instanceStatus, err = computeService.GetInstanceStatusByName(openStackCluster, name)
 		if err != nil {
 			return err
 		}
@@ -300,7 +368,10 @@ func deleteBastion(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStac
 			return err
 		}
 		if err = computeService.DeleteInstance(openStackCluster, instanceStatus, instanceSpec); err != nil {
-			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete bastion: %w", err))
+			// Existing volumes are detached asynchronously, so retry until they are
+			if !errors.Is(err, compute.ErrExistingVolumeDetaching) {
+				handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete bastion: %w", err))
+			}
 			return fmt.Errorf("failed to delete bastion: %w", err)
 		}
 	}
@@ -327,7 +398,7 @@ func deleteBastion(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStac
 	return nil
 }
 
-func reconcileNormal(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) (ctrl.Result, error) { //nolint:unparam
+func (r *OpenStackClusterReconciler) reconcileNormal(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) (ctrl.Result, error) { //nolint:unparam
 	scope.Logger().Info("Reconciling Cluster")
 
 	// If the OpenStackCluster doesn't have our finalizer, add it.
@@ -336,6 +407,13 @@ func reconcileNormal(scope *scope.WithLogger, cluster *clusterv1.Cluster, openSt
 		return reconcile.Result{}, nil
 	}
 
+	// Record the UID the OpenStack resources are tagged with before creating any, so that they are still
+	// found when the cluster is moved and its UID changes.
+	if openStackCluster.Annotations[infrav1.ClusterUIDAnnotation] == "" {
+		annotations.AddAnnotations(openStackCluster, map[string]string{infrav1.ClusterUIDAnnotation: string(openStackCluster.UID)})
+		return reconcile.Result{}, nil
+	}
+
 	computeService, err := compute.NewService(scope)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -346,6 +424,11 @@ func reconcileNormal(scope *scope.WithLogger, cluster *clusterv1.Cluster, openSt
 		return reconcile.Result{}, err
 	}
 
+	err = r.reconcileAPIServerFloatingIP(ctx, scope, cluster, openStackCluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	result, err := reconcileBastion(scope, cluster, openStackCluster)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -488,9 +571,96 @@ func reconcileBastion(scope *scope.WithLogger, cluster *clusterv1.Cluster, openS
 		return nil, err
 	}
 
+	if openStackCluster.Spec.Bastion.PortForwarding != nil {
+		// The bastion may have been switched from a floating IP to a port forwarding.
+		if err := deleteBastionFloatingIP(openStackCluster, networkingService); err != nil {
+			return nil, err
+		}
+		return bastionAddPortForwarding(openStackCluster, port, networkingService)
+	}
+	if err := deleteBastionPortForwarding(openStackCluster, networkingService); err != nil {
+		return nil, err
+	}
 	return bastionAddFloatingIP(openStackCluster, clusterName, port, networkingService)
 }
 
+// bastionAddPortForwarding exposes the SSH port of the bastion through a port
+// forwarding on the floating IP given in the bastion spec.
+func bastionAddPortForwarding(openStackCluster *infrav1.OpenStackCluster, port *ports.Port, networkingService *networking.Service) (*reconcile.Result, error) {
+	spec := openStackCluster.Spec.Bastion.PortForwarding
+	externalPort := pointer.IntDeref(spec.ExternalPort, bastionSSHPort)
+
+	// Remove a port forwarding on another floating IP or port, e.g. after the spec was changed.
+	if status := openStackCluster.Status.Bastion.PortForwarding; status != nil && (status.FloatingIP != spec.FloatingIP || status.ExternalPort != externalPort) {
+		if err := deleteBastionPortForwarding(openStackCluster, networkingService); err != nil {
+			return nil, err
+		}
+	}
+
+	fp, err := networkingService.GetFloatingIP(spec.FloatingIP)
+	if err != nil {
+		handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to get floating IP for bastion port forwarding: %w", err))
+		return nil, fmt.Errorf("failed to get floating IP for bastion port forwarding: %w", err)
+	}
+	if fp == nil {
+		err = fmt.Errorf("floating IP %s for bastion port forwarding not found", spec.FloatingIP)
+		handleUpdateOSCError(openStackCluster, err)
+		return nil, err
+	}
+
+	pf, err := networkingService.GetOrCreatePortForwarding(openStackCluster, fp, port, bastionSSHPort, externalPort)
+	if err != nil {
+		handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to get or create port forwarding for bastion: %w", err))
+		return nil, fmt.Errorf("failed to get or create port forwarding for bastion: %w", err)
+	}
+	openStackCluster.Status.Bastion.PortForwarding = &infrav1.BastionPortForwardingStatus{
+		ID:           pf.ID,
+		FloatingIPID: fp.ID,
+		FloatingIP:   fp.FloatingIP,
+		ExternalPort: externalPort,
+	}
+
+	return nil, nil
+}
+
+// deleteBastionPortForwarding deletes the port forwarding exposing the bastion, if any.
+// The floating IP of the port forwarding is not deleted, as it is not managed by the cluster.
+func deleteBastionPortForwarding(openStackCluster *infrav1.OpenStackCluster, networkingService *networking.Service) error {
+	if openStackCluster.Status.Bastion == nil || openStackCluster.Status.Bastion.PortForwarding == nil {
+		return nil
+	}
+
+	pf := openStackCluster.Status.Bastion.PortForwarding
+	if err := networkingService.DeletePortForwarding(openStackCluster, pf.FloatingIPID, pf.ID); err != nil {
+		handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete port forwarding: %w", err))
+		return fmt.Errorf("failed to delete port forwarding: %w", err)
+	}
+	openStackCluster.Status.Bastion.PortForwarding = nil
+	return nil
+}
+
+// deleteBastionFloatingIP releases the floating IP of the bastion, if any. The
+// floating IP is only disassociated if it is the one used for the port
+// forwarding of the bastion, otherwise it is deleted.
+func deleteBastionFloatingIP(openStackCluster *infrav1.OpenStackCluster, networkingService *networking.Service) error {
+	if openStackCluster.Status.Bastion == nil || openStackCluster.Status.Bastion.FloatingIP == "" {
+		return nil
+	}
+
+	floatingIP := openStackCluster.Status.Bastion.FloatingIP
+	if bastion := openStackCluster.Spec.Bastion; bastion != nil && bastion.PortForwarding != nil && bastion.PortForwarding.FloatingIP == floatingIP {
+		if err := networkingService.DisassociateFloatingIP(openStackCluster, floatingIP); err != nil {
+			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to disassociate floating IP: %w", err))
+			return fmt.Errorf("failed to disassociate floating IP: %w", err)
+		}
+	} else if err := networkingService.DeleteFloatingIP(openStackCluster, floatingIP); err != nil {
+		handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete floating IP: %w", err))
+		return fmt.Errorf("failed to delete floating IP: %w", err)
+	}
+	openStackCluster.Status.Bastion.FloatingIP = ""
+	return nil
+}
+
 func bastionAddFloatingIP(openStackCluster *infrav1.OpenStackCluster, clusterName string, port *ports.Port, networkingService *networking.Service) (*reconcile.Result, error) {
 	fp, err := networkingService.GetFloatingIPByPortID(port.ID)
 	if err != nil {
@@ -537,8 +707,13 @@ func bastionToInstanceSpec(openStackCluster *infrav1.OpenStackCluster, cluster *
 	if openStackCluster.Status.Bastion == nil {
 		return nil, fmt.Errorf("bastion status is nil")
 	}
+	name, err := bastionName(openStackCluster, cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+
 	instanceSpec := &compute.InstanceSpec{
-		Name:          bastionName(cluster.Name),
+		Name:          name,
 		Flavor:        openStackCluster.Spec.Bastion.Instance.Flavor,
 		SSHKeyName:    openStackCluster.Spec.Bastion.Instance.SSHKeyName,
 		ImageID:       openStackCluster.Status.Bastion.ReferencedResources.ImageID,
@@ -557,12 +732,16 @@ func bastionToInstanceSpec(openStackCluster *infrav1.OpenStackCluster, cluster *
 	instanceSpec.SecurityGroups = getBastionSecurityGroups(openStackCluster)
 
 	instanceSpec.Ports = openStackCluster.Spec.Bastion.Instance.Ports
+	instanceSpec.VolumeNameTemplate = names.GetResourceNaming(openStackCluster).Volume
+	instanceSpec.NameData = names.GetClusterResourceNameData(openStackCluster)
 
 	return instanceSpec, nil
 }
 
-func bastionName(clusterName string) string {
-	return fmt.Sprintf("%s-bastion", clusterName)
+// bastionName returns the name of the bastion of the cluster, rendering the
+// bastion naming template if set.
+func bastionName(openStackCluster *infrav1.OpenStackCluster, clusterName string) (string, error) {
+	return names.GetResourceName(names.GetResourceNaming(openStackCluster).Bastion, names.GetClusterResourceNameData(openStackCluster), fmt.Sprintf("%s-bastion", clusterName))
 }
 
 // getBastionSecurityGroups returns a combination of openStackCluster.Spec.Bastion.Instance.SecurityGroups
@@ -597,9 +776,13 @@ func getOrCreateBastionPorts(openStackCluster *infrav1.OpenStackCluster, network
 
 	securityGroups := getBastionSecurityGroups(openStackCluster)
 	bastionTags := []string{}
-	err := networkingService.CreatePorts(openStackCluster, clusterName, bastionName(clusterName), securityGroups, bastionTags, desiredPorts, dependentResources)
+	name, err := bastionName(openStackCluster, clusterName)
 	if err != nil {
-		return fmt.Errorf("failed to create ports for bastion %s: %w", bastionName(openStackCluster.Name), err)
+		return err
+	}
+	err = networkingService.CreatePorts(openStackCluster, openStackCluster, clusterName, name, securityGroups, bastionTags, desiredPorts, dependentResources)
+	if err != nil {
+		return fmt.Errorf("failed to create ports for bastion %s: %w", name, err)
 	}
 
 	return nil
@@ -648,7 +831,7 @@ func reconcileNetworkComponents(scope *scope.WithLogger, cluster *clusterv1.Clus
 		return fmt.Errorf("failed to reconcile security groups: %w", err)
 	}
 
-	return reconcileControlPlaneEndpoint(scope, networkingService, openStackCluster, clusterName)
+	return reconcileControlPlaneEndpoint(scope, networkingService, cluster, openStackCluster, clusterName)
 }
 
 // reconcilePreExistingNetworkComponents reconciles the cluster network status when the cluster is
@@ -735,13 +918,19 @@ func reconcileProvisionedNetworkComponents(networkingService *networking.Service
 // reconcileControlPlaneEndpoint configures the control plane endpoint for the
 // cluster, creating it if necessary, and updates ControlPlaneEndpoint in the
 // cluster spec.
-func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *networking.Service, openStackCluster *infrav1.OpenStackCluster, clusterName string) error {
+func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *networking.Service, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster, clusterName string) error {
 	// Calculate the port that we will use for the API server
 	apiServerPort := getAPIServerPort(openStackCluster)
 
 	// host must be set by a matching control plane endpoint provider below
 	var host string
 
+	if openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() && openStackCluster.Spec.APIServerVIP.IsEnabled() {
+		err := fmt.Errorf("apiServerVIP cannot be used together with apiServerLoadBalancer")
+		handleUpdateOSCError(openStackCluster, err)
+		return err
+	}
+
 	switch {
 	// API server load balancer is enabled. Create an Octavia load balancer.
 	// Note that we reconcile the load balancer even if the control plane
@@ -768,11 +957,26 @@ func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *n
 			host = openStackCluster.Status.APIServerLoadBalancer.InternalIP
 		}
 
+	// API server VIP is enabled. Reserve a port for the VIP which is held by
+	// the control plane machines. Note that we reconcile the VIP even if the
+	// control plane endpoint is already set.
+	case openStackCluster.Spec.APIServerVIP.IsEnabled():
+		if err := networkingService.ReconcileAPIServerVIP(openStackCluster, clusterName); err != nil {
+			return fmt.Errorf("failed to reconcile API server VIP: %w", err)
+		}
+
+		// Control plane endpoint is the floating IP if one was defined, otherwise the VIP address
+		if openStackCluster.Status.APIServerVIP.FloatingIP != "" {
+			host = openStackCluster.Status.APIServerVIP.FloatingIP
+		} else {
+			host = openStackCluster.Status.APIServerVIP.IP
+		}
+
 	// Control plane endpoint is already set
 	// Note that checking this here means that we don't re-execute any of
 	// the branches below if the control plane endpoint is already set.
 	case openStackCluster.Spec.ControlPlaneEndpoint != nil && openStackCluster.Spec.ControlPlaneEndpoint.IsValid():
-		host = openStackCluster.Spec.ControlPlaneEndpoint.Host
+		host = getControlPlaneEndpointAddress(openStackCluster)
 
 	// API server load balancer is disabled, but floating IP is not. Create
 	// a floating IP to be attached directly to a control plane host.
@@ -798,6 +1002,22 @@ func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *n
 		return err
 	}
 
+	// Publish the endpoint as a DNS record if requested.
+	if usesAPIServerDNSRecord(openStackCluster, host) {
+		dnsService, err := dns.NewService(scope)
+		if err != nil {
+			return err
+		}
+
+		recordName := pointer.StringDeref(openStackCluster.Spec.DNSRecords.APIServerRecordName, fmt.Sprintf("api.%s.%s", cluster.Name, cluster.Namespace))
+		dnsRecord, err := dnsService.ReconcileRecords(openStackCluster, clusterName, openStackCluster.Spec.DNSRecords, recordName, []string{host})
+		if err != nil {
+			return fmt.Errorf("failed to reconcile DNS records of the control plane endpoint: %w", err)
+		}
+		openStackCluster.Status.APIServerDNSRecord = dnsRecord
+		host = dnsRecord.Name
+	}
+
 	openStackCluster.Spec.ControlPlaneEndpoint = &clusterv1.APIEndpoint{
 		Host: host,
 		Port: int32(apiServerPort),
@@ -806,6 +1026,170 @@ func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *n
 	return nil
 }
 
+// usesAPIServerDNSRecord returns true if the given control plane endpoint
+// address must be published as a DNS record. A host which is not an IP
+// address has been set explicitly, or is the name of the record, and is used
+// as it is. An IP address which has already been set as the control plane
+// endpoint cannot be replaced by the name of the record anymore.
+func usesAPIServerDNSRecord(openStackCluster *infrav1.OpenStackCluster, host string) bool {
+	if openStackCluster.Spec.DNSRecords == nil || net.ParseIP(host) == nil {
+		return false
+	}
+	endpoint := openStackCluster.Spec.ControlPlaneEndpoint
+	return endpoint == nil || !endpoint.IsValid() || net.ParseIP(endpoint.Host) == nil
+}
+
+// usesAPIServerFloatingIP returns true if the control plane endpoint of the
+// cluster is a floating IP associated directly with a control plane machine.
+func usesAPIServerFloatingIP(openStackCluster *infrav1.OpenStackCluster) bool {
+	return !openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() &&
+		!openStackCluster.Spec.APIServerVIP.IsEnabled() &&
+		!pointer.BoolDeref(openStackCluster.Spec.DisableAPIServerFloatingIP, false) &&
+		openStackCluster.Spec.ControlPlaneEndpoint != nil && openStackCluster.Spec.ControlPlaneEndpoint.IsValid()
+}
+
+// apiServerFloatingIPCandidate is a control plane machine which the API server
+// floating IP can be associated with.
+type apiServerFloatingIPCandidate struct {
+	machine          *clusterv1.Machine
+	openStackMachine *infrav1.OpenStackMachine
+}
+
+// holds returns true if the floating IP is associated with a port of the machine.
+func (c *apiServerFloatingIPCandidate) holds(fp *floatingips.FloatingIP) bool {
+	for _, port := range c.openStackMachine.Status.DependentResources.Ports {
+		if fp.PortID != "" && port.ID == fp.PortID {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileAPIServerFloatingIP ensures that the API server floating IP of a
+// cluster without an API server load balancer is associated with a control
+// plane machine. The floating IP stays on its current machine while the node
+// of the machine is healthy. Otherwise it is moved to the oldest machine with
+// a healthy node. While no node is healthy, e.g. when the first control plane
+// machine is bootstrapped, an unassociated floating IP is associated with the
+// oldest ready machine.
+func (r *OpenStackClusterReconciler) reconcileAPIServerFloatingIP(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) error {
+	if !usesAPIServerFloatingIP(openStackCluster) {
+		conditions.Delete(openStackCluster, infrav1.APIServerFloatingIPReadyCondition)
+		return nil
+	}
+
+	address := getControlPlaneEndpointAddress(openStackCluster)
+	if net.ParseIP(address) == nil {
+		conditions.Delete(openStackCluster, infrav1.APIServerFloatingIPReadyCondition)
+		return nil
+	}
+
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	fp, err := networkingService.GetFloatingIP(address)
+	if err != nil {
+		return err
+	}
+	if fp == nil {
+		// The floating IP is created together with the first control plane machine
+		conditions.MarkFalse(openStackCluster, infrav1.APIServerFloatingIPReadyCondition, infrav1.WaitingForControlPlaneMachineReason, clusterv1.ConditionSeverityInfo, "Floating IP %s does not exist yet", address)
+		return nil
+	}
+
+	machines, err := collections.GetFilteredMachinesForCluster(ctx, r.Client, cluster, collections.ControlPlaneMachines(cluster.Name), collections.ActiveMachines)
+	if err != nil {
+		return err
+	}
+
+	var candidates []*apiServerFloatingIPCandidate
+	for _, machine := range machines.SortedByCreationTimestamp() {
+		infraRef := machine.Spec.InfrastructureRef
+		if infraRef.Kind != "OpenStackMachine" {
+			continue
+		}
+		openStackMachine := &infrav1.OpenStackMachine{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: machine.Namespace, Name: infraRef.Name}, openStackMachine); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if !openStackMachine.DeletionTimestamp.IsZero() || !openStackMachine.Status.Ready || openStackMachine.Spec.InstanceID == nil {
+			continue
+		}
+		candidates = append(candidates, &apiServerFloatingIPCandidate{machine: machine, openStackMachine: openStackMachine})
+	}
+
+	var holder, healthy *apiServerFloatingIPCandidate
+	for _, candidate := range candidates {
+		if candidate.holds(fp) {
+			holder = candidate
+		}
+		if healthy == nil && conditions.IsTrue(candidate.machine, clusterv1.MachineNodeHealthyCondition) {
+			healthy = candidate
+		}
+	}
+
+	var target *apiServerFloatingIPCandidate
+	switch {
+	case holder != nil && (healthy == nil || conditions.IsTrue(holder.machine, clusterv1.MachineNodeHealthyCondition)):
+		target = holder
+	case healthy != nil:
+		target = healthy
+	case fp.PortID == "" && len(candidates) > 0:
+		target = candidates[0]
+	default:
+		conditions.MarkFalse(openStackCluster, infrav1.APIServerFloatingIPReadyCondition, infrav1.WaitingForControlPlaneMachineReason, clusterv1.ConditionSeverityWarning, "No control plane machine is available for floating IP %s", fp.FloatingIP)
+		return nil
+	}
+
+	if target != holder {
+		scope.Logger().Info("Moving API server floating IP", "ip", fp.FloatingIP, "machine", target.machine.Name)
+
+		computeService, err := compute.NewService(scope)
+		if err != nil {
+			return err
+		}
+		instanceStatus, err := computeService.GetInstanceStatus(*target.openStackMachine.Spec.InstanceID)
+		if err != nil {
+			return err
+		}
+		if instanceStatus == nil {
+			return fmt.Errorf("instance %s of machine %s not found", *target.openStackMachine.Spec.InstanceID, target.machine.Name)
+		}
+		port, err := computeService.GetManagementPort(openStackCluster, instanceStatus)
+		if err != nil {
+			return fmt.Errorf("get management port of machine %s: %w", target.machine.Name, err)
+		}
+		if err := networkingService.AssociateFloatingIP(openStackCluster, fp, port.ID); err != nil {
+			conditions.MarkFalse(openStackCluster, infrav1.APIServerFloatingIPReadyCondition, infrav1.FloatingIPErrorReason, clusterv1.ConditionSeverityError, "Associating floating IP %s with machine %s failed: %v", fp.FloatingIP, target.machine.Name, err)
+			return fmt.Errorf("associate floating IP %q with machine %q: %w", fp.FloatingIP, target.machine.Name, err)
+		}
+	}
+
+	conditions.Set(openStackCluster, &clusterv1.Condition{
+		Type:    infrav1.APIServerFloatingIPReadyCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  infrav1.FloatingIPAssociatedReason,
+		Message: fmt.Sprintf("Floating IP %s is associated with machine %s", fp.FloatingIP, target.machine.Name),
+	})
+	return nil
+}
+
+// getControlPlaneEndpointAddress returns the address of the control plane
+// endpoint. If the endpoint is the DNS record managed for the cluster, this is
+// the address the record resolves to.
+func getControlPlaneEndpointAddress(openStackCluster *infrav1.OpenStackCluster) string {
+	host := openStackCluster.Spec.ControlPlaneEndpoint.Host
+	if dnsRecord := openStackCluster.Status.APIServerDNSRecord; dnsRecord != nil && dnsRecord.Name == host && len(dnsRecord.Addresses) > 0 {
+		return dnsRecord.Addresses[0]
+	}
+	return host
+}
+
 // getAPIServerPort returns the port to use for the API server based on the cluster spec.
 func getAPIServerPort(openStackCluster *infrav1.OpenStackCluster) int {
 	switch {
@@ -861,6 +1245,23 @@ func (r *OpenStackClusterReconciler) SetupWithManager(ctx context.Context, mgr c
 			}),
 			builder.WithPredicates(predicates.ClusterUnpaused(ctrl.LoggerFrom(ctx))),
 		).
+		// The API server floating IP is moved between control plane machines
+		Watches(
+			&clusterv1.Machine{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []reconcile.Request {
+				machine, ok := o.(*clusterv1.Machine)
+				if !ok || !util.IsControlPlaneMachine(machine) {
+					return nil
+				}
+
+				cluster, err := util.GetClusterByName(ctx, r.Client, machine.Namespace, machine.Spec.ClusterName)
+				if err != nil {
+					log.V(4).Error(err, "Failed to get cluster of machine")
+					return nil
+				}
+				return clusterToInfraFn(ctx, cluster)
+			}),
+		).
 		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
 		WithEventFilter(predicates.ResourceIsNotExternallyManaged(ctrl.LoggerFrom(ctx))).
 		Complete(r)
@@ -20,8 +20,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
@@ -32,18 +35,23 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/test/framework"
 	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
 )
 
@@ -792,6 +800,63 @@ func Test_getAPIServerPort(t *testing.T) {
 	}
 }
 
+func Test_usesAPIServerDNSRecord(t *testing.T) {
+	dnsRecords := &infrav1.DNSRecords{Zone: infrav1.DNSZoneFilter{Name: "example.com."}}
+
+	tests := []struct {
+		name     string
+		spec     infrav1.OpenStackClusterSpec
+		host     string
+		expected bool
+	}{
+		{
+			name:     "no DNS records",
+			host:     "203.0.113.10",
+			expected: false,
+		},
+		{
+			name:     "address without control plane endpoint",
+			spec:     infrav1.OpenStackClusterSpec{DNSRecords: dnsRecords},
+			host:     "203.0.113.10",
+			expected: true,
+		},
+		{
+			name: "address of a control plane endpoint set to the record",
+			spec: infrav1.OpenStackClusterSpec{
+				DNSRecords:           dnsRecords,
+				ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: "api.test-cluster.example.com.", Port: 6443},
+			},
+			host:     "203.0.113.10",
+			expected: true,
+		},
+		{
+			name: "host name set as control plane endpoint",
+			spec: infrav1.OpenStackClusterSpec{
+				DNSRecords:           dnsRecords,
+				ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: "api.example.org", Port: 6443},
+			},
+			host:     "api.example.org",
+			expected: false,
+		},
+		{
+			name: "address set as control plane endpoint",
+			spec: infrav1.OpenStackClusterSpec{
+				DNSRecords:           dnsRecords,
+				ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: "203.0.113.10", Port: 6443},
+			},
+			host:     "203.0.113.10",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			openStackCluster := &infrav1.OpenStackCluster{Spec: tt.spec}
+			g.Expect(usesAPIServerDNSRecord(openStackCluster, tt.host)).To(Equal(tt.expected))
+		})
+	}
+}
+
 func TestGetBastionSecurityGroups(t *testing.T) {
 	openStackCluster := &infrav1.OpenStackCluster{
 		Spec: infrav1.OpenStackClusterSpec{
@@ -828,3 +893,247 @@ func TestGetBastionSecurityGroups(t *testing.T) {
 		t.Errorf("Expected security groups %v, but got %v", expectedSecurityGroups, securityGroups)
 	}
 }
+
+func Test_reconcileAPIServerFloatingIP(t *testing.T) {
+	const (
+		namespace   = "test-namespace"
+		clusterName = "test-cluster"
+		apiServerIP = "203.0.113.10"
+		fipID       = "fip-id"
+	)
+
+	healthy := clusterv1.Conditions{{Type: clusterv1.MachineNodeHealthyCondition, Status: corev1.ConditionTrue}}
+	unhealthy := clusterv1.Conditions{{Type: clusterv1.MachineNodeHealthyCondition, Status: corev1.ConditionFalse}}
+
+	type machine struct {
+		name       string
+		portID     string
+		conditions clusterv1.Conditions
+	}
+
+	tests := []struct {
+		name        string
+		machines    []machine
+		fipPortID   string
+		fipNotFound bool
+		wantPortID  string
+		wantStatus  corev1.ConditionStatus
+		wantReason  string
+	}{
+		{
+			name:        "floating IP does not exist yet",
+			fipNotFound: true,
+			wantStatus:  corev1.ConditionFalse,
+			wantReason:  infrav1.WaitingForControlPlaneMachineReason,
+		},
+		{
+			name: "floating IP stays on healthy holder",
+			machines: []machine{
+				{name: "cp-0", portID: "port-0", conditions: healthy},
+				{name: "cp-1", portID: "port-1", conditions: healthy},
+			},
+			fipPortID:  "port-1",
+			wantStatus: corev1.ConditionTrue,
+			wantReason: infrav1.FloatingIPAssociatedReason,
+		},
+		{
+			name: "floating IP moves from unhealthy holder to healthy machine",
+			machines: []machine{
+				{name: "cp-0", portID: "port-0", conditions: unhealthy},
+				{name: "cp-1", portID: "port-1", conditions: healthy},
+			},
+			fipPortID:  "port-0",
+			wantPortID: "port-1",
+			wantStatus: corev1.ConditionTrue,
+			wantReason: infrav1.FloatingIPAssociatedReason,
+		},
+		{
+			name: "floating IP moves from deleted holder to healthy machine",
+			machines: []machine{
+				{name: "cp-1", portID: "port-1", conditions: healthy},
+			},
+			fipPortID:  "port-0",
+			wantPortID: "port-1",
+			wantStatus: corev1.ConditionTrue,
+			wantReason: infrav1.FloatingIPAssociatedReason,
+		},
+		{
+			name: "unassociated floating IP is associated with first machine during bootstrap",
+			machines: []machine{
+				{name: "cp-0", portID: "port-0"},
+			},
+			wantPortID: "port-0",
+			wantStatus: corev1.ConditionTrue,
+			wantReason: infrav1.FloatingIPAssociatedReason,
+		},
+		{
+			name: "no machine available for associated floating IP",
+			machines: []machine{
+				{name: "cp-1", portID: "port-1", conditions: unhealthy},
+			},
+			fipPortID:  "port-0",
+			wantStatus: corev1.ConditionFalse,
+			wantReason: infrav1.WaitingForControlPlaneMachineReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+
+			capiCluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace},
+			}
+			openStackCluster := &infrav1.OpenStackCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace},
+				Spec: infrav1.OpenStackClusterSpec{
+					ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: apiServerIP, Port: 6443},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{Name: "cluster-network"},
+					},
+				},
+			}
+
+			objects := []client.Object{capiCluster, openStackCluster}
+			creationTimestamp := metav1.Now()
+			for i, m := range tt.machines {
+				creationTimestamp = metav1.NewTime(creationTimestamp.Add(time.Duration(i) * time.Minute))
+				objects = append(objects,
+					&clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              m.name,
+							Namespace:         namespace,
+							CreationTimestamp: creationTimestamp,
+							Labels: map[string]string{
+								clusterv1.ClusterNameLabel:         clusterName,
+								clusterv1.MachineControlPlaneLabel: "",
+							},
+						},
+						Spec: clusterv1.MachineSpec{
+							ClusterName: clusterName,
+							InfrastructureRef: corev1.ObjectReference{
+								Kind: "OpenStackMachine",
+								Name: m.name,
+							},
+						},
+						Status: clusterv1.MachineStatus{Conditions: m.conditions},
+					},
+					&infrav1.OpenStackMachine{
+						ObjectMeta: metav1.ObjectMeta{Name: m.name, Namespace: namespace},
+						Spec:       infrav1.OpenStackMachineSpec{InstanceID: pointer.String(m.name + "-id")},
+						Status: infrav1.OpenStackMachineStatus{
+							Ready: true,
+							DependentResources: infrav1.DependentMachineResources{
+								Ports: []infrav1.PortStatus{{ID: m.portID}},
+							},
+						},
+					},
+				)
+			}
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			r := &OpenStackClusterReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			}
+
+			networkClientRecorder := mockScopeFactory.NetworkClient.EXPECT()
+			computeClientRecorder := mockScopeFactory.ComputeClient.EXPECT()
+
+			var fips []floatingips.FloatingIP
+			if !tt.fipNotFound {
+				fips = append(fips, floatingips.FloatingIP{ID: fipID, FloatingIP: apiServerIP, PortID: tt.fipPortID})
+			}
+			networkClientRecorder.ListFloatingIP(floatingips.ListOpts{FloatingIP: apiServerIP}).Return(fips, nil)
+
+			if tt.wantPortID != "" {
+				instanceID := "cp-" + strings.TrimPrefix(tt.wantPortID, "port-") + "-id"
+				server := &clients.ServerExt{}
+				server.ID = instanceID
+				server.Addresses = map[string]interface{}{
+					"cluster-network": []map[string]interface{}{
+						{"addr": "10.0.0.1", "version": 4, "OS-EXT-IPS:type": "fixed"},
+					},
+				}
+				computeClientRecorder.GetServer(instanceID).Return(server, nil)
+				networkClientRecorder.ListPort(ports.ListOpts{
+					DeviceID: instanceID,
+					FixedIPs: []ports.FixedIPOpts{{IPAddress: "10.0.0.1"}},
+					Limit:    1,
+				}).Return([]ports.Port{{ID: tt.wantPortID}}, nil)
+				networkClientRecorder.UpdateFloatingIP(fipID, &floatingips.UpdateOpts{PortID: pointer.String(tt.wantPortID)}).Return(&floatingips.FloatingIP{}, nil)
+				networkClientRecorder.GetFloatingIP(fipID).Return(&floatingips.FloatingIP{Status: "ACTIVE"}, nil)
+			}
+
+			scope := scope.NewWithLogger(mockScopeFactory, logr.Discard())
+			err := r.reconcileAPIServerFloatingIP(context.TODO(), scope, capiCluster, openStackCluster)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			condition := conditions.Get(openStackCluster, infrav1.APIServerFloatingIPReadyCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantStatus))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+		})
+	}
+}
+
+func Test_deleteBastionFloatingIP(t *testing.T) {
+	const (
+		bastionIP = "203.0.113.20"
+		fipID     = "fip-id"
+	)
+
+	tests := []struct {
+		name           string
+		portForwarding *infrav1.BastionPortForwarding
+		wantDelete     bool
+	}{
+		{
+			name:       "floating IP is deleted when the bastion no longer uses it",
+			wantDelete: true,
+		},
+		{
+			name:           "floating IP is deleted when switching to port forwarding on another floating IP",
+			portForwarding: &infrav1.BastionPortForwarding{FloatingIP: "203.0.113.30"},
+			wantDelete:     true,
+		},
+		{
+			name:           "floating IP is disassociated when switching to port forwarding on the same floating IP",
+			portForwarding: &infrav1.BastionPortForwarding{FloatingIP: bastionIP},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+
+			openStackCluster := &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					Bastion: &infrav1.Bastion{Enabled: true, PortForwarding: tt.portForwarding},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Bastion: &infrav1.BastionStatus{FloatingIP: bastionIP},
+				},
+			}
+
+			networkClientRecorder := mockScopeFactory.NetworkClient.EXPECT()
+			networkClientRecorder.ListFloatingIP(floatingips.ListOpts{FloatingIP: bastionIP}).Return([]floatingips.FloatingIP{{ID: fipID, FloatingIP: bastionIP, PortID: "bastion-port"}}, nil)
+			if tt.wantDelete {
+				networkClientRecorder.DeleteFloatingIP(fipID).Return(nil)
+			} else {
+				networkClientRecorder.UpdateFloatingIP(fipID, &floatingips.UpdateOpts{}).Return(&floatingips.FloatingIP{}, nil)
+				networkClientRecorder.GetFloatingIP(fipID).Return(&floatingips.FloatingIP{Status: "DOWN"}, nil)
+			}
+
+			networkingService, err := networking.NewService(scope.NewWithLogger(mockScopeFactory, logr.Discard()))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(deleteBastionFloatingIP(openStackCluster, networkingService)).To(Succeed())
+			g.Expect(openStackCluster.Status.Bastion.FloatingIP).To(BeEmpty())
+		})
+	}
+}
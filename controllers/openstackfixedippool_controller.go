@@ -0,0 +1,417 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	filterconvert "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert/v1alpha7"
+)
+
+const (
+	openStackFixedIPPool = "OpenStackFixedIPPool"
+
+	// checkClaimedFixedIPsInterval is the interval at which a pool checks that
+	// its claimed fixed IPs are still used by a port, as the deletion of a port
+	// does not trigger a reconcile of the pool.
+	checkClaimedFixedIPsInterval = 5 * time.Minute
+)
+
+// OpenStackFixedIPPoolReconciler reconciles a OpenStackFixedIPPool object.
+type OpenStackFixedIPPoolReconciler struct {
+	Client           client.Client
+	Recorder         record.EventRecorder
+	WatchFilterValue string
+	ScopeFactory     scope.Factory
+	CaCertificates   []byte // PEM encoded ca certificates.
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackfixedippools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackfixedippools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims;ipaddressclaims/status,verbs=get;list;watch;update;create;delete
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses;ipaddresses/status,verbs=get;list;watch;create;update;delete
+
+func (r *OpenStackFixedIPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+	pool := &infrav1alpha1.OpenStackFixedIPPool{}
+	if err := r.Client.Get(ctx, req.NamespacedName, pool); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	clientScope, err := r.ScopeFactory.NewClientScopeFromFixedIPPool(ctx, r.Client, pool, r.CaCertificates, log)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	scope := scope.NewWithLogger(clientScope, log)
+
+	// This is done before deleting the pool, because we want to release the fixed IPs of deleted IPAddresses before we delete the pool
+	if err := r.reconcileIPAddresses(ctx, scope, pool); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if pool.ObjectMeta.DeletionTimestamp.IsZero() {
+		// Add finalizer if it does not exist
+		if controllerutil.AddFinalizer(pool, infrav1alpha1.OpenStackFixedIPPoolFinalizer) {
+			return ctrl.Result{}, r.Client.Update(ctx, pool)
+		}
+	} else {
+		// Handle deletion
+		return ctrl.Result{}, r.reconcileDelete(ctx, pool)
+	}
+
+	patchHelper, err := patch.NewHelper(pool, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, pool); err != nil {
+			if reterr == nil {
+				reterr = fmt.Errorf("error patching OpenStackFixedIPPool %s/%s: %w", pool.Namespace, pool.Name, err)
+			}
+		}
+	}()
+
+	if err := r.reconcileNetwork(scope, pool); err != nil {
+		conditions.MarkFalse(pool, infrav1alpha1.OpenStackFixedIPPoolReadyCondition, infrav1.OpenStackErrorReason, clusterv1.ConditionSeverityError, "Failed to resolve network: %v", err)
+		return ctrl.Result{}, err
+	}
+
+	claims := &ipamv1.IPAddressClaimList{}
+	if err := r.Client.List(ctx, claims, client.InNamespace(req.Namespace), client.MatchingFields{infrav1alpha1.OpenStackFixedIPPoolNameIndex: pool.Name}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	for i := range claims.Items {
+		claim := &claims.Items[i]
+		if !claim.ObjectMeta.DeletionTimestamp.IsZero() || claim.Status.AddressRef.Name != "" {
+			continue
+		}
+
+		ipAddress, err := r.getOrCreateIPAddress(ctx, scope, pool, claim)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if ipAddress == nil {
+			// The pool has no free fixed IP, the claim is retried when an IPAddress of the pool is deleted
+			return ctrl.Result{}, nil
+		}
+
+		claim.Status.AddressRef.Name = ipAddress.Name
+		if err := r.Client.Status().Update(ctx, claim); err != nil {
+			log.Error(err, "Failed to update IPAddressClaim status", "claim", claim.Name, "ipaddress", ipAddress.Name)
+			return ctrl.Result{}, err
+		}
+		scope.Logger().Info("Claimed IP", "ip", ipAddress.Spec.Address, "claim", claim.Name)
+	}
+
+	conditions.MarkTrue(pool, infrav1alpha1.OpenStackFixedIPPoolReadyCondition)
+	if len(pool.Status.ClaimedIPs) > 0 {
+		return ctrl.Result{RequeueAfter: checkClaimedFixedIPsInterval}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *OpenStackFixedIPPoolReconciler) reconcileDelete(ctx context.Context, pool *infrav1alpha1.OpenStackFixedIPPool) error {
+	log := ctrl.LoggerFrom(ctx)
+	ipAddresses := &ipamv1.IPAddressList{}
+	if err := r.Client.List(ctx, ipAddresses, client.InNamespace(pool.Namespace), client.MatchingFields{infrav1alpha1.OpenStackFixedIPPoolNameIndex: pool.Name}); err != nil {
+		return err
+	}
+
+	// If there are still IPAddress objects that are not deleted, there are still claims on this pool and we should not delete the
+	// pool because it is needed to release the reserved fixed IPs in openstack
+	if len(ipAddresses.Items) > 0 {
+		log.Info("Waiting for IPAddress to be deleted before deleting OpenStackFixedIPPool")
+		return errors.New("waiting for IPAddress to be deleted, until we can delete the OpenStackFixedIPPool")
+	}
+
+	if controllerutil.RemoveFinalizer(pool, infrav1alpha1.OpenStackFixedIPPoolFinalizer) {
+		log.Info("Removing finalizer from OpenStackFixedIPPool")
+		return r.Client.Update(ctx, pool)
+	}
+	return nil
+}
+
+// reconcileIPAddresses releases the fixed IPs of the deleted IPAddresses of the pool and updates the claimed IPs.
+// Claimed IPs which are not used by any port anymore, e.g. because the port of the machine they were assigned to
+// has been deleted, are reserved again.
+func (r *OpenStackFixedIPPoolReconciler) reconcileIPAddresses(ctx context.Context, scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFixedIPPool) error {
+	ipAddresses := &ipamv1.IPAddressList{}
+	if err := r.Client.List(ctx, ipAddresses, client.InNamespace(pool.Namespace), client.MatchingFields{infrav1alpha1.OpenStackFixedIPPoolNameIndex: pool.Name}); err != nil {
+		return err
+	}
+
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return err
+	}
+	pool.Status.ClaimedIPs = []string{}
+	claimedIPs := map[string]string{}
+
+	for i := range ipAddresses.Items {
+		ipAddress := &ipAddresses.Items[i]
+		if ipAddress.ObjectMeta.DeletionTimestamp.IsZero() {
+			pool.Status.ClaimedIPs = append(pool.Status.ClaimedIPs, ipAddress.Spec.Address)
+			if controllerutil.ContainsFinalizer(ipAddress, infrav1alpha1.DeleteFixedIPFinalizer) {
+				claimedIPs[ipAddress.Spec.Address] = fmt.Sprintf("%s-%s", ipAddress.Namespace, ipAddress.Spec.ClaimRef.Name)
+			}
+			continue
+		}
+
+		if controllerutil.ContainsFinalizer(ipAddress, infrav1alpha1.DeleteFixedIPFinalizer) {
+			// The reservation is already released if the fixed IP has been assigned to the port of a machine
+			if err := networkingService.ReleaseFixedIP(pool, ipAddress.Spec.Address); err != nil {
+				return fmt.Errorf("release fixed IP %q: %w", ipAddress.Spec.Address, err)
+			}
+			controllerutil.RemoveFinalizer(ipAddress, infrav1alpha1.DeleteFixedIPFinalizer)
+			if err := r.Client.Update(ctx, ipAddress); err != nil {
+				return err
+			}
+		}
+	}
+
+	if pool.Status.Network == nil || pool.Status.Subnet == nil {
+		return nil
+	}
+	if err := networkingService.ReserveClaimedFixedIPs(pool, claimedIPs); err != nil {
+		return fmt.Errorf("reserve claimed fixed IPs: %w", err)
+	}
+	return nil
+}
+
+// getOrCreateIPAddress returns the IPAddress of the claim. If it does not exist yet, a fixed IP is reserved for the
+// claim and the IPAddress is created. It returns nil if the pool has no free fixed IP.
+func (r *OpenStackFixedIPPoolReconciler) getOrCreateIPAddress(ctx context.Context, scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFixedIPPool, claim *ipamv1.IPAddressClaim) (*ipamv1.IPAddress, error) {
+	ipAddress := &ipamv1.IPAddress{}
+	err := r.Client.Get(ctx, client.ObjectKey{Name: claim.Name, Namespace: claim.Namespace}, ipAddress)
+	if err == nil || !apierrors.IsNotFound(err) {
+		return ipAddress, err
+	}
+
+	maxIPs := pointer.IntDeref(pool.Spec.MaxIPs, -1)
+	if maxIPs != -1 && len(pool.Status.ClaimedIPs) >= maxIPs {
+		scope.Logger().Info("MaxIPs reached", "pool", pool.Name)
+		conditions.MarkFalse(pool, infrav1alpha1.OpenStackFixedIPPoolReadyCondition, infrav1alpha1.MaxIPsReachedReason, clusterv1.ConditionSeverityError, "Maximum number of IPs reached, we will not allocate more IPs for this pool")
+		return nil, nil
+	}
+
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// The name of the port is derived from the claim, so that a reservation which was created without
+	// the IPAddress object is found again
+	port, err := networkingService.GetOrReserveFixedIP(pool, fmt.Sprintf("%s-%s", claim.Namespace, claim.Name))
+	if err != nil {
+		if errors.Is(err, networking.ErrNoFreeFixedIP) {
+			conditions.MarkFalse(pool, infrav1alpha1.OpenStackFixedIPPoolReadyCondition, infrav1alpha1.PoolExhaustedReason, clusterv1.ConditionSeverityError, "No free IP left in the pool")
+			return nil, nil
+		}
+		conditions.MarkFalse(pool, infrav1alpha1.OpenStackFixedIPPoolReadyCondition, infrav1.OpenStackErrorReason, clusterv1.ConditionSeverityError, "Failed to reserve fixed IP: %v", err)
+		return nil, err
+	}
+
+	prefix, err := netip.ParsePrefix(pool.Status.Subnet.CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %q of subnet %s: %w", pool.Status.Subnet.CIDR, pool.Status.Subnet.ID, err)
+	}
+
+	ipAddress = &ipamv1.IPAddress{
+		ObjectMeta: ctrl.ObjectMeta{
+			Name:      claim.Name,
+			Namespace: claim.Namespace,
+			Finalizers: []string{
+				infrav1alpha1.DeleteFixedIPFinalizer,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: claim.APIVersion,
+					Kind:       claim.Kind,
+					Name:       claim.Name,
+					UID:        claim.UID,
+				},
+			},
+		},
+		Spec: ipamv1.IPAddressSpec{
+			ClaimRef: corev1.LocalObjectReference{
+				Name: claim.Name,
+			},
+			PoolRef: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.String(infrav1alpha1.GroupVersion.Group),
+				Kind:     openStackFixedIPPool,
+				Name:     pool.Name,
+			},
+			Address: networking.GetFixedIPAddress(port),
+			Prefix:  prefix.Bits(),
+		},
+	}
+
+	// Retry creating the IPAddress object
+	err = wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		if err := r.Client.Create(ctx, ipAddress); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+	if err != nil {
+		scope.Logger().Error(err, "Failed to create IPAddress", "ip", ipAddress.Spec.Address)
+		return nil, err
+	}
+	pool.Status.ClaimedIPs = append(pool.Status.ClaimedIPs, ipAddress.Spec.Address)
+	return ipAddress, nil
+}
+
+// reconcileNetwork resolves the network and the subnet the fixed IPs of the pool are reserved in.
+func (r *OpenStackFixedIPPoolReconciler) reconcileNetwork(scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFixedIPPool) error {
+	// If the pool already has a network and a subnet, we don't need to do anything
+	if pool.Status.Network != nil && pool.Status.Subnet != nil {
+		return nil
+	}
+
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	networkList, err := networkingService.GetNetworksByFilter(filterconvert.NetworkFilterToListOpt(&pool.Spec.Network))
+	if err != nil {
+		return fmt.Errorf("failed to find network: %w", err)
+	}
+	if len(networkList) != 1 {
+		return fmt.Errorf("expected filter to match one network, found %d", len(networkList))
+	}
+
+	listOpts := filterconvert.SubnetFilterToListOpts(&pool.Spec.Subnet)
+	listOpts.NetworkID = networkList[0].ID
+	subnetList, err := networkingService.GetSubnetsByFilter(listOpts)
+	if err != nil {
+		return fmt.Errorf("failed to find subnet: %w", err)
+	}
+	if len(subnetList) != 1 {
+		return fmt.Errorf("expected filter to match one subnet of network %s, found %d", networkList[0].ID, len(subnetList))
+	}
+
+	pool.Status.Network = &infrav1alpha7.NetworkStatus{
+		ID:   networkList[0].ID,
+		Name: networkList[0].Name,
+		Tags: networkList[0].Tags,
+	}
+	pool.Status.Subnet = &infrav1alpha7.Subnet{
+		ID:   subnetList[0].ID,
+		Name: subnetList[0].Name,
+		CIDR: subnetList[0].CIDR,
+		Tags: subnetList[0].Tags,
+	}
+	return nil
+}
+
+func (r *OpenStackFixedIPPoolReconciler) ipAddressClaimToPoolMapper(_ context.Context, o client.Object) []ctrl.Request {
+	claim, ok := o.(*ipamv1.IPAddressClaim)
+	if !ok {
+		panic(fmt.Sprintf("Expected a IPAddressClaim but got a %T", o))
+	}
+	if claim.Spec.PoolRef.Kind != openStackFixedIPPool {
+		return nil
+	}
+	return []ctrl.Request{
+		{
+			NamespacedName: client.ObjectKey{
+				Name:      claim.Spec.PoolRef.Name,
+				Namespace: claim.Namespace,
+			},
+		},
+	}
+}
+
+func (r *OpenStackFixedIPPoolReconciler) ipAddressToPoolMapper(_ context.Context, o client.Object) []ctrl.Request {
+	ip, ok := o.(*ipamv1.IPAddress)
+	if !ok {
+		panic(fmt.Sprintf("Expected a IPAddress but got a %T", o))
+	}
+	if ip.Spec.PoolRef.Kind != openStackFixedIPPool {
+		return nil
+	}
+	return []ctrl.Request{
+		{
+			NamespacedName: client.ObjectKey{
+				Name:      ip.Spec.PoolRef.Name,
+				Namespace: ip.Namespace,
+			},
+		},
+	}
+}
+
+func (r *OpenStackFixedIPPoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &ipamv1.IPAddressClaim{}, infrav1alpha1.OpenStackFixedIPPoolNameIndex, func(rawObj client.Object) []string {
+		claim := rawObj.(*ipamv1.IPAddressClaim)
+		if claim.Spec.PoolRef.Kind != openStackFixedIPPool {
+			return nil
+		}
+		return []string{claim.Spec.PoolRef.Name}
+	}); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &ipamv1.IPAddress{}, infrav1alpha1.OpenStackFixedIPPoolNameIndex, func(rawObj client.Object) []string {
+		ip := rawObj.(*ipamv1.IPAddress)
+		if ip.Spec.PoolRef.Kind != openStackFixedIPPool {
+			return nil
+		}
+		return []string{ip.Spec.PoolRef.Name}
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.OpenStackFixedIPPool{}).
+		Watches(
+			&ipamv1.IPAddressClaim{},
+			handler.EnqueueRequestsFromMapFunc(r.ipAddressClaimToPoolMapper),
+		).
+		Watches(
+			&ipamv1.IPAddress{},
+			handler.EnqueueRequestsFromMapFunc(r.ipAddressToPoolMapper),
+		).
+		Complete(r)
+}
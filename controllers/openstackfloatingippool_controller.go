@@ -20,12 +20,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/netip"
+	"slices"
 	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
@@ -40,16 +43,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
-	infrav1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
-	filterconvert "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert/v1alpha7"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert"
 )
 
 const (
-	openStackFloatingIPPool = "OpenStackFloatingIPPool"
+	openStackFloatingIPPool        = "OpenStackFloatingIPPool"
+	openStackClusterFloatingIPPool = "OpenStackClusterFloatingIPPool"
 )
 
 var errMaxIPsReached = errors.New("maximum number of IPs reached")
@@ -61,7 +64,7 @@ var backoff = wait.Backoff{
 	Jitter:   0.1,
 }
 
-// OpenStackFloatingIPPoolReconciler reconciles a OpenStackFloatingIPPool object.
+// OpenStackFloatingIPPoolReconciler reconciles OpenStackFloatingIPPool and OpenStackClusterFloatingIPPool objects.
 type OpenStackFloatingIPPoolReconciler struct {
 	Client           client.Client
 	Recorder         record.EventRecorder
@@ -74,12 +77,19 @@ type OpenStackFloatingIPPoolReconciler struct {
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackfloatingippools,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackfloatingippools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusterfloatingippools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusterfloatingippools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims;ipaddressclaims/status,verbs=get;list;watch;update;create;delete
 // +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses;ipaddresses/status,verbs=get;list;watch;create;update;delete
 
 func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
-	pool := &infrav1alpha1.OpenStackFloatingIPPool{}
+	// OpenStackClusterFloatingIPPool is cluster-scoped, so only its requests have no namespace
+	var pool infrav1alpha2.FloatingIPPool = &infrav1alpha2.OpenStackFloatingIPPool{}
+	if req.Namespace == "" {
+		pool = &infrav1alpha2.OpenStackClusterFloatingIPPool{}
+	}
 	if err := r.Client.Get(ctx, req.NamespacedName, pool); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
@@ -95,9 +105,9 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 		return ctrl.Result{}, err
 	}
 
-	if pool.ObjectMeta.DeletionTimestamp.IsZero() {
+	if pool.GetDeletionTimestamp().IsZero() {
 		// Add finalizer if it does not exist
-		if controllerutil.AddFinalizer(pool, infrav1alpha1.OpenStackFloatingIPPoolFinalizer) {
+		if controllerutil.AddFinalizer(pool, poolFinalizer(pool)) {
 			return ctrl.Result{}, r.Client.Update(ctx, pool)
 		}
 	} else {
@@ -113,7 +123,7 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 	defer func() {
 		if err := patchHelper.Patch(ctx, pool); err != nil {
 			if reterr == nil {
-				reterr = fmt.Errorf("error patching OpenStackFloatingIPPool %s/%s: %w", pool.Namespace, pool.Name, err)
+				reterr = fmt.Errorf("error patching %s %s: %w", poolKind(pool), client.ObjectKeyFromObject(pool), err)
 			}
 		}
 	}()
@@ -122,8 +132,13 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 		return ctrl.Result{}, err
 	}
 
+	if err := r.reconcileFloatingIPSubnets(scope, pool); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The namespace of a cluster-scoped pool is empty, so its claims are listed from all namespaces
 	claims := &ipamv1.IPAddressClaimList{}
-	if err := r.Client.List(context.Background(), claims, client.InNamespace(req.Namespace), client.MatchingFields{infrav1alpha1.OpenStackFloatingIPPoolNameIndex: pool.Name}); err != nil {
+	if err := r.Client.List(context.Background(), claims, client.InNamespace(req.Namespace), client.MatchingFields{poolNameIndex(pool): pool.GetName()}); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -134,6 +149,15 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 			continue
 		}
 
+		allowed, err := r.isNamespaceAllowed(ctx, pool, claim.Namespace)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !allowed {
+			log.V(4).Info("Namespace of IPAddressClaim is not selected by the pool, ignoring it", "namespace", claim.Namespace)
+			continue
+		}
+
 		if claim.Status.AddressRef.Name == "" {
 			ipAddress := &ipamv1.IPAddress{}
 			err := r.Client.Get(ctx, client.ObjectKey{Name: claim.Name, Namespace: claim.Namespace}, ipAddress)
@@ -155,7 +179,7 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 						Name:      claim.Name,
 						Namespace: claim.Namespace,
 						Finalizers: []string{
-							infrav1alpha1.DeleteFloatingIPFinalizer,
+							infrav1alpha2.DeleteFloatingIPFinalizer,
 						},
 						OwnerReferences: []metav1.OwnerReference{
 							{
@@ -171,9 +195,9 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 							Name: claim.Name,
 						},
 						PoolRef: corev1.TypedLocalObjectReference{
-							APIGroup: pointer.String(infrav1alpha1.GroupVersion.Group),
-							Kind:     pool.Kind,
-							Name:     pool.Name,
+							APIGroup: pointer.String(infrav1alpha2.GroupVersion.Group),
+							Kind:     poolKind(pool),
+							Name:     pool.GetName(),
 						},
 						Address: ip,
 						Prefix:  32,
@@ -201,22 +225,28 @@ func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req c
 			scope.Logger().Info("Claimed IP", "ip", ipAddress.Spec.Address)
 		}
 	}
-	conditions.MarkTrue(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition)
+
+	if err := r.reconcileMinAvailableIPs(ctx, scope, pool); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	conditions.MarkTrue(pool, infrav1alpha2.OpenstackFloatingIPPoolReadyCondition)
 	return ctrl.Result{}, r.Client.Status().Update(ctx, pool)
 }
 
-func (r *OpenStackFloatingIPPoolReconciler) reconcileDelete(ctx context.Context, scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool) error {
+func (r *OpenStackFloatingIPPoolReconciler) reconcileDelete(ctx context.Context, scope *scope.WithLogger, pool infrav1alpha2.FloatingIPPool) error {
+	spec, status := pool.GetFloatingIPPoolSpec(), pool.GetFloatingIPPoolStatus()
 	log := ctrl.LoggerFrom(ctx)
 	ipAddresses := &ipamv1.IPAddressList{}
-	if err := r.Client.List(ctx, ipAddresses, client.InNamespace(pool.Namespace), client.MatchingFields{infrav1alpha1.OpenStackFloatingIPPoolNameIndex: pool.Name}); err != nil {
+	if err := r.Client.List(ctx, ipAddresses, client.InNamespace(pool.GetNamespace()), client.MatchingFields{poolNameIndex(pool): pool.GetName()}); err != nil {
 		return err
 	}
 
 	// If there are still IPAddress objects that are not deleted, there are still claims on this pool and we should not delete the
 	// pool because it is needed to clean up the addresses from openstack
 	if len(ipAddresses.Items) > 0 {
-		log.Info("Waiting for IPAddress to be deleted before deleting OpenStackFloatingIPPool")
-		return errors.New("waiting for IPAddress to be deleted, until we can delete the OpenStackFloatingIPPool")
+		log.Info("Waiting for IPAddress to be deleted before deleting " + poolKind(pool))
+		return fmt.Errorf("waiting for IPAddress to be deleted, until we can delete the %s", poolKind(pool))
 	}
 
 	networkingService, err := networking.NewService(scope)
@@ -224,16 +254,16 @@ func (r *OpenStackFloatingIPPoolReconciler) reconcileDelete(ctx context.Context,
 		return err
 	}
 
-	for _, ip := range diff(pool.Status.AvailableIPs, pool.Spec.PreAllocatedFloatingIPs) {
+	for _, ip := range diff(status.AvailableIPs, spec.PreAllocatedFloatingIPs) {
 		if err := networkingService.DeleteFloatingIP(pool, ip); err != nil {
 			return fmt.Errorf("delete floating IP: %w", err)
 		}
 		// Remove the IP from the available IPs, so we don't try to delete it again if the reconcile loop runs again
-		pool.Status.AvailableIPs = diff(pool.Status.AvailableIPs, []string{ip})
+		status.AvailableIPs = diff(status.AvailableIPs, []string{ip})
 	}
 
-	if controllerutil.RemoveFinalizer(pool, infrav1alpha1.OpenStackFloatingIPPoolFinalizer) {
-		log.Info("Removing finalizer from OpenStackFloatingIPPool")
+	if controllerutil.RemoveFinalizer(pool, poolFinalizer(pool)) {
+		log.Info("Removing finalizer from " + poolKind(pool))
 		return r.Client.Update(ctx, pool)
 	}
 	return nil
@@ -269,9 +299,10 @@ func diff(a []string, b []string) []string {
 	return result
 }
 
-func (r *OpenStackFloatingIPPoolReconciler) reconcileIPAddresses(ctx context.Context, scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool) error {
+func (r *OpenStackFloatingIPPoolReconciler) reconcileIPAddresses(ctx context.Context, scope *scope.WithLogger, pool infrav1alpha2.FloatingIPPool) error {
+	spec, status := pool.GetFloatingIPPoolSpec(), pool.GetFloatingIPPoolStatus()
 	ipAddresses := &ipamv1.IPAddressList{}
-	if err := r.Client.List(ctx, ipAddresses, client.InNamespace(pool.Namespace), client.MatchingFields{infrav1alpha1.OpenStackFloatingIPPoolNameIndex: pool.Name}); err != nil {
+	if err := r.Client.List(ctx, ipAddresses, client.InNamespace(pool.GetNamespace()), client.MatchingFields{poolNameIndex(pool): pool.GetName()}); err != nil {
 		return err
 	}
 
@@ -279,39 +310,40 @@ func (r *OpenStackFloatingIPPoolReconciler) reconcileIPAddresses(ctx context.Con
 	if err != nil {
 		return err
 	}
-	pool.Status.ClaimedIPs = []string{}
-	if pool.Status.AvailableIPs == nil {
-		pool.Status.AvailableIPs = []string{}
+	status.ClaimedIPs = []string{}
+	if status.AvailableIPs == nil {
+		status.AvailableIPs = []string{}
 	}
 
 	for i := 0; i < len(ipAddresses.Items); i++ {
 		ipAddress := &(ipAddresses.Items[i])
 		if ipAddress.ObjectMeta.DeletionTimestamp.IsZero() {
-			pool.Status.ClaimedIPs = append(pool.Status.ClaimedIPs, ipAddress.Spec.Address)
+			status.ClaimedIPs = append(status.ClaimedIPs, ipAddress.Spec.Address)
 			continue
 		}
 
-		if controllerutil.ContainsFinalizer(ipAddress, infrav1alpha1.DeleteFloatingIPFinalizer) {
-			if pool.Spec.ReclaimPolicy == infrav1alpha1.ReclaimDelete && !contains(pool.Spec.PreAllocatedFloatingIPs, ipAddress.Spec.Address) {
+		if controllerutil.ContainsFinalizer(ipAddress, infrav1alpha2.DeleteFloatingIPFinalizer) {
+			if spec.ReclaimPolicy == infrav1alpha2.ReclaimDelete && !contains(spec.PreAllocatedFloatingIPs, ipAddress.Spec.Address) {
 				if err = networkingService.DeleteFloatingIP(pool, ipAddress.Spec.Address); err != nil {
 					return fmt.Errorf("delete floating IP %q: %w", ipAddress.Spec.Address, err)
 				}
 			} else {
-				pool.Status.AvailableIPs = append(pool.Status.AvailableIPs, ipAddress.Spec.Address)
+				status.AvailableIPs = append(status.AvailableIPs, ipAddress.Spec.Address)
 			}
 		}
-		controllerutil.RemoveFinalizer(ipAddress, infrav1alpha1.DeleteFloatingIPFinalizer)
+		controllerutil.RemoveFinalizer(ipAddress, infrav1alpha2.DeleteFloatingIPFinalizer)
 		if err := r.Client.Update(ctx, ipAddress); err != nil {
 			return err
 		}
 	}
-	allIPs := union(pool.Status.AvailableIPs, pool.Spec.PreAllocatedFloatingIPs)
-	unclaimedIPs := diff(allIPs, pool.Status.ClaimedIPs)
-	pool.Status.AvailableIPs = diff(unclaimedIPs, pool.Status.FailedIPs)
+	allIPs := union(status.AvailableIPs, spec.PreAllocatedFloatingIPs)
+	unclaimedIPs := diff(allIPs, status.ClaimedIPs)
+	status.AvailableIPs = diff(unclaimedIPs, status.FailedIPs)
 	return nil
 }
 
-func (r *OpenStackFloatingIPPoolReconciler) getIP(ctx context.Context, scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool) (string, error) {
+func (r *OpenStackFloatingIPPoolReconciler) getIP(ctx context.Context, scope *scope.WithLogger, pool infrav1alpha2.FloatingIPPool) (string, error) {
+	spec, status := pool.GetFloatingIPPoolSpec(), pool.GetFloatingIPPoolStatus()
 	// There's a potential leak of IPs here, if the reconcile loop fails after we claim an IP but before we create the IPAddress object.
 	var ip string
 
@@ -323,25 +355,25 @@ func (r *OpenStackFloatingIPPoolReconciler) getIP(ctx context.Context, scope *sc
 
 	// Get tagged floating IPs and add them to the available IPs if they are not present in either the available IPs or the claimed IPs
 	// This is done to prevent leaking floating IPs if the floating IP was created but the IPAddress object was not
-	if len(pool.Status.AvailableIPs) == 0 {
+	if len(status.AvailableIPs) == 0 {
 		taggedIPs, err := networkingService.GetFloatingIPsByTag(pool.GetFloatingIPTag())
 		if err != nil {
-			scope.Logger().Error(err, "Failed to get floating IPs by tag", "pool", pool.Name)
+			scope.Logger().Error(err, "Failed to get floating IPs by tag", "pool", pool.GetName())
 			return "", err
 		}
 		for _, taggedIP := range taggedIPs {
-			if contains(pool.Status.AvailableIPs, taggedIP.FloatingIP) || contains(pool.Status.ClaimedIPs, taggedIP.FloatingIP) {
+			if contains(status.AvailableIPs, taggedIP.FloatingIP) || contains(status.ClaimedIPs, taggedIP.FloatingIP) {
 				continue
 			}
 			scope.Logger().Info("Tagged floating IP found that was not known to the pool, adding it to the pool", "ip", taggedIP.FloatingIP)
-			pool.Status.AvailableIPs = append(pool.Status.AvailableIPs, taggedIP.FloatingIP)
+			status.AvailableIPs = append(status.AvailableIPs, taggedIP.FloatingIP)
 		}
 	}
 
-	if len(pool.Status.AvailableIPs) > 0 {
-		ip = pool.Status.AvailableIPs[0]
-		pool.Status.AvailableIPs = pool.Status.AvailableIPs[1:]
-		pool.Status.ClaimedIPs = append(pool.Status.ClaimedIPs, ip)
+	if len(status.AvailableIPs) > 0 {
+		ip = status.AvailableIPs[0]
+		status.AvailableIPs = status.AvailableIPs[1:]
+		status.ClaimedIPs = append(status.ClaimedIPs, ip)
 	}
 
 	if ip != "" {
@@ -350,49 +382,127 @@ func (r *OpenStackFloatingIPPoolReconciler) getIP(ctx context.Context, scope *sc
 			return "", fmt.Errorf("get floating IP: %w", err)
 		}
 		if fp != nil {
-			pool.Status.ClaimedIPs = append(pool.Status.ClaimedIPs, fp.FloatingIP)
+			status.ClaimedIPs = append(status.ClaimedIPs, fp.FloatingIP)
 			return fp.FloatingIP, nil
 		}
-		pool.Status.FailedIPs = append(pool.Status.FailedIPs, ip)
+		status.FailedIPs = append(status.FailedIPs, ip)
 	}
-	maxIPs := pointer.IntDeref(pool.Spec.MaxIPs, -1)
+	maxIPs := pointer.IntDeref(spec.MaxIPs, -1)
 	// If we have reached the maximum number of IPs, we should not create more IPs
-	if maxIPs != -1 && len(pool.Status.ClaimedIPs) >= maxIPs {
-		scope.Logger().Info("MaxIPs reached", "pool", pool.Name)
-		conditions.MarkFalse(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition, infrav1alpha1.MaxIPsReachedReason, clusterv1.ConditionSeverityError, "Maximum number of IPs reached, we will not allocate more IPs for this pool")
+	if maxIPs != -1 && len(status.ClaimedIPs) >= maxIPs {
+		scope.Logger().Info("MaxIPs reached", "pool", pool.GetName())
+		conditions.MarkFalse(pool, infrav1alpha2.OpenstackFloatingIPPoolReadyCondition, infrav1alpha2.MaxIPsReachedReason, clusterv1.ConditionSeverityError, "Maximum number of IPs reached, we will not allocate more IPs for this pool")
 		return "", errMaxIPsReached
 	}
 
 	fp, err := networkingService.CreateFloatingIPForPool(pool)
 	if err != nil {
-		scope.Logger().Error(err, "Failed to create floating IP", "pool", pool.Name)
-		conditions.MarkFalse(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition, infrav1.OpenStackErrorReason, clusterv1.ConditionSeverityError, "Failed to create floating IP: %v", err)
+		scope.Logger().Error(err, "Failed to create floating IP", "pool", pool.GetName())
+		conditions.MarkFalse(pool, infrav1alpha2.OpenstackFloatingIPPoolReadyCondition, createFloatingIPFailureReason(err), clusterv1.ConditionSeverityError, "Failed to create floating IP: %v", err)
 		return "", err
 	}
+	defer r.tagFloatingIP(ctx, scope, networkingService, pool, fp.FloatingIP)
+
+	conditions.MarkTrue(pool, infrav1alpha2.OpenstackFloatingIPPoolReadyCondition)
+	ip = fp.FloatingIP
+	status.ClaimedIPs = append(status.ClaimedIPs, ip)
+	return ip, nil
+}
+
+// createFloatingIPFailureReason returns the condition reason for a failure to create a floating IP for a pool.
+func createFloatingIPFailureReason(err error) string {
+	if errors.Is(err, networking.ErrFloatingIPAddressForbidden) {
+		return infrav1alpha2.FloatingIPAddressForbiddenReason
+	}
+	return infrav1.OpenStackErrorReason
+}
+
+// tagFloatingIP tags a floating IP created by the pool, so that it can be found again if it is not recorded in the pool status.
+func (r *OpenStackFloatingIPPoolReconciler) tagFloatingIP(ctx context.Context, scope *scope.WithLogger, networkingService *networking.Service, pool infrav1alpha2.FloatingIPPool, ip string) {
+	tag := pool.GetFloatingIPTag()
+
+	err := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		if err := networkingService.TagFloatingIP(ip, tag); err != nil {
+			scope.Logger().Error(err, "Failed to tag floating IP, retrying", "ip", ip, "tag", tag)
+			return false, err
+		}
+		return true, nil
+	})
+	if err != nil {
+		scope.Logger().Error(err, "Failed to tag floating IP", "ip", ip, "tag", tag)
+	}
+}
+
+// reconcileMinAvailableIPs allocates floating IPs in advance until the pool has at least MinAvailableIPs available
+// floating IPs, and updates the statistics of the pool.
+func (r *OpenStackFloatingIPPoolReconciler) reconcileMinAvailableIPs(ctx context.Context, scope *scope.WithLogger, pool infrav1alpha2.FloatingIPPool) error {
+	spec, status := pool.GetFloatingIPPoolSpec(), pool.GetFloatingIPPoolStatus()
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	// The quota is only informational, so failing to get it must not prevent the pool from working
+	remainingQuota, err := networkingService.GetFloatingIPQuotaRemaining()
+	if err != nil {
+		scope.Logger().Error(err, "Failed to get floating IP quota", "pool", pool.GetName())
+	}
 	defer func() {
-		tag := pool.GetFloatingIPTag()
+		status.Statistics = getPoolStatistics(pool, remainingQuota)
+	}()
 
-		err := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
-			if err := networkingService.TagFloatingIP(fp.FloatingIP, tag); err != nil {
-				scope.Logger().Error(err, "Failed to tag floating IP, retrying", "ip", fp.FloatingIP, "tag", tag)
-				return false, err
-			}
-			return true, nil
-		})
+	minAvailableIPs := pointer.IntDeref(spec.MinAvailableIPs, 0)
+	if minAvailableIPs == 0 {
+		conditions.Delete(pool, infrav1alpha2.OpenstackFloatingIPPoolMinAvailableIPsCondition)
+		return nil
+	}
+
+	maxIPs := pointer.IntDeref(spec.MaxIPs, -1)
+	for len(status.AvailableIPs) < minAvailableIPs {
+		if maxIPs != -1 && len(union(status.ClaimedIPs, status.AvailableIPs)) >= maxIPs {
+			conditions.MarkFalse(pool, infrav1alpha2.OpenstackFloatingIPPoolMinAvailableIPsCondition, infrav1alpha2.MaxIPsReachedReason, clusterv1.ConditionSeverityWarning, "Maximum number of IPs reached with %d of %d minimum available IPs", len(status.AvailableIPs), minAvailableIPs)
+			return nil
+		}
+		if remainingQuota != nil && *remainingQuota <= 0 {
+			conditions.MarkFalse(pool, infrav1alpha2.OpenstackFloatingIPPoolMinAvailableIPsCondition, infrav1alpha2.QuotaExceededReason, clusterv1.ConditionSeverityWarning, "Floating IP quota exceeded with %d of %d minimum available IPs", len(status.AvailableIPs), minAvailableIPs)
+			return nil
+		}
+
+		fp, err := networkingService.CreateFloatingIPForPool(pool)
 		if err != nil {
-			scope.Logger().Error(err, "Failed to tag floating IP", "ip", fp.FloatingIP, "tag", tag)
+			conditions.MarkFalse(pool, infrav1alpha2.OpenstackFloatingIPPoolMinAvailableIPsCondition, createFloatingIPFailureReason(err), clusterv1.ConditionSeverityError, "Failed to create floating IP: %v", err)
+			return err
 		}
-	}()
+		r.tagFloatingIP(ctx, scope, networkingService, pool, fp.FloatingIP)
 
-	conditions.MarkTrue(pool, infrav1alpha1.OpenstackFloatingIPPoolReadyCondition)
-	ip = fp.FloatingIP
-	pool.Status.ClaimedIPs = append(pool.Status.ClaimedIPs, ip)
-	return ip, nil
+		scope.Logger().Info("Allocated floating IP to maintain the minimum available IPs", "ip", fp.FloatingIP)
+		status.AvailableIPs = append(status.AvailableIPs, fp.FloatingIP)
+		if remainingQuota != nil {
+			*remainingQuota--
+		}
+	}
+
+	conditions.MarkTrue(pool, infrav1alpha2.OpenstackFloatingIPPoolMinAvailableIPsCondition)
+	return nil
 }
 
-func (r *OpenStackFloatingIPPoolReconciler) reconcileFloatingIPNetwork(scope *scope.WithLogger, pool *infrav1alpha1.OpenStackFloatingIPPool) error {
+func getPoolStatistics(pool infrav1alpha2.FloatingIPPool, remainingQuota *int) *infrav1alpha2.OpenStackFloatingIPPoolStatistics {
+	status := pool.GetFloatingIPPoolStatus()
+	claimedIPs := len(union(status.ClaimedIPs, nil))
+	availableIPs := len(union(status.AvailableIPs, nil))
+	return &infrav1alpha2.OpenStackFloatingIPPoolStatistics{
+		TotalIPs:       claimedIPs + availableIPs,
+		ClaimedIPs:     claimedIPs,
+		AvailableIPs:   availableIPs,
+		FailedIPs:      len(union(status.FailedIPs, nil)),
+		RemainingQuota: remainingQuota,
+	}
+}
+
+func (r *OpenStackFloatingIPPoolReconciler) reconcileFloatingIPNetwork(scope *scope.WithLogger, pool infrav1alpha2.FloatingIPPool) error {
+	spec, status := pool.GetFloatingIPPoolSpec(), pool.GetFloatingIPPoolStatus()
 	// If the pool already has a network, we don't need to do anything
-	if pool.Status.FloatingIPNetwork != nil {
+	if status.FloatingIPNetwork != nil {
 		return nil
 	}
 
@@ -402,7 +512,7 @@ func (r *OpenStackFloatingIPPoolReconciler) reconcileFloatingIPNetwork(scope *sc
 	}
 
 	netListOpts := external.ListOptsExt{
-		ListOptsBuilder: filterconvert.NetworkFilterToListOpt(&pool.Spec.FloatingIPNetwork),
+		ListOptsBuilder: filterconvert.NetworkFilterToListOpts(&spec.FloatingIPNetwork),
 		External:        pointer.Bool(true),
 	}
 
@@ -414,7 +524,7 @@ func (r *OpenStackFloatingIPPoolReconciler) reconcileFloatingIPNetwork(scope *sc
 		return fmt.Errorf("found multiple networks, expects filter to match one (result: %v)", networkList)
 	}
 
-	pool.Status.FloatingIPNetwork = &infrav1alpha7.NetworkStatus{
+	status.FloatingIPNetwork = &infrav1.NetworkStatus{
 		ID:   networkList[0].ID,
 		Name: networkList[0].Name,
 		Tags: networkList[0].Tags,
@@ -422,72 +532,231 @@ func (r *OpenStackFloatingIPPoolReconciler) reconcileFloatingIPNetwork(scope *sc
 	return nil
 }
 
-func (r *OpenStackFloatingIPPoolReconciler) ipAddressClaimToPoolMapper(_ context.Context, o client.Object) []ctrl.Request {
-	claim, ok := o.(*ipamv1.IPAddressClaim)
+// reconcileFloatingIPSubnets resolves the subnets the floating ips of the pool are allocated from. Pre-allocated floating
+// ips outside of these subnets are marked as failed.
+func (r *OpenStackFloatingIPPoolReconciler) reconcileFloatingIPSubnets(scope *scope.WithLogger, pool infrav1alpha2.FloatingIPPool) error {
+	spec, status := pool.GetFloatingIPPoolSpec(), pool.GetFloatingIPPoolStatus()
+	if len(spec.FloatingIPSubnets) == 0 {
+		status.FloatingIPSubnets = nil
+		return nil
+	}
+
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	poolSubnets := []infrav1.Subnet{}
+	for i := range spec.FloatingIPSubnets {
+		listOpts := filterconvert.SubnetFilterToListOpts(&spec.FloatingIPSubnets[i])
+		listOpts.NetworkID = status.FloatingIPNetwork.ID
+
+		subnetList, err := networkingService.GetSubnetsByFilter(listOpts)
+		if err != nil {
+			return fmt.Errorf("failed to find subnets: %w", err)
+		}
+		for _, subnet := range subnetList {
+			if slices.ContainsFunc(poolSubnets, func(s infrav1.Subnet) bool { return s.ID == subnet.ID }) {
+				continue
+			}
+			poolSubnets = append(poolSubnets, infrav1.Subnet{
+				Name: subnet.Name,
+				ID:   subnet.ID,
+				CIDR: subnet.CIDR,
+				Tags: subnet.Tags,
+			})
+		}
+	}
+	status.FloatingIPSubnets = poolSubnets
+
+	for _, ip := range spec.PreAllocatedFloatingIPs {
+		if contains(status.FailedIPs, ip) || isInSubnets(ip, poolSubnets) {
+			continue
+		}
+		scope.Logger().Info("Pre-allocated floating IP is not in a subnet of the pool, it will not be used", "ip", ip)
+		status.FailedIPs = append(status.FailedIPs, ip)
+		status.AvailableIPs = diff(status.AvailableIPs, []string{ip})
+	}
+	return nil
+}
+
+func isInSubnets(ip string, subnets []infrav1.Subnet) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	for _, subnet := range subnets {
+		if prefix, err := netip.ParsePrefix(subnet.CIDR); err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNamespaceAllowed returns whether the pool serves IPAddressClaims of the given namespace. An OpenStackFloatingIPPool
+// only serves its own namespace, which is enforced by the index used to list the claims, while an
+// OpenStackClusterFloatingIPPool serves the namespaces matching its NamespaceSelector, and none without one.
+func (r *OpenStackFloatingIPPoolReconciler) isNamespaceAllowed(ctx context.Context, pool infrav1alpha2.FloatingIPPool, namespace string) (bool, error) {
+	clusterPool, ok := pool.(*infrav1alpha2.OpenStackClusterFloatingIPPool)
 	if !ok {
-		panic(fmt.Sprintf("Expected a IPAddressClaim but got a %T", o))
+		return true, nil
+	}
+	if clusterPool.Spec.NamespaceSelector == nil {
+		return false, nil
 	}
-	if claim.Spec.PoolRef.Kind != openStackFloatingIPPool {
+
+	selector, err := metav1.LabelSelectorAsSelector(clusterPool.Spec.NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid namespace selector: %w", err)
+	}
+
+	ns := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// poolKind returns the kind of the pool, as the TypeMeta of typed objects is not always set.
+func poolKind(pool infrav1alpha2.FloatingIPPool) string {
+	if _, ok := pool.(*infrav1alpha2.OpenStackClusterFloatingIPPool); ok {
+		return openStackClusterFloatingIPPool
+	}
+	return openStackFloatingIPPool
+}
+
+func poolFinalizer(pool infrav1alpha2.FloatingIPPool) string {
+	if _, ok := pool.(*infrav1alpha2.OpenStackClusterFloatingIPPool); ok {
+		return infrav1alpha2.OpenStackClusterFloatingIPPoolFinalizer
+	}
+	return infrav1alpha2.OpenStackFloatingIPPoolFinalizer
+}
+
+func poolNameIndex(pool infrav1alpha2.FloatingIPPool) string {
+	if _, ok := pool.(*infrav1alpha2.OpenStackClusterFloatingIPPool); ok {
+		return infrav1alpha2.OpenStackClusterFloatingIPPoolNameIndex
+	}
+	return infrav1alpha2.OpenStackFloatingIPPoolNameIndex
+}
+
+// poolRequests returns the request for the pool of the given kind referenced from the given namespace.
+func poolRequests(kind string, poolRef corev1.TypedLocalObjectReference, namespace string) []ctrl.Request {
+	if poolRef.Kind != kind {
 		return nil
 	}
+	// OpenStackClusterFloatingIPPool is cluster-scoped
+	if kind == openStackClusterFloatingIPPool {
+		namespace = ""
+	}
 	return []ctrl.Request{
 		{
 			NamespacedName: client.ObjectKey{
-				Name:      claim.Spec.PoolRef.Name,
-				Namespace: claim.Namespace,
+				Name:      poolRef.Name,
+				Namespace: namespace,
 			},
 		},
 	}
 }
 
-func (r *OpenStackFloatingIPPoolReconciler) ipAddressToPoolMapper(_ context.Context, o client.Object) []ctrl.Request {
-	ip, ok := o.(*ipamv1.IPAddress)
-	if !ok {
-		panic(fmt.Sprintf("Expected a IPAddress but got a %T", o))
+func (r *OpenStackFloatingIPPoolReconciler) ipAddressClaimToPoolMapper(kind string) handler.MapFunc {
+	return func(_ context.Context, o client.Object) []ctrl.Request {
+		claim, ok := o.(*ipamv1.IPAddressClaim)
+		if !ok {
+			panic(fmt.Sprintf("Expected a IPAddressClaim but got a %T", o))
+		}
+		return poolRequests(kind, claim.Spec.PoolRef, claim.Namespace)
 	}
-	if ip.Spec.PoolRef.Kind != openStackFloatingIPPool {
+}
+
+func (r *OpenStackFloatingIPPoolReconciler) ipAddressToPoolMapper(kind string) handler.MapFunc {
+	return func(_ context.Context, o client.Object) []ctrl.Request {
+		ip, ok := o.(*ipamv1.IPAddress)
+		if !ok {
+			panic(fmt.Sprintf("Expected a IPAddress but got a %T", o))
+		}
+		return poolRequests(kind, ip.Spec.PoolRef, ip.Namespace)
+	}
+}
+
+// namespaceToClusterPoolMapper requeues the OpenStackClusterFloatingIPPools with a NamespaceSelector when a namespace
+// changes, so that claims of a namespace which becomes selected are served.
+func (r *OpenStackFloatingIPPoolReconciler) namespaceToClusterPoolMapper(ctx context.Context, o client.Object) []ctrl.Request {
+	log := ctrl.LoggerFrom(ctx)
+
+	pools := &infrav1alpha2.OpenStackClusterFloatingIPPoolList{}
+	if err := r.Client.List(ctx, pools); err != nil {
+		log.Error(err, "Failed to list OpenStackClusterFloatingIPPools", "namespace", o.GetName())
 		return nil
 	}
-	return []ctrl.Request{
-		{
-			NamespacedName: client.ObjectKey{
-				Name:      ip.Spec.PoolRef.Name,
-				Namespace: ip.Namespace,
-			},
-		},
+
+	requests := []ctrl.Request{}
+	for _, pool := range pools.Items {
+		if pool.Spec.NamespaceSelector == nil {
+			continue
+		}
+		requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKey{Name: pool.Name}})
 	}
+	return requests
 }
 
-func (r *OpenStackFloatingIPPoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
-	if err := mgr.GetFieldIndexer().IndexField(ctx, &ipamv1.IPAddressClaim{}, infrav1alpha1.OpenStackFloatingIPPoolNameIndex, func(rawObj client.Object) []string {
-		claim := rawObj.(*ipamv1.IPAddressClaim)
-		if claim.Spec.PoolRef.Kind != openStackFloatingIPPool {
+// poolRefNameIndexFunc indexes IPAddressClaims and IPAddresses by the name of the referenced pool of the given kind.
+func poolRefNameIndexFunc(kind string) client.IndexerFunc {
+	return func(rawObj client.Object) []string {
+		var poolRef corev1.TypedLocalObjectReference
+		switch o := rawObj.(type) {
+		case *ipamv1.IPAddressClaim:
+			poolRef = o.Spec.PoolRef
+		case *ipamv1.IPAddress:
+			poolRef = o.Spec.PoolRef
+		}
+		if poolRef.Kind != kind {
 			return nil
 		}
-		return []string{claim.Spec.PoolRef.Name}
-	}); err != nil {
-		return err
+		return []string{poolRef.Name}
 	}
+}
 
-	if err := mgr.GetFieldIndexer().IndexField(ctx, &ipamv1.IPAddress{}, infrav1alpha1.OpenStackFloatingIPPoolNameIndex, func(rawObj client.Object) []string {
-		ip := rawObj.(*ipamv1.IPAddress)
-		if ip.Spec.PoolRef.Kind != openStackFloatingIPPool {
-			return nil
+func (r *OpenStackFloatingIPPoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	indexes := map[string]string{
+		infrav1alpha2.OpenStackFloatingIPPoolNameIndex:        openStackFloatingIPPool,
+		infrav1alpha2.OpenStackClusterFloatingIPPoolNameIndex: openStackClusterFloatingIPPool,
+	}
+	for index, kind := range indexes {
+		if err := mgr.GetFieldIndexer().IndexField(ctx, &ipamv1.IPAddressClaim{}, index, poolRefNameIndexFunc(kind)); err != nil {
+			return err
 		}
-		return []string{ip.Spec.PoolRef.Name}
-	}); err != nil {
+		if err := mgr.GetFieldIndexer().IndexField(ctx, &ipamv1.IPAddress{}, index, poolRefNameIndexFunc(kind)); err != nil {
+			return err
+		}
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha2.OpenStackFloatingIPPool{}).
+		Watches(
+			&ipamv1.IPAddressClaim{},
+			handler.EnqueueRequestsFromMapFunc(r.ipAddressClaimToPoolMapper(openStackFloatingIPPool)),
+		).
+		Watches(
+			&ipamv1.IPAddress{},
+			handler.EnqueueRequestsFromMapFunc(r.ipAddressToPoolMapper(openStackFloatingIPPool)),
+		).
+		Complete(r); err != nil {
 		return err
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&infrav1alpha1.OpenStackFloatingIPPool{}).
+		For(&infrav1alpha2.OpenStackClusterFloatingIPPool{}).
 		Watches(
 			&ipamv1.IPAddressClaim{},
-			handler.EnqueueRequestsFromMapFunc(r.ipAddressClaimToPoolMapper),
+			handler.EnqueueRequestsFromMapFunc(r.ipAddressClaimToPoolMapper(openStackClusterFloatingIPPool)),
 		).
 		Watches(
 			&ipamv1.IPAddress{},
-			handler.EnqueueRequestsFromMapFunc(r.ipAddressToPoolMapper),
+			handler.EnqueueRequestsFromMapFunc(r.ipAddressToPoolMapper(openStackClusterFloatingIPPool)),
+		).
+		Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.namespaceToClusterPoolMapper),
 		).
 		Complete(r)
 }
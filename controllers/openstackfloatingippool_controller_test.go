@@ -0,0 +1,239 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_reconcileMinAvailableIPs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	const (
+		projectID = "project-id"
+		networkID = "external-network-id"
+		newIP     = "203.0.113.20"
+	)
+
+	expectCreateFloatingIP := func(m *mock.MockNetworkClientMockRecorder, pool *infrav1alpha2.OpenStackFloatingIPPool) {
+		m.CreateFloatingIP(floatingips.CreateOpts{
+			FloatingNetworkID: networkID,
+			Description:       "Created by cluster-api-provider-openstack OpenStackFloatingIPPool test-pool",
+		}).Return(&floatingips.FloatingIP{ID: "fip-id", FloatingIP: newIP}, nil)
+		m.ListFloatingIP(floatingips.ListOpts{FloatingIP: newIP}).Return([]floatingips.FloatingIP{{ID: "fip-id", FloatingIP: newIP}}, nil)
+		m.ReplaceAllAttributesTags("floatingips", "fip-id", attributestags.ReplaceAllOpts{Tags: []string{pool.GetFloatingIPTag()}}).Return(nil, nil)
+	}
+
+	tests := []struct {
+		name                string
+		spec                infrav1alpha2.OpenStackFloatingIPPoolSpec
+		status              infrav1alpha2.OpenStackFloatingIPPoolStatus
+		quota               quotas.QuotaDetail
+		expect              func(m *mock.MockNetworkClientMockRecorder, pool *infrav1alpha2.OpenStackFloatingIPPool)
+		wantAvailableIPs    []string
+		wantCondition       corev1.ConditionStatus
+		wantConditionReason string
+		wantStatistics      *infrav1alpha2.OpenStackFloatingIPPoolStatistics
+	}{
+		{
+			name: "without minimum no floating IP is allocated",
+			status: infrav1alpha2.OpenStackFloatingIPPoolStatus{
+				ClaimedIPs:   []string{"203.0.113.10"},
+				AvailableIPs: []string{},
+				FailedIPs:    []string{"203.0.113.11"},
+			},
+			quota:            quotas.QuotaDetail{Limit: -1},
+			wantAvailableIPs: []string{},
+			wantStatistics: &infrav1alpha2.OpenStackFloatingIPPoolStatistics{
+				TotalIPs:   1,
+				ClaimedIPs: 1,
+				FailedIPs:  1,
+			},
+		},
+		{
+			name: "floating IPs are allocated up to the minimum",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				MinAvailableIPs: pointer.Int(2),
+			},
+			status: infrav1alpha2.OpenStackFloatingIPPoolStatus{
+				AvailableIPs: []string{"203.0.113.10"},
+			},
+			quota:            quotas.QuotaDetail{Limit: 10, Used: 4},
+			expect:           expectCreateFloatingIP,
+			wantAvailableIPs: []string{"203.0.113.10", newIP},
+			wantCondition:    corev1.ConditionTrue,
+			wantStatistics: &infrav1alpha2.OpenStackFloatingIPPoolStatistics{
+				TotalIPs:       2,
+				AvailableIPs:   2,
+				RemainingQuota: pointer.Int(5),
+			},
+		},
+		{
+			name: "minimum is not reached when the maximum is reached",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				MaxIPs:          pointer.Int(2),
+				MinAvailableIPs: pointer.Int(2),
+			},
+			status: infrav1alpha2.OpenStackFloatingIPPoolStatus{
+				ClaimedIPs:   []string{"203.0.113.10"},
+				AvailableIPs: []string{"203.0.113.11"},
+			},
+			quota:               quotas.QuotaDetail{Limit: -1},
+			wantAvailableIPs:    []string{"203.0.113.11"},
+			wantCondition:       corev1.ConditionFalse,
+			wantConditionReason: infrav1alpha2.MaxIPsReachedReason,
+			wantStatistics: &infrav1alpha2.OpenStackFloatingIPPoolStatistics{
+				TotalIPs:     2,
+				ClaimedIPs:   1,
+				AvailableIPs: 1,
+			},
+		},
+		{
+			name: "minimum is not reached when the quota is exceeded",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				MinAvailableIPs: pointer.Int(1),
+			},
+			quota:               quotas.QuotaDetail{Limit: 4, Used: 4},
+			wantCondition:       corev1.ConditionFalse,
+			wantConditionReason: infrav1alpha2.QuotaExceededReason,
+			wantStatistics: &infrav1alpha2.OpenStackFloatingIPPoolStatistics{
+				RemainingQuota: pointer.Int(0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			pool := &infrav1alpha2.OpenStackFloatingIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pool"},
+				Spec:       tt.spec,
+				Status:     tt.status,
+			}
+			pool.Status.FloatingIPNetwork = &infrav1.NetworkStatus{ID: networkID}
+
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, projectID)
+			mockScopeFactory.NetworkClient.EXPECT().GetQuotaDetail(projectID).Return(&quotas.QuotaDetailSet{FloatingIP: tt.quota}, nil)
+			if tt.expect != nil {
+				tt.expect(mockScopeFactory.NetworkClient.EXPECT(), pool)
+			}
+
+			r := &OpenStackFloatingIPPoolReconciler{}
+			err := r.reconcileMinAvailableIPs(context.TODO(), scope.NewWithLogger(mockScopeFactory, log), pool)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(pool.Status.AvailableIPs).To(Equal(tt.wantAvailableIPs))
+			g.Expect(pool.Status.Statistics).To(Equal(tt.wantStatistics))
+
+			condition := conditions.Get(pool, infrav1alpha2.OpenstackFloatingIPPoolMinAvailableIPsCondition)
+			if tt.wantCondition == "" {
+				g.Expect(condition).To(BeNil())
+			} else {
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(condition.Status).To(Equal(tt.wantCondition))
+				g.Expect(condition.Reason).To(Equal(tt.wantConditionReason))
+			}
+		})
+	}
+}
+
+func Test_isNamespaceAllowed(t *testing.T) {
+	namespaces := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}},
+	}
+	teamASelector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+
+	tests := []struct {
+		name      string
+		pool      infrav1alpha2.FloatingIPPool
+		namespace string
+		want      bool
+	}{
+		{
+			name:      "OpenStackFloatingIPPool allows its claims",
+			pool:      &infrav1alpha2.OpenStackFloatingIPPool{ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "team-b"}},
+			namespace: "team-b",
+			want:      true,
+		},
+		{
+			name:      "OpenStackClusterFloatingIPPool without selector denies all namespaces",
+			pool:      &infrav1alpha2.OpenStackClusterFloatingIPPool{ObjectMeta: metav1.ObjectMeta{Name: "pool"}},
+			namespace: "team-b",
+			want:      false,
+		},
+		{
+			name: "OpenStackClusterFloatingIPPool with empty selector allows all namespaces",
+			pool: &infrav1alpha2.OpenStackClusterFloatingIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec:       infrav1alpha2.OpenStackClusterFloatingIPPoolSpec{NamespaceSelector: &metav1.LabelSelector{}},
+			},
+			namespace: "team-b",
+			want:      true,
+		},
+		{
+			name: "OpenStackClusterFloatingIPPool allows selected namespace",
+			pool: &infrav1alpha2.OpenStackClusterFloatingIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec:       infrav1alpha2.OpenStackClusterFloatingIPPoolSpec{NamespaceSelector: teamASelector},
+			},
+			namespace: "team-a",
+			want:      true,
+		},
+		{
+			name: "OpenStackClusterFloatingIPPool denies other namespace",
+			pool: &infrav1alpha2.OpenStackClusterFloatingIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec:       infrav1alpha2.OpenStackClusterFloatingIPPoolSpec{NamespaceSelector: teamASelector},
+			},
+			namespace: "team-b",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := &OpenStackFloatingIPPoolReconciler{
+				Client: fake.NewClientBuilder().WithObjects(namespaces...).Build(),
+			}
+			allowed, err := r.isNamespaceAllowed(context.TODO(), tt.pool, tt.namespace)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(allowed).To(Equal(tt.want))
+		})
+	}
+}
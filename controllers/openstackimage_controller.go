@@ -0,0 +1,240 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/image"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+const waitForImageActiveInterval = 30 * time.Second
+
+// OpenStackImageReconciler reconciles a OpenStackImage object.
+type OpenStackImageReconciler struct {
+	Client           client.Client
+	Recorder         record.EventRecorder
+	WatchFilterValue string
+	ScopeFactory     scope.Factory
+	CaCertificates   []byte // PEM encoded ca certificates.
+
+	uploaderOnce sync.Once
+	uploader     *image.Uploader
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackimages,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackimages/status,verbs=get;update;patch
+
+func (r *OpenStackImageReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	openStackImage := &infrav1alpha1.OpenStackImage{}
+	if err := r.Client.Get(ctx, req.NamespacedName, openStackImage); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	patchHelper, err := patch.NewHelper(openStackImage, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the openStackImage when exiting this function so we can persist any OpenStackImage changes.
+	defer func() {
+		if err := patchHelper.Patch(ctx, openStackImage); err != nil {
+			result = ctrl.Result{}
+			reterr = kerrors.NewAggregate([]error{reterr, fmt.Errorf("error patching OpenStackImage %s/%s: %w", openStackImage.Namespace, openStackImage.Name, err)})
+		}
+	}()
+
+	clientScope, err := r.ScopeFactory.NewClientScopeFromImage(ctx, r.Client, openStackImage, r.CaCertificates, log)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	scope := scope.NewWithLogger(clientScope, log)
+
+	// Handle deleted images
+	if !openStackImage.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, r.reconcileDelete(scope, openStackImage)
+	}
+
+	// Handle non-deleted images
+	return r.reconcileNormal(scope, openStackImage)
+}
+
+func (r *OpenStackImageReconciler) reconcileNormal(scope *scope.WithLogger, openStackImage *infrav1alpha1.OpenStackImage) (ctrl.Result, error) {
+	// If the OpenStackImage doesn't have our finalizer, add it.
+	if controllerutil.AddFinalizer(openStackImage, infrav1alpha1.OpenStackImageFinalizer) {
+		// Register the finalizer immediately to avoid orphaning the glance image on delete
+		return reconcile.Result{}, nil
+	}
+
+	imageService, err := image.NewService(scope)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	glanceImage, err := r.getOrCreateImage(scope, imageService, openStackImage)
+	if err != nil {
+		conditions.MarkFalse(openStackImage, infrav1alpha1.OpenStackImageReadyCondition, infrav1.OpenStackErrorReason, clusterv1.ConditionSeverityError, "Failed to create image: %v", err)
+		return reconcile.Result{}, err
+	}
+	openStackImage.Status.ImageID = glanceImage.ID
+	openStackImage.Status.ImageStatus = string(glanceImage.Status)
+
+	switch glanceImage.Status {
+	case images.ImageStatusActive:
+		openStackImage.Status.Ready = true
+		conditions.MarkTrue(openStackImage, infrav1alpha1.OpenStackImageReadyCondition)
+		return reconcile.Result{}, nil
+	case images.ImageStatusKilled, images.ImageStatusDeactivated, images.ImageStatusDeleted, images.ImageStatusPendingDelete:
+		openStackImage.Status.Ready = false
+		conditions.MarkFalse(openStackImage, infrav1alpha1.OpenStackImageReadyCondition, infrav1alpha1.ImageImportFailedReason, clusterv1.ConditionSeverityError, "Image %s has status %s", glanceImage.ID, glanceImage.Status)
+		return reconcile.Result{}, nil
+	}
+
+	openStackImage.Status.Ready = false
+	if err := imageService.ImportImage(r.getUploader(), openStackImage, glanceImage); err != nil {
+		conditions.MarkFalse(openStackImage, infrav1alpha1.OpenStackImageReadyCondition, infrav1alpha1.ImageImportFailedReason, clusterv1.ConditionSeverityError, "Failed to import image: %v", err)
+		return reconcile.Result{}, err
+	}
+	openStackImage.Status.ImageStatus = string(glanceImage.Status)
+
+	scope.Logger().Info("Waiting for image to become active", "id", glanceImage.ID, "status", glanceImage.Status)
+	conditions.MarkFalse(openStackImage, infrav1alpha1.OpenStackImageReadyCondition, infrav1alpha1.ImageImportingReason, clusterv1.ConditionSeverityInfo, "Waiting for image %s to become active", glanceImage.ID)
+	return reconcile.Result{RequeueAfter: waitForImageActiveInterval}, nil
+}
+
+// getOrCreateImage returns the glance image for the OpenStackImage, creating it if it does not exist.
+func (r *OpenStackImageReconciler) getOrCreateImage(scope *scope.WithLogger, imageService *image.Service, openStackImage *infrav1alpha1.OpenStackImage) (*images.Image, error) {
+	if openStackImage.Status.ImageID != "" {
+		glanceImage, err := imageService.GetImage(openStackImage.Status.ImageID)
+		if err != nil {
+			return nil, err
+		}
+		if glanceImage != nil {
+			return glanceImage, nil
+		}
+		scope.Logger().Info("Image no longer exists, recreating it", "id", openStackImage.Status.ImageID)
+	}
+
+	// Look the image up by tag in case it was created but we failed to record it in the status
+	glanceImage, err := imageService.GetImageByTag(openStackImage.GetImageTag())
+	if err != nil {
+		return nil, err
+	}
+	if glanceImage != nil {
+		return glanceImage, nil
+	}
+
+	return imageService.CreateImage(openStackImage)
+}
+
+func (r *OpenStackImageReconciler) reconcileDelete(scope *scope.WithLogger, openStackImage *infrav1alpha1.OpenStackImage) error {
+	scope.Logger().Info("Reconciling OpenStackImage delete")
+
+	if openStackImage.Spec.ReclaimPolicy != infrav1alpha1.ReclaimRetain {
+		imageService, err := image.NewService(scope)
+		if err != nil {
+			return err
+		}
+
+		imageID := openStackImage.Status.ImageID
+		if imageID == "" {
+			glanceImage, err := imageService.GetImageByTag(openStackImage.GetImageTag())
+			if err != nil {
+				return err
+			}
+			if glanceImage != nil {
+				imageID = glanceImage.ID
+			}
+		}
+
+		if imageID != "" {
+			r.getUploader().Cancel(imageID)
+			if err := imageService.DeleteImage(openStackImage, imageID); err != nil {
+				return fmt.Errorf("delete image: %w", err)
+			}
+		}
+	}
+
+	controllerutil.RemoveFinalizer(openStackImage, infrav1alpha1.OpenStackImageFinalizer)
+	scope.Logger().Info("Reconciled OpenStackImage deleted successfully")
+	return nil
+}
+
+// getUploader returns the Uploader which tracks background image data
+// transfers for this reconciler.
+func (r *OpenStackImageReconciler) getUploader() *image.Uploader {
+	r.uploaderOnce.Do(func() {
+		r.uploader = image.NewUploader()
+	})
+	return r.uploader
+}
+
+func (r *OpenStackImageReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.OpenStackImage{}).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
+		Complete(r)
+}
+
+// errImageNotReady is returned by resolveImageRef while the referenced OpenStackImage is not ready.
+var errImageNotReady = errors.New("OpenStackImage is not ready")
+
+// resolveImageRef populates the ImageID in ReferencedMachineResources from the
+// OpenStackImage referenced by the given ImageFilter, if any. It returns
+// errImageNotReady if the referenced OpenStackImage is not ready yet.
+func resolveImageRef(ctx context.Context, ctrlClient client.Client, namespace string, imageFilter *infrav1.ImageFilter, resources *infrav1.ReferencedMachineResources) (changed bool, err error) {
+	if imageFilter.ImageRef == nil || resources.ImageID != "" {
+		return false, nil
+	}
+
+	openStackImage := &infrav1alpha1.OpenStackImage{}
+	if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: imageFilter.ImageRef.Name}, openStackImage); err != nil {
+		return false, fmt.Errorf("get OpenStackImage %s/%s: %w", namespace, imageFilter.ImageRef.Name, err)
+	}
+
+	if !openStackImage.Status.Ready || openStackImage.Status.ImageID == "" {
+		return false, fmt.Errorf("%w: %s/%s", errImageNotReady, namespace, openStackImage.Name)
+	}
+
+	resources.ImageID = openStackImage.Status.ImageID
+	return true, nil
+}
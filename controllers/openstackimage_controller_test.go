@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+func Test_resolveImageRef(t *testing.T) {
+	const imageID = "ce96e584-7ebc-46d6-9e55-987d72e3806c"
+
+	tests := []struct {
+		name        string
+		status      infrav1alpha1.OpenStackImageStatus
+		wantImageID string
+		wantChanged bool
+		wantErrIs   error
+	}{
+		{
+			name:      "Image which is not ready",
+			status:    infrav1alpha1.OpenStackImageStatus{ImageID: imageID},
+			wantErrIs: errImageNotReady,
+		},
+		{
+			name:        "Ready image",
+			status:      infrav1alpha1.OpenStackImageStatus{Ready: true, ImageID: imageID},
+			wantImageID: imageID,
+			wantChanged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+			openStackImage := &infrav1alpha1.OpenStackImage{
+				ObjectMeta: metav1.ObjectMeta{Name: "ubuntu", Namespace: "default"},
+				Status:     tt.status,
+			}
+			ctrlClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(openStackImage).WithStatusSubresource(openStackImage).Build()
+
+			imageFilter := &infrav1.ImageFilter{ImageRef: &infrav1.ResourceReference{Name: "ubuntu"}}
+			resources := &infrav1.ReferencedMachineResources{}
+			changed, err := resolveImageRef(context.TODO(), ctrlClient, "default", imageFilter, resources)
+			if tt.wantErrIs != nil {
+				g.Expect(err).To(MatchError(tt.wantErrIs))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(changed).To(Equal(tt.wantChanged))
+			g.Expect(resources.ImageID).To(Equal(tt.wantImageID))
+		})
+	}
+}
@@ -21,7 +21,9 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"net"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -52,6 +54,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/dns"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/loadbalancer"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
@@ -71,6 +74,7 @@ const (
 	waitForClusterInfrastructureReadyDuration = 15 * time.Second
 	waitForInstanceBecomeActiveToReconcile    = 60 * time.Second
 	waitForBuildingInstanceToReconcile        = 10 * time.Second
+	waitForExistingVolumeToReconcile          = 10 * time.Second
 )
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackmachines,verbs=get;list;watch;create;update;patch;delete
@@ -153,8 +157,31 @@ func (r *OpenStackMachineReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	}
 	scope := scope.NewWithLogger(clientScope, log)
 
+	// Resolve and store the referenced OpenStackImage, if any
+	if openStackMachine.DeletionTimestamp.IsZero() {
+		changed, err := resolveImageRef(ctx, r.Client, openStackMachine.Namespace, &openStackMachine.Spec.Image, &openStackMachine.Status.ReferencedResources)
+		if errors.Is(err, errImageNotReady) {
+			scope.Logger().Info("Waiting for the referenced OpenStackImage to be ready", "reason", err.Error())
+			conditions.MarkFalse(openStackMachine, infrav1.InstanceReadyCondition, infrav1.WaitingForImageReason, clusterv1.ConditionSeverityInfo, err.Error())
+			return reconcile.Result{RequeueAfter: waitForImageActiveInterval}, nil
+		}
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if changed {
+			// If the referenced resources have changed, we need to update the OpenStackMachine status now.
+			return reconcile.Result{}, nil
+		}
+	}
+
+	// Claim the fixed IP addresses of the ports from IPAM pools, which are required to resolve the ports
+	machineSpec, waitingForFixedAddresses, err := r.reconcileFixedAddressesFromPool(ctx, scope, openStackMachine, infraCluster)
+	if err != nil || waitingForFixedAddresses {
+		return reconcile.Result{}, err
+	}
+
 	// Resolve and store referenced resources
-	changed, err := compute.ResolveReferencedMachineResources(scope, infraCluster, &openStackMachine.Spec, &openStackMachine.Status.ReferencedResources)
+	changed, err := compute.ResolveReferencedMachineResources(scope, infraCluster, machineSpec, &openStackMachine.Status.ReferencedResources)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -164,7 +191,7 @@ func (r *OpenStackMachineReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	}
 
 	// Resolve and store dependent resources
-	changed, err = compute.ResolveDependentMachineResources(scope, openStackMachine)
+	changed, err = compute.ResolveDependentMachineResources(scope, infraCluster, openStackMachine)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -265,6 +292,18 @@ func (r *OpenStackMachineReconciler) reconcileDelete(scope *scope.WithLogger, cl
 		return ctrl.Result{}, err
 	}
 
+	if openStackMachine.Status.DNSRecord != nil {
+		dnsService, err := dns.NewService(scope)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if err := dnsService.DeleteRecords(openStackMachine, clusterName, openStackMachine.Status.DNSRecord); err != nil {
+			return ctrl.Result{}, fmt.Errorf("delete DNS records: %w", err)
+		}
+		openStackMachine.Status.DNSRecord = nil
+	}
+
 	if openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() {
 		loadBalancerService, err := loadbalancer.NewService(scope)
 		if err != nil {
@@ -287,32 +326,46 @@ func (r *OpenStackMachineReconciler) reconcileDelete(scope *scope.WithLogger, cl
 	} else if instanceStatus, err = computeService.GetInstanceStatusByName(openStackMachine, openStackMachine.Name); err != nil {
 		return ctrl.Result{}, err
 	}
-	if !openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() && util.IsControlPlaneMachine(machine) && openStackCluster.Spec.APIServerFloatingIP == nil {
-		if instanceStatus != nil {
-			instanceNS, err := instanceStatus.NetworkStatus()
+	// The API server floating IP outlives the control plane machine holding
+	// it. It is moved to another control plane machine by the cluster
+	// controller and deleted together with the cluster.
+	if util.IsControlPlaneMachine(machine) && usesAPIServerFloatingIP(openStackCluster) {
+		if address := getControlPlaneEndpointAddress(openStackCluster); net.ParseIP(address) != nil {
+			fp, err := networkingService.GetFloatingIP(address)
 			if err != nil {
-				openStackMachine.SetFailure(
-					capierrors.UpdateMachineError,
-					fmt.Errorf("get network status for OpenStack instance %s with ID %s: %v", instanceStatus.Name(), instanceStatus.ID(), err),
-				)
-				return ctrl.Result{}, nil
+				return ctrl.Result{}, err
 			}
-
-			addresses := instanceNS.Addresses()
-			for _, address := range addresses {
-				if address.Type == corev1.NodeExternalIP {
-					if err = networkingService.DeleteFloatingIP(openStackMachine, address.Address); err != nil {
-						conditions.MarkFalse(openStackMachine, infrav1.APIServerIngressReadyCondition, infrav1.FloatingIPErrorReason, clusterv1.ConditionSeverityError, "Deleting floating IP failed: %v", err)
-						return ctrl.Result{}, fmt.Errorf("delete floating IP %q: %w", address.Address, err)
+			if fp != nil && fp.PortID != "" {
+				for _, port := range openStackMachine.Status.DependentResources.Ports {
+					if port.ID != fp.PortID {
+						continue
+					}
+					if err = networkingService.DisassociateFloatingIP(openStackMachine, fp.FloatingIP); err != nil {
+						conditions.MarkFalse(openStackMachine, infrav1.APIServerIngressReadyCondition, infrav1.FloatingIPErrorReason, clusterv1.ConditionSeverityError, "Disassociating floating IP failed: %v", err)
+						return ctrl.Result{}, fmt.Errorf("disassociate floating IP %q: %w", fp.FloatingIP, err)
 					}
 				}
 			}
 		}
 	}
 
+	if util.IsControlPlaneMachine(machine) && openStackCluster.Status.APIServerVIP != nil {
+		vip := openStackCluster.Status.APIServerVIP.IP
+		for _, port := range openStackMachine.Status.DependentResources.Ports {
+			if err := networkingService.RemoveAllowedAddressPair(openStackMachine, port.ID, vip); err != nil {
+				conditions.MarkFalse(openStackMachine, infrav1.APIServerIngressReadyCondition, infrav1.APIServerVIPErrorReason, clusterv1.ConditionSeverityWarning, "Removing API server VIP from port failed: %v", err)
+				return ctrl.Result{}, fmt.Errorf("remove API server VIP %q from port %q: %w", vip, port.ID, err)
+			}
+		}
+	}
+
 	instanceSpec := machineToInstanceSpec(openStackCluster, machine, openStackMachine, "")
 
 	if err := computeService.DeleteInstance(openStackMachine, instanceStatus, instanceSpec); err != nil {
+		if errors.Is(err, compute.ErrExistingVolumeDetaching) {
+			scope.Logger().Info("Waiting for existing volumes to be detached before deleting the instance")
+			return ctrl.Result{RequeueAfter: waitForExistingVolumeToReconcile}, nil
+		}
 		conditions.MarkFalse(openStackMachine, infrav1.InstanceReadyCondition, infrav1.InstanceDeleteFailedReason, clusterv1.ConditionSeverityError, "Deleting instance failed: %v", err)
 		return ctrl.Result{}, fmt.Errorf("delete instance: %w", err)
 	}
@@ -333,6 +386,10 @@ func (r *OpenStackMachineReconciler) reconcileDelete(scope *scope.WithLogger, cl
 		return ctrl.Result{}, err
 	}
 
+	if err := r.reconcileDeleteFixedAddressesFromPool(scope, openStackMachine); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	controllerutil.RemoveFinalizer(openStackMachine, infrav1.MachineFinalizer)
 	scope.Logger().Info("Reconciled Machine delete successfully")
 	return ctrl.Result{}, nil
@@ -367,50 +424,10 @@ func (r *OpenStackMachineReconciler) reconcileFloatingAddressFromPool(ctx contex
 	return claim, false, nil
 }
 
-// createIPAddressClaim creates IPAddressClaim for the FloatingAddressFromPool if it does not exist yet.
+// getOrCreateIPAddressClaimForFloatingAddress creates IPAddressClaim for the FloatingAddressFromPool if it does not exist yet.
 func (r *OpenStackMachineReconciler) getOrCreateIPAddressClaimForFloatingAddress(ctx context.Context, scope *scope.WithLogger, openStackMachine *infrav1.OpenStackMachine, openStackCluster *infrav1.OpenStackCluster) (*ipamv1.IPAddressClaim, error) {
-	var err error
-
-	poolRef := openStackMachine.Spec.FloatingIPPoolRef
 	claimName := names.GetFloatingAddressClaimName(openStackMachine.Name)
-	claim := &ipamv1.IPAddressClaim{}
-
-	err = r.Client.Get(ctx, client.ObjectKey{Namespace: openStackMachine.Namespace, Name: claimName}, claim)
-	if err == nil {
-		return claim, nil
-	} else if client.IgnoreNotFound(err) != nil {
-		return nil, err
-	}
-
-	claim = &ipamv1.IPAddressClaim{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      claimName,
-			Namespace: openStackMachine.Namespace,
-			Labels: map[string]string{
-				clusterv1.ClusterNameLabel: openStackCluster.Labels[clusterv1.ClusterNameLabel],
-			},
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: openStackMachine.APIVersion,
-					Kind:       openStackMachine.Kind,
-					Name:       openStackMachine.Name,
-					UID:        openStackMachine.UID,
-				},
-			},
-			Finalizers: []string{infrav1.IPClaimMachineFinalizer},
-		},
-		Spec: ipamv1.IPAddressClaimSpec{
-			PoolRef: *poolRef,
-		},
-	}
-
-	if err := r.Client.Create(ctx, claim); err != nil {
-		return nil, err
-	}
-
-	r.Recorder.Eventf(openStackMachine, corev1.EventTypeNormal, "CreatingIPAddressClaim", "Creating IPAddressClaim %s/%s", claim.Namespace, claim.Name)
-	scope.Logger().Info("Created IPAddressClaim", "name", claim.Name)
-	return claim, nil
+	return r.getOrCreateIPAddressClaim(ctx, scope, openStackMachine, openStackCluster, claimName, openStackMachine.Spec.FloatingIPPoolRef)
 }
 
 func (r *OpenStackMachineReconciler) associateIPAddressFromIPAddressClaim(ctx context.Context, scope *scope.WithLogger, openStackMachine *infrav1.OpenStackMachine, instanceStatus *compute.InstanceStatus, instanceNS *compute.InstanceNetworkStatus, claim *ipamv1.IPAddressClaim) error {
@@ -477,6 +494,130 @@ func (r *OpenStackMachineReconciler) reconcileDeleteFloatingAddressFromPool(scop
 	return r.Client.Update(context.Background(), claim)
 }
 
+// reconcileFixedAddressesFromPool claims the fixed IP addresses of the ports which reference an IPAM pool, before the
+// ports of the machine are resolved. It returns the machine spec with the claimed addresses, and a boolean indicating
+// if the machine is waiting for an address to be allocated.
+func (r *OpenStackMachineReconciler) reconcileFixedAddressesFromPool(ctx context.Context, scope *scope.WithLogger, openStackMachine *infrav1.OpenStackMachine, openStackCluster *infrav1.OpenStackCluster) (*infrav1.OpenStackMachineSpec, bool, error) {
+	// The claimed addresses are only needed until the ports have been resolved
+	if !openStackMachine.DeletionTimestamp.IsZero() || len(openStackMachine.Status.ReferencedResources.Ports) > 0 || !hasFixedAddressFromPool(openStackMachine.Spec.Ports) {
+		return &openStackMachine.Spec, false, nil
+	}
+
+	// The finalizer is required to release the claims when the machine is deleted
+	if controllerutil.AddFinalizer(openStackMachine, infrav1.MachineFinalizer) {
+		return nil, true, nil
+	}
+
+	machineSpec := openStackMachine.Spec.DeepCopy()
+	waiting := false
+	for i := range machineSpec.Ports {
+		for j := range machineSpec.Ports[i].FixedIPs {
+			fixedIP := &machineSpec.Ports[i].FixedIPs[j]
+			if fixedIP.IPAddressPoolRef == nil {
+				continue
+			}
+
+			claimName := names.GetFixedAddressClaimName(openStackMachine.Name, i, j)
+			claim, err := r.getOrCreateIPAddressClaim(ctx, scope, openStackMachine, openStackCluster, claimName, fixedIP.IPAddressPoolRef)
+			if err != nil {
+				return nil, false, fmt.Errorf("reconcile IPAddressClaim for fixed IP %d of port %d: %w", j, i, err)
+			}
+			if claim.Status.AddressRef.Name == "" {
+				r.Recorder.Eventf(openStackMachine, corev1.EventTypeNormal, "WaitingForIPAddressClaim", "Waiting for IPAddressClaim %s/%s to be allocated", claim.Namespace, claim.Name)
+				waiting = true
+				continue
+			}
+
+			address := &ipamv1.IPAddress{}
+			if err := r.Client.Get(ctx, client.ObjectKey{Namespace: openStackMachine.Namespace, Name: claim.Status.AddressRef.Name}, address); err != nil {
+				return nil, false, err
+			}
+			fixedIP.IPAddress = pointer.String(address.Spec.Address)
+		}
+	}
+	return machineSpec, waiting, nil
+}
+
+// getOrCreateIPAddressClaim creates the IPAddressClaim with the given name for the machine if it does not exist yet.
+func (r *OpenStackMachineReconciler) getOrCreateIPAddressClaim(ctx context.Context, scope *scope.WithLogger, openStackMachine *infrav1.OpenStackMachine, openStackCluster *infrav1.OpenStackCluster, claimName string, poolRef *corev1.TypedLocalObjectReference) (*ipamv1.IPAddressClaim, error) {
+	claim := &ipamv1.IPAddressClaim{}
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: openStackMachine.Namespace, Name: claimName}, claim)
+	if err == nil {
+		return claim, nil
+	} else if client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+
+	claim = &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claimName,
+			Namespace: openStackMachine.Namespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: openStackCluster.Labels[clusterv1.ClusterNameLabel],
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: openStackMachine.APIVersion,
+					Kind:       openStackMachine.Kind,
+					Name:       openStackMachine.Name,
+					UID:        openStackMachine.UID,
+				},
+			},
+			Finalizers: []string{infrav1.IPClaimMachineFinalizer},
+		},
+		Spec: ipamv1.IPAddressClaimSpec{
+			PoolRef: *poolRef,
+		},
+	}
+
+	if err := r.Client.Create(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	r.Recorder.Eventf(openStackMachine, corev1.EventTypeNormal, "CreatingIPAddressClaim", "Creating IPAddressClaim %s/%s", claim.Namespace, claim.Name)
+	scope.Logger().Info("Created IPAddressClaim", "name", claim.Name)
+	return claim, nil
+}
+
+// reconcileDeleteFixedAddressesFromPool releases the IPAddressClaims of the fixed IP addresses of the ports.
+func (r *OpenStackMachineReconciler) reconcileDeleteFixedAddressesFromPool(scope *scope.WithLogger, openStackMachine *infrav1.OpenStackMachine) error {
+	for i := range openStackMachine.Spec.Ports {
+		for j := range openStackMachine.Spec.Ports[i].FixedIPs {
+			if openStackMachine.Spec.Ports[i].FixedIPs[j].IPAddressPoolRef == nil {
+				continue
+			}
+
+			claimName := names.GetFixedAddressClaimName(openStackMachine.Name, i, j)
+			claim := &ipamv1.IPAddressClaim{}
+			if err := r.Client.Get(context.Background(), client.ObjectKey{Namespace: openStackMachine.Namespace, Name: claimName}, claim); err != nil {
+				if client.IgnoreNotFound(err) != nil {
+					return err
+				}
+				continue
+			}
+
+			if controllerutil.RemoveFinalizer(claim, infrav1.IPClaimMachineFinalizer) {
+				scope.Logger().Info("Releasing IPAddressClaim", "name", claim.Name)
+				if err := r.Client.Update(context.Background(), claim); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func hasFixedAddressFromPool(ports []infrav1.PortOpts) bool {
+	for i := range ports {
+		for j := range ports[i].FixedIPs {
+			if ports[i].FixedIPs[j].IPAddressPoolRef != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (r *OpenStackMachineReconciler) reconcileNormal(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine) (_ ctrl.Result, reterr error) {
 	var err error
 
@@ -527,6 +668,11 @@ func (r *OpenStackMachineReconciler) reconcileNormal(ctx context.Context, scope
 		return ctrl.Result{}, err
 	}
 
+	// Adopt an existing server if requested. The adopted resources are persisted before continuing.
+	if serverID, ok := openStackMachine.Annotations[infrav1.AdoptServerAnnotation]; ok && openStackMachine.Spec.InstanceID == nil {
+		return ctrl.Result{}, r.adoptInstance(scope, openStackCluster, machine, openStackMachine, computeService, networkingService, serverID)
+	}
+
 	err = getOrCreateMachinePorts(openStackCluster, machine, openStackMachine, networkingService, clusterName)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -534,6 +680,10 @@ func (r *OpenStackMachineReconciler) reconcileNormal(ctx context.Context, scope
 	portIDs := GetPortIDs(openStackMachine.Status.DependentResources.Ports)
 
 	instanceStatus, err := r.getOrCreateInstance(scope.Logger(), openStackCluster, machine, openStackMachine, computeService, userData, portIDs)
+	if errors.Is(err, compute.ErrExistingVolumeNotAvailable) {
+		// Condition set in getOrCreateInstance
+		return ctrl.Result{RequeueAfter: waitForExistingVolumeToReconcile}, nil
+	}
 	if err != nil || instanceStatus == nil {
 		// Conditions set in getOrCreateInstance
 		return ctrl.Result{}, err
@@ -604,6 +754,10 @@ func (r *OpenStackMachineReconciler) reconcileNormal(ctx context.Context, scope
 		return ctrl.Result{RequeueAfter: waitForInstanceBecomeActiveToReconcile}, nil
 	}
 
+	if err := reconcileMachineDNSRecord(scope, cluster, openStackCluster, openStackMachine, clusterName); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	if !util.IsControlPlaneMachine(machine) {
 		scope.Logger().Info("Not a Control plane machine, no floating ip reconcile needed, Reconciled Machine create successfully")
 		return ctrl.Result{}, nil
@@ -636,11 +790,23 @@ func (r *OpenStackMachineReconciler) reconcileAPIServerLoadBalancer(scope *scope
 			conditions.MarkFalse(openStackMachine, infrav1.APIServerIngressReadyCondition, infrav1.LoadBalancerMemberErrorReason, clusterv1.ConditionSeverityError, "Reconciling load balancer member failed: %v", err)
 			return fmt.Errorf("reconcile load balancer member: %w", err)
 		}
+	} else if openStackCluster.Spec.APIServerVIP.IsEnabled() {
+		vipStatus := openStackCluster.Status.APIServerVIP
+		if vipStatus == nil {
+			conditions.MarkFalse(openStackMachine, infrav1.APIServerIngressReadyCondition, infrav1.APIServerVIPErrorReason, clusterv1.ConditionSeverityWarning, "API server VIP is not available yet")
+			return fmt.Errorf("API server VIP of cluster is not available yet")
+		}
+		for _, port := range openStackMachine.Status.DependentResources.Ports {
+			if err := networkingService.AddAllowedAddressPair(openStackMachine, port.ID, vipStatus.NetworkID, vipStatus.IP); err != nil {
+				conditions.MarkFalse(openStackMachine, infrav1.APIServerIngressReadyCondition, infrav1.APIServerVIPErrorReason, clusterv1.ConditionSeverityError, "Adding API server VIP to port failed: %v", err)
+				return fmt.Errorf("add API server VIP %q to port %q: %w", vipStatus.IP, port.ID, err)
+			}
+		}
 	} else if !pointer.BoolDeref(openStackCluster.Spec.DisableAPIServerFloatingIP, false) {
 		var floatingIPAddress *string
 		switch {
 		case openStackCluster.Spec.ControlPlaneEndpoint != nil && openStackCluster.Spec.ControlPlaneEndpoint.IsValid():
-			floatingIPAddress = &openStackCluster.Spec.ControlPlaneEndpoint.Host
+			floatingIPAddress = pointer.String(getControlPlaneEndpointAddress(openStackCluster))
 		case openStackCluster.Spec.APIServerFloatingIP != nil:
 			floatingIPAddress = openStackCluster.Spec.APIServerFloatingIP
 		}
@@ -669,6 +835,38 @@ func (r *OpenStackMachineReconciler) reconcileAPIServerLoadBalancer(scope *scope
 	return nil
 }
 
+// reconcileMachineDNSRecord ensures that the DNS record of the machine resolves
+// to its internal addresses if the cluster manages DNS records for its machines.
+func reconcileMachineDNSRecord(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterName string) error {
+	dnsRecords := openStackCluster.Spec.DNSRecords
+	if dnsRecords == nil || !pointer.BoolDeref(dnsRecords.MachineRecords, false) {
+		return nil
+	}
+
+	var addresses []string
+	for _, address := range openStackMachine.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			addresses = append(addresses, address.Address)
+		}
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	dnsService, err := dns.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	recordName := fmt.Sprintf("%s.%s.%s", openStackMachine.Name, cluster.Name, cluster.Namespace)
+	dnsRecord, err := dnsService.ReconcileRecords(openStackMachine, clusterName, dnsRecords, recordName, addresses)
+	if err != nil {
+		return fmt.Errorf("reconcile DNS records: %w", err)
+	}
+	openStackMachine.Status.DNSRecord = dnsRecord
+	return nil
+}
+
 func getOrCreateMachinePorts(openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, networkingService *networking.Service, clusterName string) error {
 	desiredPorts := openStackMachine.Status.ReferencedResources.Ports
 	dependentResources := &openStackMachine.Status.DependentResources
@@ -677,15 +875,61 @@ func getOrCreateMachinePorts(openStackCluster *infrav1.OpenStackCluster, machine
 		return nil
 	}
 
+	// The ports of an existing server are not created by us
+	if openStackMachine.Spec.InstanceID != nil {
+		return nil
+	}
+
 	instanceTags := getInstanceTags(openStackMachine, openStackCluster)
 	managedSecurityGroups := getManagedSecurityGroups(openStackCluster, machine, openStackMachine)
-	if err := networkingService.CreatePorts(openStackMachine, clusterName, openStackMachine.Name, managedSecurityGroups, instanceTags, desiredPorts, dependentResources); err != nil {
+	if err := networkingService.CreatePorts(openStackMachine, openStackCluster, clusterName, openStackMachine.Name, managedSecurityGroups, instanceTags, desiredPorts, dependentResources); err != nil {
 		return fmt.Errorf("creating ports: %w", err)
 	}
 
 	return nil
 }
 
+// adoptInstance takes ownership of the existing server with the given ID and records its ports,
+// trunks and volumes in the OpenStackMachine status. Any difference between the OpenStackMachine spec
+// and the server is reported in the InstanceAdopted condition.
+func (r *OpenStackMachineReconciler) adoptInstance(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, computeService *compute.Service, networkingService *networking.Service, serverID string) error {
+	scope.Logger().Info("Adopting existing server", "id", serverID)
+
+	instanceTags := getInstanceTags(openStackMachine, openStackCluster)
+	owner := fmt.Sprintf("%s/%s", openStackMachine.Namespace, openStackMachine.Name)
+	instanceStatus, err := computeService.AdoptInstance(openStackMachine, serverID, owner, instanceTags)
+	if err != nil {
+		conditions.MarkFalse(openStackMachine, infrav1.InstanceAdoptedCondition, infrav1.InstanceAdoptFailedReason, clusterv1.ConditionSeverityError, "Adopting server %s failed: %v", serverID, err)
+		return fmt.Errorf("adopt server %s: %w", serverID, err)
+	}
+
+	ports, trunks, err := networkingService.AdoptInstancePorts(openStackMachine, serverID, instanceTags)
+	if err != nil {
+		conditions.MarkFalse(openStackMachine, infrav1.InstanceAdoptedCondition, infrav1.InstanceAdoptFailedReason, clusterv1.ConditionSeverityError, "Adopting ports of server %s failed: %v", serverID, err)
+		return fmt.Errorf("adopt ports of server %s: %w", serverID, err)
+	}
+
+	openStackMachine.Status.DependentResources.Ports = ports
+	openStackMachine.Status.DependentResources.Trunks = trunks
+	openStackMachine.Status.DependentResources.Volumes = instanceStatus.AttachedVolumes()
+	openStackMachine.Spec.InstanceID = pointer.String(instanceStatus.ID())
+
+	instanceSpec := machineToInstanceSpec(openStackCluster, machine, openStackMachine, "")
+	drift := compute.GetInstanceSpecDrift(instanceStatus, instanceSpec)
+	if desiredPorts := len(openStackMachine.Status.ReferencedResources.Ports); desiredPorts != len(ports) {
+		drift = append(drift, fmt.Sprintf("ports: spec %d, server %d", desiredPorts, len(ports)))
+	}
+
+	if len(drift) > 0 {
+		conditions.MarkFalse(openStackMachine, infrav1.InstanceAdoptedCondition, infrav1.InstanceSpecMismatchReason, clusterv1.ConditionSeverityWarning, "Adopted server differs from spec: %s", strings.Join(drift, "; "))
+	} else {
+		conditions.MarkTrue(openStackMachine, infrav1.InstanceAdoptedCondition)
+	}
+
+	scope.Logger().Info("Adopted existing server", "id", instanceStatus.ID(), "ports", len(ports), "trunks", len(trunks))
+	return nil
+}
+
 func (r *OpenStackMachineReconciler) getOrCreateInstance(logger logr.Logger, openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, computeService *compute.Service, userData string, portIDs []string) (*compute.InstanceStatus, error) {
 	var instanceStatus *compute.InstanceStatus
 	var err error
@@ -713,6 +957,11 @@ func (r *OpenStackMachineReconciler) getOrCreateInstance(logger logr.Logger, ope
 		instanceSpec := machineToInstanceSpec(openStackCluster, machine, openStackMachine, userData)
 		logger.Info("Machine does not exist, creating Machine", "name", openStackMachine.Name)
 		instanceStatus, err = computeService.CreateInstance(openStackMachine, instanceSpec, portIDs)
+		if errors.Is(err, compute.ErrExistingVolumeNotAvailable) {
+			logger.Info("Waiting for existing volume to become available", "reason", err.Error())
+			conditions.MarkFalse(openStackMachine, infrav1.InstanceReadyCondition, infrav1.WaitingForExistingVolumeReason, clusterv1.ConditionSeverityInfo, err.Error())
+			return nil, err
+		}
 		if err != nil {
 			conditions.MarkFalse(openStackMachine, infrav1.InstanceReadyCondition, infrav1.InstanceCreateFailedReason, clusterv1.ConditionSeverityError, err.Error())
 			return nil, fmt.Errorf("create OpenStack instance: %w", err)
@@ -751,6 +1000,8 @@ func machineToInstanceSpec(openStackCluster *infrav1.OpenStackCluster, machine *
 	instanceSpec.Tags = getInstanceTags(openStackMachine, openStackCluster)
 	instanceSpec.SecurityGroups = getManagedSecurityGroups(openStackCluster, machine, openStackMachine)
 	instanceSpec.Ports = openStackMachine.Spec.Ports
+	instanceSpec.VolumeNameTemplate = names.GetResourceNaming(openStackCluster).Volume
+	instanceSpec.NameData = names.GetClusterResourceNameData(openStackCluster)
 
 	return &instanceSpec
 }
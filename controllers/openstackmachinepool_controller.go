@@ -0,0 +1,713 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	exputil "sigs.k8s.io/cluster-api/exp/util"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/loadbalancer"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/hash"
+)
+
+// OpenStackMachinePoolReconciler reconciles a OpenStackMachinePool object.
+type OpenStackMachinePoolReconciler struct {
+	Client           client.Client
+	Recorder         record.EventRecorder
+	WatchFilterValue string
+	ScopeFactory     scope.Factory
+	CaCertificates   []byte // PEM encoded ca certificates.
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackmachinepools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackmachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch
+
+func (r *OpenStackMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Fetch the OpenStackMachinePool instance.
+	openStackMachinePool := &infrav1.OpenStackMachinePool{}
+	err := r.Client.Get(ctx, req.NamespacedName, openStackMachinePool)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	log = log.WithValues("openStackMachinePool", openStackMachinePool.Name)
+
+	// Fetch the MachinePool.
+	machinePool, err := exputil.GetOwnerMachinePool(ctx, r.Client, openStackMachinePool.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machinePool == nil {
+		log.Info("MachinePool Controller has not yet set OwnerRef")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("machinePool", machinePool.Name)
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machinePool.ObjectMeta)
+	if err != nil {
+		log.Info("MachinePool is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("cluster", cluster.Name)
+
+	if annotations.IsPaused(cluster, openStackMachinePool) {
+		log.Info("OpenStackMachinePool or linked Cluster is marked as paused. Won't reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	openStackCluster := &infrav1.OpenStackCluster{}
+	openStackClusterName := client.ObjectKey{
+		Namespace: openStackMachinePool.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Client.Get(ctx, openStackClusterName, openStackCluster); err != nil {
+		return ctrl.Result{}, errors.New("error getting infra provider cluster")
+	}
+
+	log = log.WithValues("openStackCluster", openStackCluster.Name)
+
+	patchHelper, err := patch.NewHelper(openStackMachinePool, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the openStackMachinePool when exiting this function so we can persist any OpenStackMachinePool changes.
+	defer func() {
+		if err := patchHelper.Patch(ctx, openStackMachinePool); err != nil {
+			result = ctrl.Result{}
+			reterr = kerrors.NewAggregate([]error{reterr, fmt.Errorf("error patching OpenStackMachinePool %s/%s: %w", openStackMachinePool.Namespace, openStackMachinePool.Name, err)})
+		}
+	}()
+
+	clientScope, err := r.ScopeFactory.NewClientScopeFromMachinePool(ctx, r.Client, openStackMachinePool, openStackCluster, r.CaCertificates, log)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	scope := scope.NewWithLogger(clientScope, log)
+
+	// Handle deleted machine pools
+	if !openStackMachinePool.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.reconcileDelete(scope, cluster, openStackCluster, openStackMachinePool)
+	}
+
+	// Handle non-deleted machine pools
+	return r.reconcileNormal(ctx, scope, cluster, openStackCluster, machinePool, openStackMachinePool)
+}
+
+func (r *OpenStackMachinePoolReconciler) reconcileNormal(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster, machinePool *expv1.MachinePool, openStackMachinePool *infrav1.OpenStackMachinePool) (ctrl.Result, error) {
+	// If the OpenStackMachinePool doesn't have our finalizer, add it.
+	if controllerutil.AddFinalizer(openStackMachinePool, infrav1.MachinePoolFinalizer) {
+		// Register the finalizer immediately to avoid orphaning OpenStack resources on delete
+		return ctrl.Result{}, nil
+	}
+
+	if !cluster.Status.InfrastructureReady {
+		scope.Logger().Info("Cluster infrastructure is not ready yet, re-queuing machine pool")
+		conditions.MarkFalse(openStackMachinePool, infrav1.ReplicasReadyCondition, infrav1.WaitingForClusterInfrastructureReason, clusterv1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: waitForClusterInfrastructureReadyDuration}, nil
+	}
+
+	// Make sure bootstrap data is available and populated.
+	if machinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		scope.Logger().Info("Bootstrap data secret reference is not yet available")
+		conditions.MarkFalse(openStackMachinePool, infrav1.ReplicasReadyCondition, infrav1.WaitingForBootstrapDataReason, clusterv1.ConditionSeverityInfo, "")
+		return ctrl.Result{}, nil
+	}
+	userData, err := r.getBootstrapData(ctx, machinePool)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	scope.Logger().Info("Reconciling MachinePool")
+
+	// The bootstrap data is part of the template: servers are replaced when it changes.
+	templateHash, err := getMachinePoolTemplateHash(openStackMachinePool, *machinePool.Spec.Template.Spec.Bootstrap.DataSecretName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if openStackMachinePool.Status.TemplateHash != templateHash {
+		// The resources referenced by the previous template may not be valid for the new one.
+		// Servers created from the previous template are deleted using its recorded revision.
+		openStackMachinePool.Status.TemplateHash = templateHash
+		openStackMachinePool.Status.ReferencedResources = infrav1.ReferencedMachineResources{}
+		return ctrl.Result{}, nil
+	}
+
+	// Resolve and store the referenced OpenStackImage, if any
+	changed, err := resolveImageRef(ctx, r.Client, openStackMachinePool.Namespace, &openStackMachinePool.Spec.Template.Image, &openStackMachinePool.Status.ReferencedResources)
+	if errors.Is(err, errImageNotReady) {
+		scope.Logger().Info("Waiting for the referenced OpenStackImage to be ready", "reason", err.Error())
+		conditions.MarkFalse(openStackMachinePool, infrav1.ReplicasReadyCondition, infrav1.WaitingForImageReason, clusterv1.ConditionSeverityInfo, err.Error())
+		return ctrl.Result{RequeueAfter: waitForImageActiveInterval}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if changed {
+		// If the referenced resources have changed, we need to update the OpenStackMachinePool status now.
+		return ctrl.Result{}, nil
+	}
+
+	// Resolve and store referenced resources
+	changed, err = compute.ResolveReferencedMachineResources(scope, openStackCluster, &openStackMachinePool.Spec.Template, &openStackMachinePool.Status.ReferencedResources)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if changed {
+		// If the referenced resources have changed, we need to update the OpenStackMachinePool status now.
+		return ctrl.Result{}, nil
+	}
+
+	// Record the current template before creating any server from it
+	setMachinePoolTemplateRevision(openStackMachinePool, templateHash)
+
+	clusterName := fmt.Sprintf("%s-%s", cluster.ObjectMeta.Namespace, cluster.Name)
+
+	computeService, err := compute.NewService(scope)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	instanceStatuses, err := r.refreshInstances(scope, openStackCluster, openStackMachinePool, computeService, networkingService, clusterName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	desiredReplicas := int(pointer.Int32Deref(machinePool.Spec.Replicas, 1))
+	toDelete, toCreate := planMachinePoolRollout(openStackMachinePool.Status.Instances, templateHash, desiredReplicas, int(openStackMachinePool.Spec.MaxSurge))
+
+	for _, name := range toDelete {
+		scope.Logger().Info("Deleting server from machine pool", "name", name)
+		if err := r.deleteInstance(scope, openStackCluster, openStackMachinePool, name, computeService, networkingService, clusterName); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Retry the creation of up to date servers which failed in a previous reconcile
+	for i := range openStackMachinePool.Status.Instances {
+		instance := &openStackMachinePool.Status.Instances[i]
+		if instance.TemplateHash != templateHash || instance.InstanceID != "" {
+			continue
+		}
+		if err := r.createInstance(scope, openStackCluster, openStackMachinePool, instance, computeService, networkingService, clusterName, userData); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	for i := 0; i < toCreate; i++ {
+		openStackMachinePool.Status.Instances = append(openStackMachinePool.Status.Instances, infrav1.OpenStackMachinePoolInstanceStatus{
+			Name:          fmt.Sprintf("%s-%s", openStackMachinePool.Name, utilrand.String(5)),
+			TemplateHash:  templateHash,
+			FailureDomain: getMachinePoolFailureDomain(machinePool.Spec.FailureDomains, openStackMachinePool.Status.Instances, templateHash),
+		})
+		instance := &openStackMachinePool.Status.Instances[len(openStackMachinePool.Status.Instances)-1]
+		scope.Logger().Info("Creating server for machine pool", "name", instance.Name)
+		if err := r.createInstance(scope, openStackCluster, openStackMachinePool, instance, computeService, networkingService, clusterName, userData); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if openStackMachinePool.Spec.APIServerLoadBalancerMember && openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() {
+		if err := r.reconcileLoadBalancerMembers(scope, openStackCluster, openStackMachinePool, instanceStatuses, clusterName); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	pruneMachinePoolTemplateRevisions(openStackMachinePool, templateHash)
+
+	return r.updateStatus(scope, openStackMachinePool, templateHash, desiredReplicas), nil
+}
+
+// refreshInstances updates the state of the servers of the pool. Servers which no longer exist or are in an
+// error state are removed from the pool so that they will be replaced. It returns the status of the existing servers.
+func (r *OpenStackMachinePoolReconciler) refreshInstances(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachinePool *infrav1.OpenStackMachinePool, computeService *compute.Service, networkingService *networking.Service, clusterName string) (map[string]*compute.InstanceStatus, error) {
+	instanceStatuses := make(map[string]*compute.InstanceStatus, len(openStackMachinePool.Status.Instances))
+
+	var removed []string
+	for i := range openStackMachinePool.Status.Instances {
+		instance := &openStackMachinePool.Status.Instances[i]
+
+		var instanceStatus *compute.InstanceStatus
+		var err error
+		if instance.InstanceID != "" {
+			instanceStatus, err = computeService.GetInstanceStatus(instance.InstanceID)
+		} else {
+			instanceStatus, err = computeService.GetInstanceStatusByName(openStackMachinePool, instance.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if instanceStatus == nil {
+			if instance.InstanceID != "" {
+				scope.Logger().Info("Server of machine pool no longer exists, replacing it", "name", instance.Name, "id", instance.InstanceID)
+				removed = append(removed, instance.Name)
+			}
+			continue
+		}
+
+		instance.InstanceID = instanceStatus.ID()
+		instance.ProviderID = fmt.Sprintf("openstack:///%s", instanceStatus.ID())
+		state := instanceStatus.State()
+		instance.InstanceState = &state
+		instance.Ready = state == infrav1.InstanceStateActive
+
+		if state == infrav1.InstanceStateError || state == infrav1.InstanceStateDeleted {
+			scope.Logger().Info("Server of machine pool is in a failed state, replacing it", "name", instance.Name, "id", instance.InstanceID, "state", state)
+			removed = append(removed, instance.Name)
+			continue
+		}
+		instanceStatuses[instance.Name] = instanceStatus
+	}
+
+	for _, name := range removed {
+		if err := r.deleteInstance(scope, openStackCluster, openStackMachinePool, name, computeService, networkingService, clusterName); err != nil {
+			return nil, err
+		}
+	}
+
+	return instanceStatuses, nil
+}
+
+// createInstance creates the ports and the server of an instance of the pool. Ports created by a previous
+// reconcile are adopted and recorded in the instance status before any new resources are created.
+func (r *OpenStackMachinePoolReconciler) createInstance(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachinePool *infrav1.OpenStackMachinePool, instance *infrav1.OpenStackMachinePoolInstanceStatus, computeService *compute.Service, networkingService *networking.Service, clusterName, userData string) error {
+	machine := instanceToMachine(instance)
+	openStackMachine := instanceToOpenStackMachine(openStackMachinePool, instance)
+
+	desiredPorts := openStackMachinePool.Status.ReferencedResources.Ports
+	if _, err := networkingService.AdoptMachinePorts(scope, openStackCluster, openStackMachine, desiredPorts); err != nil {
+		return err
+	}
+	instance.DependentResources = openStackMachine.Status.DependentResources
+
+	if len(desiredPorts) != len(instance.DependentResources.Ports) {
+		instanceTags := getInstanceTags(openStackMachine, openStackCluster)
+		managedSecurityGroups := getManagedSecurityGroups(openStackCluster, machine, openStackMachine)
+		if err := networkingService.CreatePorts(openStackMachinePool, openStackCluster, clusterName, instance.Name, managedSecurityGroups, instanceTags, desiredPorts, &instance.DependentResources); err != nil {
+			conditions.MarkFalse(openStackMachinePool, infrav1.ReplicasReadyCondition, infrav1.InstanceCreateFailedReason, clusterv1.ConditionSeverityError, "Creating ports failed: %v", err)
+			return fmt.Errorf("creating ports: %w", err)
+		}
+	}
+	portIDs := GetPortIDs(instance.DependentResources.Ports)
+
+	instanceSpec := machineToInstanceSpec(openStackCluster, machine, openStackMachine, userData)
+	instanceStatus, err := computeService.CreateInstance(openStackMachinePool, instanceSpec, portIDs)
+	if err != nil {
+		conditions.MarkFalse(openStackMachinePool, infrav1.ReplicasReadyCondition, infrav1.InstanceCreateFailedReason, clusterv1.ConditionSeverityError, "Creating server failed: %v", err)
+		return fmt.Errorf("create OpenStack instance: %w", err)
+	}
+
+	instance.InstanceID = instanceStatus.ID()
+	instance.ProviderID = fmt.Sprintf("openstack:///%s", instanceStatus.ID())
+	state := instanceStatus.State()
+	instance.InstanceState = &state
+	instance.Ready = state == infrav1.InstanceStateActive
+	return nil
+}
+
+// deleteInstance deletes the server and the ports of an instance of the pool and removes it from the pool.
+func (r *OpenStackMachinePoolReconciler) deleteInstance(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachinePool *infrav1.OpenStackMachinePool, name string, computeService *compute.Service, networkingService *networking.Service, clusterName string) error {
+	index := -1
+	for i := range openStackMachinePool.Status.Instances {
+		if openStackMachinePool.Status.Instances[i].Name == name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil
+	}
+	instance := &openStackMachinePool.Status.Instances[index]
+
+	if openStackMachinePool.Spec.APIServerLoadBalancerMember && openStackCluster.Spec.APIServerLoadBalancer.IsEnabled() {
+		loadBalancerService, err := loadbalancer.NewService(scope)
+		if err != nil {
+			return err
+		}
+
+		if err := loadBalancerService.DeleteInstanceLoadBalancerMember(openStackCluster, instance.Name, clusterName); err != nil {
+			conditions.MarkFalse(openStackMachinePool, infrav1.ReplicasReadyCondition, infrav1.LoadBalancerMemberErrorReason, clusterv1.ConditionSeverityWarning, "Server could not be removed from load balancer: %v", err)
+			return err
+		}
+	}
+
+	var instanceStatus *compute.InstanceStatus
+	var err error
+	if instance.InstanceID != "" {
+		instanceStatus, err = computeService.GetInstanceStatus(instance.InstanceID)
+	} else {
+		instanceStatus, err = computeService.GetInstanceStatusByName(openStackMachinePool, instance.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	instanceSpec := machineToInstanceSpec(openStackCluster, instanceToMachine(instance), instanceToOpenStackMachine(openStackMachinePool, instance), "")
+	if err := computeService.DeleteInstance(openStackMachinePool, instanceStatus, instanceSpec); err != nil {
+		conditions.MarkFalse(openStackMachinePool, infrav1.ReplicasReadyCondition, infrav1.InstanceDeleteFailedReason, clusterv1.ConditionSeverityError, "Deleting server failed: %v", err)
+		return fmt.Errorf("delete instance: %w", err)
+	}
+
+	trunkSupported, err := networkingService.IsTrunkExtSupported()
+	if err != nil {
+		return err
+	}
+
+	for _, port := range instance.DependentResources.Ports {
+		if err := networkingService.DeleteInstanceTrunkAndPort(openStackMachinePool, port, trunkSupported); err != nil {
+			return fmt.Errorf("failed to delete port %q: %w", port.ID, err)
+		}
+	}
+
+	openStackMachinePool.Status.Instances = append(openStackMachinePool.Status.Instances[:index], openStackMachinePool.Status.Instances[index+1:]...)
+	return nil
+}
+
+func (r *OpenStackMachinePoolReconciler) reconcileLoadBalancerMembers(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachinePool *infrav1.OpenStackMachinePool, instanceStatuses map[string]*compute.InstanceStatus, clusterName string) error {
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	for i := range openStackMachinePool.Status.Instances {
+		instance := &openStackMachinePool.Status.Instances[i]
+		instanceStatus, ok := instanceStatuses[instance.Name]
+		if !ok || !instance.Ready {
+			continue
+		}
+
+		instanceNS, err := instanceStatus.NetworkStatus()
+		if err != nil {
+			return fmt.Errorf("get network status: %w", err)
+		}
+
+		ip := instanceNS.IP(openStackCluster.Status.Network.Name)
+		if err := loadBalancerService.ReconcileLoadBalancerMember(openStackCluster, instanceToOpenStackMachine(openStackMachinePool, instance), clusterName, ip); err != nil {
+			conditions.MarkFalse(openStackMachinePool, infrav1.ReplicasReadyCondition, infrav1.LoadBalancerMemberErrorReason, clusterv1.ConditionSeverityError, "Reconciling load balancer member failed: %v", err)
+			return fmt.Errorf("reconcile load balancer member: %w", err)
+		}
+	}
+	return nil
+}
+
+// updateStatus updates the replicas, provider IDs and readiness of the pool from the state of its servers.
+func (r *OpenStackMachinePoolReconciler) updateStatus(scope *scope.WithLogger, openStackMachinePool *infrav1.OpenStackMachinePool, templateHash string, desiredReplicas int) ctrl.Result {
+	providerIDList := make([]string, 0, len(openStackMachinePool.Status.Instances))
+	var readyReplicas, upToDateReplicas int
+	for i := range openStackMachinePool.Status.Instances {
+		instance := &openStackMachinePool.Status.Instances[i]
+		if instance.ProviderID != "" {
+			providerIDList = append(providerIDList, instance.ProviderID)
+		}
+		if instance.Ready {
+			readyReplicas++
+			if instance.TemplateHash == templateHash {
+				upToDateReplicas++
+			}
+		}
+	}
+
+	openStackMachinePool.Spec.ProviderIDList = providerIDList
+	openStackMachinePool.Status.ProviderIDList = providerIDList
+	openStackMachinePool.Status.Replicas = int32(readyReplicas)
+	openStackMachinePool.Status.Ready = readyReplicas >= desiredReplicas
+
+	switch {
+	case upToDateReplicas == desiredReplicas && len(openStackMachinePool.Status.Instances) == desiredReplicas:
+		conditions.MarkTrue(openStackMachinePool, infrav1.ReplicasReadyCondition)
+		scope.Logger().Info("Reconciled MachinePool successfully")
+		return ctrl.Result{}
+	case upToDateReplicas < readyReplicas:
+		conditions.MarkFalse(openStackMachinePool, infrav1.ReplicasReadyCondition, infrav1.RollingUpdateReason, clusterv1.ConditionSeverityInfo, "%d of %d servers are up to date", upToDateReplicas, desiredReplicas)
+	default:
+		conditions.MarkFalse(openStackMachinePool, infrav1.ReplicasReadyCondition, infrav1.ScalingReason, clusterv1.ConditionSeverityInfo, "%d of %d servers are ready", readyReplicas, desiredReplicas)
+	}
+
+	scope.Logger().Info("Waiting for machine pool servers", "ready", readyReplicas, "upToDate", upToDateReplicas, "desired", desiredReplicas)
+	return ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile}
+}
+
+func (r *OpenStackMachinePoolReconciler) reconcileDelete(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster, openStackMachinePool *infrav1.OpenStackMachinePool) error {
+	scope.Logger().Info("Reconciling MachinePool delete")
+
+	clusterName := fmt.Sprintf("%s-%s", cluster.ObjectMeta.Namespace, cluster.Name)
+
+	computeService, err := compute.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	networkingService, err := networking.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	for len(openStackMachinePool.Status.Instances) > 0 {
+		name := openStackMachinePool.Status.Instances[0].Name
+		if err := r.deleteInstance(scope, openStackCluster, openStackMachinePool, name, computeService, networkingService, clusterName); err != nil {
+			return err
+		}
+	}
+	openStackMachinePool.Spec.ProviderIDList = nil
+	openStackMachinePool.Status.ProviderIDList = nil
+	openStackMachinePool.Status.Replicas = 0
+	openStackMachinePool.Status.TemplateRevisions = nil
+
+	controllerutil.RemoveFinalizer(openStackMachinePool, infrav1.MachinePoolFinalizer)
+	scope.Logger().Info("Reconciled MachinePool delete successfully")
+	return nil
+}
+
+func (r *OpenStackMachinePoolReconciler) getBootstrapData(ctx context.Context, machinePool *expv1.MachinePool) (string, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: machinePool.Namespace, Name: *machinePool.Spec.Template.Spec.Bootstrap.DataSecretName}
+	if err := r.Client.Get(ctx, key, secret); err != nil {
+		return "", fmt.Errorf("failed to retrieve bootstrap data secret for MachinePool %s/%s: %w", machinePool.Namespace, machinePool.Name, err)
+	}
+
+	value, ok := secret.Data["value"]
+	if !ok {
+		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+	}
+
+	return base64.StdEncoding.EncodeToString(value), nil
+}
+
+func (r *OpenStackMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.OpenStackMachinePool{}).
+		Watches(
+			&expv1.MachinePool{},
+			handler.EnqueueRequestsFromMapFunc(exputil.MachinePoolToInfrastructureMapFunc(infrav1.GroupVersion.WithKind("OpenStackMachinePool"), log)),
+		).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(log, r.WatchFilterValue)).
+		Complete(r)
+}
+
+// getMachinePoolTemplateHash returns a hash of the template of the pool and of its bootstrap data secret.
+func getMachinePoolTemplateHash(openStackMachinePool *infrav1.OpenStackMachinePool, dataSecretName string) (string, error) {
+	templateHash, err := hash.ComputeSpewHash(struct {
+		Template       infrav1.OpenStackMachineSpec
+		DataSecretName string
+	}{openStackMachinePool.Spec.Template, dataSecretName})
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(uint64(templateHash), 16), nil
+}
+
+// planMachinePoolRollout returns the names of the servers to delete and the number of servers to create to
+// converge the pool towards desiredReplicas up to date servers. Servers in excess are deleted, not ready ones
+// first. Outdated servers are only deleted when it does not reduce the number of ready servers below the
+// desired number of replicas, and at most maxSurge servers are created above the desired number of replicas.
+func planMachinePoolRollout(instances []infrav1.OpenStackMachinePoolInstanceStatus, templateHash string, desiredReplicas, maxSurge int) (toDelete []string, toCreate int) {
+	if maxSurge < 1 {
+		maxSurge = 1
+	}
+
+	var upToDate, outdated []infrav1.OpenStackMachinePoolInstanceStatus
+	readyReplicas := 0
+	for i := range instances {
+		if instances[i].TemplateHash == templateHash {
+			upToDate = append(upToDate, instances[i])
+		} else {
+			outdated = append(outdated, instances[i])
+		}
+		if instances[i].Ready {
+			readyReplicas++
+		}
+	}
+
+	// Not ready servers are deleted first, then the newest ones
+	byDeletionPriority := func(s []infrav1.OpenStackMachinePoolInstanceStatus) {
+		sort.SliceStable(s, func(i, j int) bool { return !s[i].Ready && s[j].Ready })
+	}
+
+	byDeletionPriority(upToDate)
+	for len(upToDate) > desiredReplicas {
+		instance := upToDate[0]
+		upToDate = upToDate[1:]
+		toDelete = append(toDelete, instance.Name)
+		if instance.Ready {
+			readyReplicas--
+		}
+	}
+
+	byDeletionPriority(outdated)
+	remaining := 0
+	for _, instance := range outdated {
+		if instance.Ready && readyReplicas-1 < desiredReplicas {
+			remaining++
+			continue
+		}
+		toDelete = append(toDelete, instance.Name)
+		if instance.Ready {
+			readyReplicas--
+		}
+	}
+
+	total := len(upToDate) + remaining
+	toCreate = desiredReplicas - len(upToDate)
+	if surge := desiredReplicas + maxSurge - total; surge < toCreate {
+		toCreate = surge
+	}
+	if toCreate < 0 {
+		toCreate = 0
+	}
+	return toDelete, toCreate
+}
+
+// getMachinePoolFailureDomain returns the failure domain with the fewest up to date servers.
+func getMachinePoolFailureDomain(failureDomains []string, instances []infrav1.OpenStackMachinePoolInstanceStatus, templateHash string) string {
+	if len(failureDomains) == 0 {
+		return ""
+	}
+
+	counts := make(map[string]int, len(failureDomains))
+	for i := range instances {
+		if instances[i].TemplateHash == templateHash {
+			counts[instances[i].FailureDomain]++
+		}
+	}
+
+	failureDomain := failureDomains[0]
+	for _, fd := range failureDomains[1:] {
+		if counts[fd] < counts[failureDomain] {
+			failureDomain = fd
+		}
+	}
+	return failureDomain
+}
+
+// setMachinePoolTemplateRevision records the current template and its resolved references as the revision
+// for templateHash.
+func setMachinePoolTemplateRevision(openStackMachinePool *infrav1.OpenStackMachinePool, templateHash string) {
+	revision := infrav1.OpenStackMachinePoolTemplateRevision{
+		TemplateHash:        templateHash,
+		Template:            *openStackMachinePool.Spec.Template.DeepCopy(),
+		ReferencedResources: *openStackMachinePool.Status.ReferencedResources.DeepCopy(),
+	}
+
+	for i := range openStackMachinePool.Status.TemplateRevisions {
+		if openStackMachinePool.Status.TemplateRevisions[i].TemplateHash == templateHash {
+			openStackMachinePool.Status.TemplateRevisions[i] = revision
+			return
+		}
+	}
+	openStackMachinePool.Status.TemplateRevisions = append(openStackMachinePool.Status.TemplateRevisions, revision)
+}
+
+// pruneMachinePoolTemplateRevisions removes the revisions which are neither current nor used by a server of
+// the pool.
+func pruneMachinePoolTemplateRevisions(openStackMachinePool *infrav1.OpenStackMachinePool, templateHash string) {
+	inUse := map[string]bool{templateHash: true}
+	for i := range openStackMachinePool.Status.Instances {
+		inUse[openStackMachinePool.Status.Instances[i].TemplateHash] = true
+	}
+
+	revisions := openStackMachinePool.Status.TemplateRevisions[:0]
+	for _, revision := range openStackMachinePool.Status.TemplateRevisions {
+		if inUse[revision.TemplateHash] {
+			revisions = append(revisions, revision)
+		}
+	}
+	openStackMachinePool.Status.TemplateRevisions = revisions
+}
+
+// instanceToOpenStackMachine returns an OpenStackMachine describing a server of the pool, so that the
+// helpers shared with the OpenStackMachine controller can be used for the servers of the pool. The
+// OpenStackMachine is built from the template revision the server was created from, if it is recorded,
+// and from the current template otherwise.
+func instanceToOpenStackMachine(openStackMachinePool *infrav1.OpenStackMachinePool, instance *infrav1.OpenStackMachinePoolInstanceStatus) *infrav1.OpenStackMachine {
+	spec := openStackMachinePool.Spec.Template
+	referencedResources := openStackMachinePool.Status.ReferencedResources
+	for i := range openStackMachinePool.Status.TemplateRevisions {
+		revision := &openStackMachinePool.Status.TemplateRevisions[i]
+		if revision.TemplateHash == instance.TemplateHash {
+			spec = revision.Template
+			referencedResources = revision.ReferencedResources
+			break
+		}
+	}
+
+	return &infrav1.OpenStackMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.Name,
+			Namespace: openStackMachinePool.Namespace,
+		},
+		Spec: spec,
+		Status: infrav1.OpenStackMachineStatus{
+			ReferencedResources: referencedResources,
+			DependentResources:  instance.DependentResources,
+		},
+	}
+}
+
+// instanceToMachine returns a worker Machine in the failure domain of a server of the pool.
+func instanceToMachine(instance *infrav1.OpenStackMachinePoolInstanceStatus) *clusterv1.Machine {
+	machine := &clusterv1.Machine{}
+	if instance.FailureDomain != "" {
+		machine.Spec.FailureDomain = pointer.String(instance.FailureDomain)
+	}
+	return machine
+}
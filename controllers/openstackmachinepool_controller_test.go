@@ -0,0 +1,290 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_planMachinePoolRollout(t *testing.T) {
+	const (
+		currentHash  = "current"
+		outdatedHash = "outdated"
+	)
+
+	instance := func(name, templateHash string, ready bool) infrav1.OpenStackMachinePoolInstanceStatus {
+		return infrav1.OpenStackMachinePoolInstanceStatus{Name: name, TemplateHash: templateHash, Ready: ready}
+	}
+
+	tests := []struct {
+		name            string
+		instances       []infrav1.OpenStackMachinePoolInstanceStatus
+		desiredReplicas int
+		maxSurge        int
+		wantToDelete    []string
+		wantToCreate    int
+	}{
+		{
+			name:            "Scale up from zero",
+			desiredReplicas: 3,
+			maxSurge:        1,
+			wantToCreate:    3,
+		},
+		{
+			name: "Up to date",
+			instances: []infrav1.OpenStackMachinePoolInstanceStatus{
+				instance("a", currentHash, true),
+				instance("b", currentHash, true),
+			},
+			desiredReplicas: 2,
+			maxSurge:        1,
+		},
+		{
+			name: "Scale down deletes not ready servers first",
+			instances: []infrav1.OpenStackMachinePoolInstanceStatus{
+				instance("a", currentHash, true),
+				instance("b", currentHash, false),
+				instance("c", currentHash, true),
+			},
+			desiredReplicas: 1,
+			maxSurge:        1,
+			wantToDelete:    []string{"b", "a"},
+		},
+		{
+			name: "Rolling update surges before deleting ready servers",
+			instances: []infrav1.OpenStackMachinePoolInstanceStatus{
+				instance("a", outdatedHash, true),
+				instance("b", outdatedHash, true),
+			},
+			desiredReplicas: 2,
+			maxSurge:        1,
+			wantToCreate:    1,
+		},
+		{
+			name: "Rolling update waits for new servers to become ready",
+			instances: []infrav1.OpenStackMachinePoolInstanceStatus{
+				instance("a", outdatedHash, true),
+				instance("b", outdatedHash, true),
+				instance("c", currentHash, false),
+			},
+			desiredReplicas: 2,
+			maxSurge:        1,
+		},
+		{
+			name: "Rolling update replaces an outdated server once a new one is ready",
+			instances: []infrav1.OpenStackMachinePoolInstanceStatus{
+				instance("a", outdatedHash, true),
+				instance("b", outdatedHash, true),
+				instance("c", currentHash, true),
+			},
+			desiredReplicas: 2,
+			maxSurge:        1,
+			wantToDelete:    []string{"a"},
+			wantToCreate:    1,
+		},
+		{
+			name: "Rolling update deletes not ready outdated servers",
+			instances: []infrav1.OpenStackMachinePoolInstanceStatus{
+				instance("a", outdatedHash, true),
+				instance("b", outdatedHash, false),
+			},
+			desiredReplicas: 2,
+			maxSurge:        1,
+			wantToDelete:    []string{"b"},
+			wantToCreate:    2,
+		},
+		{
+			name: "Rolling update honours max surge",
+			instances: []infrav1.OpenStackMachinePoolInstanceStatus{
+				instance("a", outdatedHash, true),
+				instance("b", outdatedHash, true),
+				instance("c", outdatedHash, true),
+			},
+			desiredReplicas: 3,
+			maxSurge:        2,
+			wantToCreate:    2,
+		},
+		{
+			name: "Scale to zero",
+			instances: []infrav1.OpenStackMachinePoolInstanceStatus{
+				instance("a", outdatedHash, true),
+				instance("b", currentHash, true),
+			},
+			desiredReplicas: 0,
+			maxSurge:        1,
+			wantToDelete:    []string{"b", "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			toDelete, toCreate := planMachinePoolRollout(tt.instances, currentHash, tt.desiredReplicas, tt.maxSurge)
+			g.Expect(toDelete).To(Equal(tt.wantToDelete))
+			g.Expect(toCreate).To(Equal(tt.wantToCreate))
+		})
+	}
+}
+
+func Test_getMachinePoolFailureDomain(t *testing.T) {
+	instances := []infrav1.OpenStackMachinePoolInstanceStatus{
+		{Name: "a", TemplateHash: "current", FailureDomain: "az1"},
+		{Name: "b", TemplateHash: "current", FailureDomain: "az2"},
+		{Name: "c", TemplateHash: "outdated", FailureDomain: "az3"},
+	}
+
+	tests := []struct {
+		name           string
+		failureDomains []string
+		want           string
+	}{
+		{
+			name: "No failure domains",
+			want: "",
+		},
+		{
+			name:           "Failure domain with the fewest up to date servers",
+			failureDomains: []string{"az1", "az2", "az3"},
+			want:           "az3",
+		},
+		{
+			name:           "First failure domain on a tie",
+			failureDomains: []string{"az2", "az1"},
+			want:           "az2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(getMachinePoolFailureDomain(tt.failureDomains, instances, "current")).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestOpenStackMachinePoolReconciler_replaceOutdatedInstance(t *testing.T) {
+	const (
+		projectID      = "e9a4b5c2-5d3f-4b0e-9a57-e5e5e5d1c0a1"
+		dataSecretName = "bootstrap-data"
+	)
+
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, projectID)
+	log := testr.New(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+		Status:     clusterv1.ClusterStatus{InfrastructureReady: true},
+	}
+	machinePool := &expv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+		Spec: expv1.MachinePoolSpec{
+			Replicas: pointer.Int32(1),
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{DataSecretName: pointer.String(dataSecretName)},
+				},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: dataSecretName, Namespace: "default"},
+		Data:       map[string][]byte{"value": []byte("user-data")},
+	}
+
+	// The outdated server was created from a template with a root volume,
+	// which the current template no longer has.
+	outdatedTemplate := infrav1.OpenStackMachineSpec{
+		Flavor:     "m1.small",
+		Image:      infrav1.ImageFilter{Name: pointer.String("image")},
+		RootVolume: &infrav1.RootVolume{Size: 10},
+	}
+	openStackMachinePool := &infrav1.OpenStackMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "pool",
+			Namespace:  "default",
+			Finalizers: []string{infrav1.MachinePoolFinalizer},
+		},
+		Spec: infrav1.OpenStackMachinePoolSpec{
+			Template: infrav1.OpenStackMachineSpec{
+				Flavor: "m1.small",
+				Image:  infrav1.ImageFilter{Name: pointer.String("image")},
+			},
+			MaxSurge: 1,
+		},
+	}
+	templateHash, err := getMachinePoolTemplateHash(openStackMachinePool, dataSecretName)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	openStackMachinePool.Status = infrav1.OpenStackMachinePoolStatus{
+		TemplateHash:        templateHash,
+		ReferencedResources: infrav1.ReferencedMachineResources{ImageID: "image-id"},
+		TemplateRevisions: []infrav1.OpenStackMachinePoolTemplateRevision{
+			{
+				TemplateHash:        "outdated",
+				Template:            outdatedTemplate,
+				ReferencedResources: infrav1.ReferencedMachineResources{ImageID: "outdated-image-id"},
+			},
+		},
+		Instances: []infrav1.OpenStackMachinePoolInstanceStatus{
+			{Name: "pool-outdated", InstanceID: "outdated-id", TemplateHash: "outdated", Ready: true},
+			{Name: "pool-current", InstanceID: "current-id", TemplateHash: templateHash, Ready: true},
+		},
+	}
+
+	computeRecorder := mockScopeFactory.ComputeClient.EXPECT()
+	computeRecorder.GetServer("current-id").Return(&clients.ServerExt{Server: servers.Server{ID: "current-id", Name: "pool-current", Status: "ACTIVE"}}, nil)
+	computeRecorder.GetServer("outdated-id").Return(nil, gophercloud.ErrDefault404{}).Times(2)
+
+	// The root volume of the outdated server is looked up by the name given by
+	// the template the server was created from.
+	volumeRecorder := mockScopeFactory.VolumeClient.EXPECT()
+	volumeRecorder.ListVolumes(volumes.ListOpts{Name: "pool-outdated-root", TenantID: projectID}).Return([]volumes.Volume{{ID: "root-volume-id", Name: "pool-outdated-root"}}, nil)
+	volumeRecorder.DeleteVolume("root-volume-id", volumes.DeleteOpts{}).Return(nil)
+
+	mockScopeFactory.NetworkClient.EXPECT().ListExtensions().Return([]extensions.Extension{}, nil)
+
+	r := &OpenStackMachinePoolReconciler{
+		Client: fake.NewClientBuilder().WithObjects(secret).Build(),
+	}
+	_, err = r.reconcileNormal(context.TODO(), scope.NewWithLogger(mockScopeFactory, log), cluster, &infrav1.OpenStackCluster{}, machinePool, openStackMachinePool)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(openStackMachinePool.Status.Instances).To(HaveLen(1))
+	g.Expect(openStackMachinePool.Status.Instances[0].Name).To(Equal("pool-current"))
+	g.Expect(openStackMachinePool.Status.TemplateRevisions).To(HaveLen(1))
+	g.Expect(openStackMachinePool.Status.TemplateRevisions[0].TemplateHash).To(Equal(templateHash))
+	g.Expect(openStackMachinePool.Status.TemplateRevisions[0].ReferencedResources.ImageID).To(Equal("image-id"))
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+// OpenStackMachineTemplateReconciler reconciles the status of a OpenStackMachineTemplate object.
+type OpenStackMachineTemplateReconciler struct {
+	Client           client.Client
+	Recorder         record.EventRecorder
+	WatchFilterValue string
+	ScopeFactory     scope.Factory
+	CaCertificates   []byte // PEM encoded ca certificates.
+
+	// PCIAliasResources maps Nova PCI passthrough aliases to the resource
+	// names under which they are reported in the template capacity.
+	PCIAliasResources map[string]corev1.ResourceName
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackmachinetemplates,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackmachinetemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments,verbs=get;list;watch
+
+func (r *OpenStackMachineTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	openStackMachineTemplate := &infrav1.OpenStackMachineTemplate{}
+	if err := r.Client.Get(ctx, req.NamespacedName, openStackMachineTemplate); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !openStackMachineTemplate.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	// The cluster is optional: it is only required for its credentials if the template does not specify any.
+	var openStackCluster *infrav1.OpenStackCluster
+	if clusterName, ok := openStackMachineTemplate.Labels[clusterv1.ClusterNameLabel]; ok {
+		cluster, err := util.GetClusterByName(ctx, r.Client, openStackMachineTemplate.Namespace, clusterName)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		log = log.WithValues("cluster", cluster.Name)
+
+		if annotations.IsPaused(cluster, openStackMachineTemplate) {
+			log.Info("OpenStackMachineTemplate or linked Cluster is marked as paused. Not reconciling")
+			return reconcile.Result{}, nil
+		}
+
+		if cluster.Spec.InfrastructureRef != nil {
+			openStackCluster = &infrav1.OpenStackCluster{}
+			openStackClusterName := client.ObjectKey{
+				Namespace: openStackMachineTemplate.Namespace,
+				Name:      cluster.Spec.InfrastructureRef.Name,
+			}
+			if err := r.Client.Get(ctx, openStackClusterName, openStackCluster); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	}
+
+	if openStackMachineTemplate.Spec.Template.Spec.IdentityRef == nil && openStackCluster == nil {
+		log.Info("OpenStackMachineTemplate has no identity and does not belong to a cluster. Not reconciling")
+		return reconcile.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(openStackMachineTemplate, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the openStackMachineTemplate when exiting this function so we can persist any OpenStackMachineTemplate changes.
+	defer func() {
+		if err := patchHelper.Patch(ctx, openStackMachineTemplate); err != nil {
+			result = ctrl.Result{}
+			reterr = kerrors.NewAggregate([]error{reterr, fmt.Errorf("error patching OpenStackMachineTemplate %s/%s: %w", openStackMachineTemplate.Namespace, openStackMachineTemplate.Name, err)})
+		}
+	}()
+
+	clientScope, err := r.ScopeFactory.NewClientScopeFromMachineTemplate(ctx, r.Client, openStackMachineTemplate, openStackCluster, r.CaCertificates, log)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	scope := scope.NewWithLogger(clientScope, log)
+
+	return reconcile.Result{}, r.reconcileNormal(ctx, scope, openStackMachineTemplate)
+}
+
+func (r *OpenStackMachineTemplateReconciler) reconcileNormal(ctx context.Context, scope *scope.WithLogger, openStackMachineTemplate *infrav1.OpenStackMachineTemplate) error {
+	scope.Logger().Info("Reconciling OpenStackMachineTemplate")
+
+	computeService, err := compute.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	spec := &openStackMachineTemplate.Spec.Template.Spec
+	capacity, err := computeService.GetMachineCapacity(spec, r.PCIAliasResources)
+	if err != nil {
+		conditions.MarkFalse(openStackMachineTemplate, infrav1.CapacityReadyCondition, infrav1.FlavorNotFoundReason, clusterv1.ConditionSeverityError, "Failed to resolve flavor %s: %v", spec.Flavor, err)
+		return err
+	}
+	openStackMachineTemplate.Status.Capacity = capacity
+
+	nodeLabels := map[string]string{
+		corev1.LabelInstanceTypeStable: spec.Flavor,
+	}
+	failureDomain, err := r.getFailureDomain(ctx, openStackMachineTemplate)
+	if err != nil {
+		return err
+	}
+	if failureDomain != "" {
+		nodeLabels[corev1.LabelTopologyZone] = failureDomain
+	}
+	openStackMachineTemplate.Status.NodeLabels = nodeLabels
+
+	conditions.MarkTrue(openStackMachineTemplate, infrav1.CapacityReadyCondition)
+	return nil
+}
+
+// getFailureDomain returns the failure domain of the MachineDeployments using
+// the template. It returns an empty string unless they all agree on a single
+// failure domain.
+func (r *OpenStackMachineTemplateReconciler) getFailureDomain(ctx context.Context, openStackMachineTemplate *infrav1.OpenStackMachineTemplate) (string, error) {
+	machineDeployments := &clusterv1.MachineDeploymentList{}
+	if err := r.Client.List(ctx, machineDeployments, client.InNamespace(openStackMachineTemplate.Namespace)); err != nil {
+		return "", err
+	}
+
+	var failureDomain string
+	for i := range machineDeployments.Items {
+		machineDeployment := &machineDeployments.Items[i]
+		if !usesMachineTemplate(machineDeployment, openStackMachineTemplate) {
+			continue
+		}
+
+		if machineDeployment.Spec.Template.Spec.FailureDomain == nil {
+			return "", nil
+		}
+		if failureDomain != "" && failureDomain != *machineDeployment.Spec.Template.Spec.FailureDomain {
+			return "", nil
+		}
+		failureDomain = *machineDeployment.Spec.Template.Spec.FailureDomain
+	}
+
+	return failureDomain, nil
+}
+
+func usesMachineTemplate(machineDeployment *clusterv1.MachineDeployment, openStackMachineTemplate *infrav1.OpenStackMachineTemplate) bool {
+	infraRef := &machineDeployment.Spec.Template.Spec.InfrastructureRef
+	return infraRef.Kind == "OpenStackMachineTemplate" && infraRef.Name == openStackMachineTemplate.Name
+}
+
+func (r *OpenStackMachineTemplateReconciler) machineDeploymentToOpenStackMachineTemplate(_ context.Context, o client.Object) []ctrl.Request {
+	machineDeployment, ok := o.(*clusterv1.MachineDeployment)
+	if !ok {
+		panic(fmt.Sprintf("Expected a MachineDeployment but got a %T", o))
+	}
+
+	infraRef := &machineDeployment.Spec.Template.Spec.InfrastructureRef
+	if infraRef.Kind != "OpenStackMachineTemplate" {
+		return nil
+	}
+	return []ctrl.Request{
+		{
+			NamespacedName: client.ObjectKey{
+				Name:      infraRef.Name,
+				Namespace: machineDeployment.Namespace,
+			},
+		},
+	}
+}
+
+func (r *OpenStackMachineTemplateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.OpenStackMachineTemplate{}).
+		Watches(
+			&clusterv1.MachineDeployment{},
+			handler.EnqueueRequestsFromMapFunc(r.machineDeploymentToOpenStackMachineTemplate),
+		).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
+		Complete(r)
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+// remediationPhases are the steps performed on the server of an unhealthy machine, in order.
+var remediationPhases = []infrav1.RemediationPhase{
+	infrav1.RemediationPhaseSoftReboot,
+	infrav1.RemediationPhaseHardReboot,
+	infrav1.RemediationPhaseRebuild,
+}
+
+// OpenStackRemediationReconciler reconciles a OpenStackRemediation object.
+type OpenStackRemediationReconciler struct {
+	Client           client.Client
+	Recorder         record.EventRecorder
+	WatchFilterValue string
+	ScopeFactory     scope.Factory
+	CaCertificates   []byte // PEM encoded ca certificates.
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackremediations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackremediations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackremediationtemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=delete
+
+func (r *OpenStackRemediationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Fetch the OpenStackRemediation instance.
+	remediation := &infrav1.OpenStackRemediation{}
+	err := r.Client.Get(ctx, req.NamespacedName, remediation)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	log = log.WithValues("openStackRemediation", remediation.Name)
+
+	// The remediation is deleted by the MachineHealthCheck once the Machine is healthy again
+	if !remediation.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the Machine.
+	machine, err := util.GetOwnerMachine(ctx, r.Client, remediation.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machine == nil {
+		log.Info("MachineHealthCheck Controller has not yet set OwnerRef")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("machine", machine.Name)
+
+	if !machine.DeletionTimestamp.IsZero() {
+		log.Info("Machine is being deleted, nothing to remediate")
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machine.ObjectMeta)
+	if err != nil {
+		log.Info("Machine is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("cluster", cluster.Name)
+
+	if annotations.IsPaused(cluster, remediation) {
+		log.Info("OpenStackRemediation or linked Cluster is marked as paused. Won't reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	openStackMachine := &infrav1.OpenStackMachine{}
+	openStackMachineName := client.ObjectKey{
+		Namespace: machine.Namespace,
+		Name:      machine.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Client.Get(ctx, openStackMachineName, openStackMachine); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	openStackCluster := &infrav1.OpenStackCluster{}
+	openStackClusterName := client.ObjectKey{
+		Namespace: cluster.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Client.Get(ctx, openStackClusterName, openStackCluster); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Initialize the patch helper
+	patchHelper, err := patch.NewHelper(remediation, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the OpenStackRemediation when exiting this function so we can persist any changes.
+	defer func() {
+		if err := patchHelper.Patch(ctx, remediation); err != nil {
+			result = ctrl.Result{}
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	clientScope, err := r.ScopeFactory.NewClientScopeFromMachine(ctx, r.Client, openStackMachine, openStackCluster, r.CaCertificates, log)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	scope := scope.NewWithLogger(clientScope, log)
+
+	return r.reconcileNormal(ctx, scope, machine, openStackMachine, remediation)
+}
+
+func (r *OpenStackRemediationReconciler) reconcileNormal(ctx context.Context, scope *scope.WithLogger, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine, remediation *infrav1.OpenStackRemediation) (ctrl.Result, error) {
+	phase := remediation.Status.Phase
+	if phase == infrav1.RemediationPhaseDeleteMachine {
+		scope.Logger().Info("Remediation failed, waiting for Machine to be deleted")
+		return ctrl.Result{}, nil
+	}
+
+	// Wait for the machine to become healthy after the last remediation step
+	if phase != "" && remediation.Status.LastRemediated != nil {
+		remaining := time.Until(remediation.Status.LastRemediated.Add(remediation.Spec.GetTimeout(phase)))
+		if remaining > 0 {
+			scope.Logger().V(4).Info("Waiting for Machine to become healthy", "phase", phase, "remaining", remaining)
+			return ctrl.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	computeService, err := compute.NewService(scope)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var instanceStatus *compute.InstanceStatus
+	if openStackMachine.Spec.InstanceID != nil {
+		instanceStatus, err = computeService.GetInstanceStatus(*openStackMachine.Spec.InstanceID)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	nextPhase := infrav1.RemediationPhaseDeleteMachine
+	if instanceStatus != nil {
+		nextPhase = nextRemediationPhase(&remediation.Spec, phase, instanceStatus.ImageID() != "")
+	}
+
+	// A step which fails is recorded and escalated to the next one, so that a server which can not be
+	// rebooted or rebuilt, e.g. because it is locked or in ERROR state, eventually gets its machine deleted.
+	var messages []string
+	for nextPhase != infrav1.RemediationPhaseDeleteMachine {
+		scope.Logger().Info("Remediating Machine", "phase", nextPhase)
+
+		message, err := remediateServer(computeService, remediation, instanceStatus, nextPhase)
+		if err == nil {
+			setRemediationStatus(remediation, nextPhase, append(messages, message))
+			return ctrl.Result{RequeueAfter: remediation.Spec.GetTimeout(nextPhase)}, nil
+		}
+
+		scope.Logger().Error(err, "Remediation step failed, escalating", "phase", nextPhase)
+		messages = append(messages, err.Error())
+		nextPhase = nextRemediationPhase(&remediation.Spec, nextPhase, instanceStatus.ImageID() != "")
+	}
+
+	scope.Logger().Info("Remediating Machine", "phase", nextPhase)
+	if err := r.Client.Delete(ctx, machine); err != nil && !apierrors.IsNotFound(err) {
+		remediation.Status.Message = strings.Join(append(messages, err.Error()), "; ")
+		return ctrl.Result{}, fmt.Errorf("delete machine %s: %w", machine.Name, err)
+	}
+	setRemediationStatus(remediation, nextPhase, append(messages, fmt.Sprintf("Deleted machine %s", machine.Name)))
+	return ctrl.Result{}, nil
+}
+
+// remediateServer performs the given reboot or rebuild remediation step on the server.
+func remediateServer(computeService *compute.Service, remediation *infrav1.OpenStackRemediation, instanceStatus *compute.InstanceStatus, phase infrav1.RemediationPhase) (string, error) {
+	switch phase {
+	case infrav1.RemediationPhaseSoftReboot, infrav1.RemediationPhaseHardReboot:
+		if err := computeService.RebootInstance(remediation, instanceStatus, phase == infrav1.RemediationPhaseHardReboot); err != nil {
+			return "", fmt.Errorf("%s of server %s failed: %w", phase, instanceStatus.ID(), err)
+		}
+		return fmt.Sprintf("Rebooted server %s", instanceStatus.ID()), nil
+	case infrav1.RemediationPhaseRebuild:
+		if err := computeService.RebuildInstance(remediation, instanceStatus); err != nil {
+			return "", fmt.Errorf("%s of server %s failed: %w", phase, instanceStatus.ID(), err)
+		}
+		return fmt.Sprintf("Rebuilt server %s", instanceStatus.ID()), nil
+	}
+	return "", fmt.Errorf("unknown remediation phase %s", phase)
+}
+
+// setRemediationStatus records the remediation step which was performed, and the outcome of it and of any
+// step which failed before it.
+func setRemediationStatus(remediation *infrav1.OpenStackRemediation, phase infrav1.RemediationPhase, messages []string) {
+	now := metav1.Now()
+	remediation.Status.Phase = phase
+	remediation.Status.LastRemediated = &now
+	remediation.Status.Message = strings.Join(messages, "; ")
+}
+
+// nextRemediationPhase returns the remediation step to perform after the given one.
+// Steps with a timeout of 0 are skipped, as is the rebuild of a server which can not be rebuilt.
+// Deleting the machine is the last resort.
+func nextRemediationPhase(spec *infrav1.OpenStackRemediationSpec, phase infrav1.RemediationPhase, rebuildable bool) infrav1.RemediationPhase {
+	next := 0
+	for i := range remediationPhases {
+		if remediationPhases[i] == phase {
+			next = i + 1
+			break
+		}
+	}
+
+	for _, p := range remediationPhases[next:] {
+		if spec.GetTimeout(p) == 0 {
+			continue
+		}
+		if p == infrav1.RemediationPhaseRebuild && !rebuildable {
+			continue
+		}
+		return p
+	}
+	return infrav1.RemediationPhaseDeleteMachine
+}
+
+func (r *OpenStackRemediationReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.OpenStackRemediation{}).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(log, r.WatchFilterValue)).
+		Complete(r)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_nextRemediationPhase(t *testing.T) {
+	skip := &metav1.Duration{Duration: 0}
+	timeout := &metav1.Duration{Duration: time.Minute}
+
+	tests := []struct {
+		name        string
+		spec        infrav1.OpenStackRemediationSpec
+		phase       infrav1.RemediationPhase
+		rebuildable bool
+		want        infrav1.RemediationPhase
+	}{
+		{
+			name:        "Soft reboot first",
+			rebuildable: true,
+			want:        infrav1.RemediationPhaseSoftReboot,
+		},
+		{
+			name:        "Hard reboot after soft reboot",
+			phase:       infrav1.RemediationPhaseSoftReboot,
+			rebuildable: true,
+			want:        infrav1.RemediationPhaseHardReboot,
+		},
+		{
+			name:        "Rebuild after hard reboot",
+			phase:       infrav1.RemediationPhaseHardReboot,
+			rebuildable: true,
+			want:        infrav1.RemediationPhaseRebuild,
+		},
+		{
+			name:        "Delete after rebuild",
+			phase:       infrav1.RemediationPhaseRebuild,
+			rebuildable: true,
+			want:        infrav1.RemediationPhaseDeleteMachine,
+		},
+		{
+			name:  "Server booted from volume is not rebuilt",
+			phase: infrav1.RemediationPhaseHardReboot,
+			want:  infrav1.RemediationPhaseDeleteMachine,
+		},
+		{
+			name:        "Skip disabled steps",
+			spec:        infrav1.OpenStackRemediationSpec{SoftRebootTimeout: skip, HardRebootTimeout: skip, RebuildTimeout: timeout},
+			rebuildable: true,
+			want:        infrav1.RemediationPhaseRebuild,
+		},
+		{
+			name:        "All steps disabled",
+			spec:        infrav1.OpenStackRemediationSpec{SoftRebootTimeout: skip, HardRebootTimeout: skip, RebuildTimeout: skip},
+			rebuildable: true,
+			want:        infrav1.RemediationPhaseDeleteMachine,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(nextRemediationPhase(&tt.spec, tt.phase, tt.rebuildable)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestOpenStackRemediationReconciler_reconcileNormal(t *testing.T) {
+	const serverID = "server-id"
+	errLocked := errors.New("server is locked")
+
+	tests := []struct {
+		name          string
+		expect        func(m *mock.MockComputeClientMockRecorder)
+		wantPhase     infrav1.RemediationPhase
+		wantDeleted   bool
+		wantMessageRe string
+	}{
+		{
+			name: "Soft reboot",
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.RebootServer(serverID, servers.RebootOpts{Type: servers.SoftReboot}).Return(nil)
+			},
+			wantPhase:     infrav1.RemediationPhaseSoftReboot,
+			wantMessageRe: "^Rebooted server server-id$",
+		},
+		{
+			name: "Failed soft reboot escalates to hard reboot",
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.RebootServer(serverID, servers.RebootOpts{Type: servers.SoftReboot}).Return(errLocked)
+				m.RebootServer(serverID, servers.RebootOpts{Type: servers.HardReboot}).Return(nil)
+			},
+			wantPhase:     infrav1.RemediationPhaseHardReboot,
+			wantMessageRe: "^SoftReboot of server server-id failed: server is locked; Rebooted server server-id$",
+		},
+		{
+			name: "Failed steps escalate to deleting the machine",
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.RebootServer(serverID, servers.RebootOpts{Type: servers.SoftReboot}).Return(errLocked)
+				m.RebootServer(serverID, servers.RebootOpts{Type: servers.HardReboot}).Return(errLocked)
+				m.RebuildServer(serverID, servers.RebuildOpts{ImageRef: "image-id"}).Return(nil, errLocked)
+			},
+			wantPhase:     infrav1.RemediationPhaseDeleteMachine,
+			wantDeleted:   true,
+			wantMessageRe: "Rebuild of server server-id failed: server is locked; Deleted machine machine$",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+
+			computeRecorder := mockScopeFactory.ComputeClient.EXPECT()
+			computeRecorder.GetServer(serverID).Return(&clients.ServerExt{Server: servers.Server{
+				ID:     serverID,
+				Status: "ERROR",
+				Image:  map[string]interface{}{"id": "image-id"},
+			}}, nil)
+			tt.expect(computeRecorder)
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+			machine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"}}
+			r := &OpenStackRemediationReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(machine).Build(),
+			}
+			openStackMachine := &infrav1.OpenStackMachine{Spec: infrav1.OpenStackMachineSpec{InstanceID: pointer.String(serverID)}}
+			remediation := &infrav1.OpenStackRemediation{}
+
+			_, err := r.reconcileNormal(context.TODO(), scope.NewWithLogger(mockScopeFactory, testr.New(t)), machine, openStackMachine, remediation)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(remediation.Status.Phase).To(Equal(tt.wantPhase))
+			g.Expect(remediation.Status.LastRemediated).NotTo(BeNil())
+			g.Expect(remediation.Status.Message).To(MatchRegexp(tt.wantMessageRe))
+
+			err = r.Client.Get(context.TODO(), client.ObjectKeyFromObject(machine), &clusterv1.Machine{})
+			g.Expect(apierrors.IsNotFound(err)).To(Equal(tt.wantDeleted))
+		})
+	}
+}
@@ -36,6 +36,7 @@ import (
 	_ "k8s.io/component-base/logs/json/register"
 	"k8s.io/klog/v2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/flags"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -46,6 +47,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
 	infrav1alpha5 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha5"
 	infrav1alpha6 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha6"
 	infrav1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
@@ -97,6 +99,8 @@ var (
 	caCertsPath                 string
 	showVersion                 bool
 	scopeCacheMaxSize           int
+	enableMachinePool           bool
+	pciAliasResources           map[string]string
 	logOptions                  = logs.NewOptions()
 )
 
@@ -104,11 +108,13 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = clusterv1.AddToScheme(scheme)
 	_ = ipamv1.AddToScheme(scheme)
+	_ = expv1.AddToScheme(scheme)
 	_ = infrav1.AddToScheme(scheme)
 	_ = infrav1alpha5.AddToScheme(scheme)
 	_ = infrav1alpha6.AddToScheme(scheme)
 	_ = infrav1alpha7.AddToScheme(scheme)
 	_ = infrav1alpha1.AddToScheme(scheme)
+	_ = infrav1alpha2.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 
 	metrics.RegisterAPIPrometheusMetrics()
@@ -173,6 +179,11 @@ func InitFlags(fs *pflag.FlagSet) {
 
 	fs.IntVar(&scopeCacheMaxSize, "scope-cache-max-size", 10, "The maximum credentials count the operator should keep in cache. Setting this value to 0 means no cache.")
 
+	fs.BoolVar(&enableMachinePool, "enable-machine-pool", false, "Enable the OpenStackMachinePool controller. Requires the Cluster API MachinePool feature to be enabled.")
+
+	fs.StringToStringVar(&pciAliasResources, "pci-passthrough-alias-resources", nil,
+		"Comma-separated mapping of Nova PCI passthrough aliases to the resource names reported in the capacity of OpenStackMachineTemplates, e.g. a100=nvidia.com/gpu,mi210=amd.com/gpu. Aliases which are not mapped are not reported.")
+
 	fs.BoolVar(&showVersion, "version", false, "Show current version and exit.")
 
 	fs.StringVar(&tlsOptions.TLSMinVersion, "tls-min-version", TLSVersion12,
@@ -341,6 +352,43 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, caCerts []byte, sco
 		setupLog.Error(err, "unable to create controller", "controller", "OpenStackMachine")
 		os.Exit(1)
 	}
+	aliasResources := make(map[string]corev1.ResourceName, len(pciAliasResources))
+	for alias, resourceName := range pciAliasResources {
+		aliasResources[alias] = corev1.ResourceName(resourceName)
+	}
+	if err := (&controllers.OpenStackMachineTemplateReconciler{
+		Client:            mgr.GetClient(),
+		Recorder:          mgr.GetEventRecorderFor("openstackmachinetemplate-controller"),
+		WatchFilterValue:  watchFilterValue,
+		ScopeFactory:      scopeFactory,
+		CaCertificates:    caCerts,
+		PCIAliasResources: aliasResources,
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OpenStackMachineTemplate")
+		os.Exit(1)
+	}
+	if enableMachinePool {
+		if err := (&controllers.OpenStackMachinePoolReconciler{
+			Client:           mgr.GetClient(),
+			Recorder:         mgr.GetEventRecorderFor("openstackmachinepool-controller"),
+			WatchFilterValue: watchFilterValue,
+			ScopeFactory:     scopeFactory,
+			CaCertificates:   caCerts,
+		}).SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "OpenStackMachinePool")
+			os.Exit(1)
+		}
+	}
+	if err := (&controllers.OpenStackRemediationReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorderFor("openstackremediation-controller"),
+		WatchFilterValue: watchFilterValue,
+		ScopeFactory:     scopeFactory,
+		CaCertificates:   caCerts,
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OpenStackRemediation")
+		os.Exit(1)
+	}
 	if err := (&controllers.OpenStackFloatingIPPoolReconciler{
 		Client:         mgr.GetClient(),
 		Recorder:       mgr.GetEventRecorderFor("floatingippool-controller"),
@@ -351,6 +399,26 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, caCerts []byte, sco
 		setupLog.Error(err, "unable to create controller", "controller", "FloatingIPPool")
 		os.Exit(1)
 	}
+	if err := (&controllers.OpenStackFixedIPPoolReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorderFor("openstackfixedippool-controller"),
+		WatchFilterValue: watchFilterValue,
+		ScopeFactory:     scopeFactory,
+		CaCertificates:   caCerts,
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OpenStackFixedIPPool")
+		os.Exit(1)
+	}
+	if err := (&controllers.OpenStackImageReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorderFor("openstackimage-controller"),
+		WatchFilterValue: watchFilterValue,
+		ScopeFactory:     scopeFactory,
+		CaCertificates:   caCerts,
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OpenStackImage")
+		os.Exit(1)
+	}
 }
 
 func setupWebhooks(mgr ctrl.Manager) {
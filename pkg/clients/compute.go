@@ -24,6 +24,8 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/attachinterfaces"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/tags"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/volumeattach"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/utils/openstack/clientconfig"
@@ -54,14 +56,21 @@ type ComputeClient interface {
 	ListAvailabilityZones() ([]availabilityzones.AvailabilityZone, error)
 
 	GetFlavorFromName(flavor string) (*flavors.Flavor, error)
+	ListFlavorExtraSpecs(flavorID string) (map[string]string, error)
 	CreateServer(createOpts servers.CreateOptsBuilder) (*ServerExt, error)
 	DeleteServer(serverID string) error
 	GetServer(serverID string) (*ServerExt, error)
 	ListServers(listOpts servers.ListOptsBuilder) ([]ServerExt, error)
+	ReplaceAllServerTags(serverID string, opts tags.ReplaceAllOptsBuilder) ([]string, error)
+	UpdateServerMetadata(serverID string, opts servers.UpdateMetadataOptsBuilder) (map[string]string, error)
+	RebootServer(serverID string, opts servers.RebootOptsBuilder) error
+	RebuildServer(serverID string, opts servers.RebuildOptsBuilder) (*ServerExt, error)
 
 	ListAttachedInterfaces(serverID string) ([]attachinterfaces.Interface, error)
 	DeleteAttachedInterface(serverID, portID string) error
 
+	DeleteVolumeAttachment(serverID, volumeID string) error
+
 	ListServerGroups() ([]servergroups.ServerGroup, error)
 }
 
@@ -100,6 +109,15 @@ func (c computeClient) GetFlavorFromName(flavor string) (*flavors.Flavor, error)
 	return f, mc.ObserveRequest(err)
 }
 
+func (c computeClient) ListFlavorExtraSpecs(flavorID string) (map[string]string, error) {
+	mc := metrics.NewMetricPrometheusContext("flavor_extra_specs", "list")
+	extraSpecs, err := flavors.ListExtraSpecs(c.client, flavorID).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return extraSpecs, nil
+}
+
 func (c computeClient) CreateServer(createOpts servers.CreateOptsBuilder) (*ServerExt, error) {
 	var server ServerExt
 	mc := metrics.NewMetricPrometheusContext("server", "create")
@@ -137,6 +155,40 @@ func (c computeClient) ListServers(listOpts servers.ListOptsBuilder) ([]ServerEx
 	return serverList, err
 }
 
+func (c computeClient) ReplaceAllServerTags(serverID string, opts tags.ReplaceAllOptsBuilder) ([]string, error) {
+	mc := metrics.NewMetricPrometheusContext("server_tags", "replace_all")
+	serverTags, err := tags.ReplaceAll(c.client, serverID, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return serverTags, nil
+}
+
+func (c computeClient) UpdateServerMetadata(serverID string, opts servers.UpdateMetadataOptsBuilder) (map[string]string, error) {
+	mc := metrics.NewMetricPrometheusContext("server_metadata", "update")
+	metadata, err := servers.UpdateMetadata(c.client, serverID, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+func (c computeClient) RebootServer(serverID string, opts servers.RebootOptsBuilder) error {
+	mc := metrics.NewMetricPrometheusContext("server", "reboot")
+	err := servers.Reboot(c.client, serverID, opts).ExtractErr()
+	return mc.ObserveRequest(err)
+}
+
+func (c computeClient) RebuildServer(serverID string, opts servers.RebuildOptsBuilder) (*ServerExt, error) {
+	var server ServerExt
+	mc := metrics.NewMetricPrometheusContext("server", "rebuild")
+	err := servers.Rebuild(c.client, serverID, opts).ExtractInto(&server)
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return &server, nil
+}
+
 func (c computeClient) ListAttachedInterfaces(serverID string) ([]attachinterfaces.Interface, error) {
 	mc := metrics.NewMetricPrometheusContext("server_os_interface", "list")
 	interfaces, err := attachinterfaces.List(c.client, serverID).AllPages()
@@ -152,6 +204,12 @@ func (c computeClient) DeleteAttachedInterface(serverID, portID string) error {
 	return mc.ObserveRequestIgnoreNotFoundorConflict(err)
 }
 
+func (c computeClient) DeleteVolumeAttachment(serverID, volumeID string) error {
+	mc := metrics.NewMetricPrometheusContext("server_os_volume_attachment", "delete")
+	err := volumeattach.Delete(c.client, serverID, volumeID).ExtractErr()
+	return mc.ObserveRequestIgnoreNotFound(err)
+}
+
 func (c computeClient) ListServerGroups() ([]servergroups.ServerGroup, error) {
 	mc := metrics.NewMetricPrometheusContext("server_group", "list")
 	opts := servergroups.ListOpts{}
@@ -177,6 +235,10 @@ func (e computeErrorClient) GetFlavorFromName(_ string) (*flavors.Flavor, error)
 	return nil, e.error
 }
 
+func (e computeErrorClient) ListFlavorExtraSpecs(_ string) (map[string]string, error) {
+	return nil, e.error
+}
+
 func (e computeErrorClient) CreateServer(_ servers.CreateOptsBuilder) (*ServerExt, error) {
 	return nil, e.error
 }
@@ -193,6 +255,22 @@ func (e computeErrorClient) ListServers(_ servers.ListOptsBuilder) ([]ServerExt,
 	return nil, e.error
 }
 
+func (e computeErrorClient) ReplaceAllServerTags(_ string, _ tags.ReplaceAllOptsBuilder) ([]string, error) {
+	return nil, e.error
+}
+
+func (e computeErrorClient) UpdateServerMetadata(_ string, _ servers.UpdateMetadataOptsBuilder) (map[string]string, error) {
+	return nil, e.error
+}
+
+func (e computeErrorClient) RebootServer(_ string, _ servers.RebootOptsBuilder) error {
+	return e.error
+}
+
+func (e computeErrorClient) RebuildServer(_ string, _ servers.RebuildOptsBuilder) (*ServerExt, error) {
+	return nil, e.error
+}
+
 func (e computeErrorClient) ListAttachedInterfaces(_ string) ([]attachinterfaces.Interface, error) {
 	return nil, e.error
 }
@@ -201,6 +279,10 @@ func (e computeErrorClient) DeleteAttachedInterface(_, _ string) error {
 	return e.error
 }
 
+func (e computeErrorClient) DeleteVolumeAttachment(_, _ string) error {
+	return e.error
+}
+
 func (e computeErrorClient) ListServerGroups() ([]servergroups.ServerGroup, error) {
 	return nil, e.error
 }
@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+	"github.com/gophercloud/utils/openstack/clientconfig"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
+)
+
+type DNSClient interface {
+	ListZones(opts zones.ListOptsBuilder) ([]zones.Zone, error)
+	GetZone(zoneID string) (*zones.Zone, error)
+
+	ListRecordSets(zoneID string, opts recordsets.ListOptsBuilder) ([]recordsets.RecordSet, error)
+	CreateRecordSet(zoneID string, opts recordsets.CreateOptsBuilder) (*recordsets.RecordSet, error)
+	UpdateRecordSet(zoneID, recordSetID string, opts recordsets.UpdateOptsBuilder) (*recordsets.RecordSet, error)
+	DeleteRecordSet(zoneID, recordSetID string) error
+}
+
+type dnsClient struct{ client *gophercloud.ServiceClient }
+
+// NewDNSClient returns a new designate client.
+func NewDNSClient(providerClient *gophercloud.ProviderClient, providerClientOpts *clientconfig.ClientOpts) (DNSClient, error) {
+	dns, err := openstack.NewDNSV2(providerClient, gophercloud.EndpointOpts{
+		Region:       providerClientOpts.RegionName,
+		Availability: clientconfig.GetEndpointType(providerClientOpts.EndpointType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dns service client: %v", err)
+	}
+
+	return &dnsClient{dns}, nil
+}
+
+func (c dnsClient) ListZones(opts zones.ListOptsBuilder) ([]zones.Zone, error) {
+	mc := metrics.NewMetricPrometheusContext("dns_zone", "list")
+	allPages, err := zones.List(c.client, opts).AllPages()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return zones.ExtractZones(allPages)
+}
+
+func (c dnsClient) GetZone(zoneID string) (*zones.Zone, error) {
+	mc := metrics.NewMetricPrometheusContext("dns_zone", "get")
+	zone, err := zones.Get(c.client, zoneID).Extract()
+	return zone, mc.ObserveRequestIgnoreNotFound(err)
+}
+
+func (c dnsClient) ListRecordSets(zoneID string, opts recordsets.ListOptsBuilder) ([]recordsets.RecordSet, error) {
+	mc := metrics.NewMetricPrometheusContext("dns_recordset", "list")
+	allPages, err := recordsets.ListByZone(c.client, zoneID, opts).AllPages()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	return recordsets.ExtractRecordSets(allPages)
+}
+
+func (c dnsClient) CreateRecordSet(zoneID string, opts recordsets.CreateOptsBuilder) (*recordsets.RecordSet, error) {
+	mc := metrics.NewMetricPrometheusContext("dns_recordset", "create")
+	recordSet, err := recordsets.Create(c.client, zoneID, opts).Extract()
+	return recordSet, mc.ObserveRequest(err)
+}
+
+func (c dnsClient) UpdateRecordSet(zoneID, recordSetID string, opts recordsets.UpdateOptsBuilder) (*recordsets.RecordSet, error) {
+	mc := metrics.NewMetricPrometheusContext("dns_recordset", "update")
+	recordSet, err := recordsets.Update(c.client, zoneID, recordSetID, opts).Extract()
+	return recordSet, mc.ObserveRequest(err)
+}
+
+func (c dnsClient) DeleteRecordSet(zoneID, recordSetID string) error {
+	mc := metrics.NewMetricPrometheusContext("dns_recordset", "delete")
+	err := recordsets.Delete(c.client, zoneID, recordSetID).ExtractErr()
+	return mc.ObserveRequestIgnoreNotFound(err)
+}
@@ -18,9 +18,12 @@ package clients
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imagedata"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
 	"github.com/gophercloud/utils/openstack/clientconfig"
 
@@ -29,6 +32,11 @@ import (
 
 type ImageClient interface {
 	ListImages(listOpts images.ListOptsBuilder) ([]images.Image, error)
+	GetImage(id string) (*images.Image, error)
+	CreateImage(createOpts images.CreateOptsBuilder) (*images.Image, error)
+	DeleteImage(id string) error
+	StageImageData(id string, data io.Reader) error
+	ImportImage(id string, opts imageimport.CreateOptsBuilder) error
 }
 
 type imageClient struct{ client *gophercloud.ServiceClient }
@@ -55,6 +63,42 @@ func (c imageClient) ListImages(listOpts images.ListOptsBuilder) ([]images.Image
 	return images.ExtractImages(pages)
 }
 
+func (c imageClient) GetImage(id string) (*images.Image, error) {
+	mc := metrics.NewMetricPrometheusContext("image", "get")
+	image, err := images.Get(c.client, id).Extract()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	return image, nil
+}
+
+func (c imageClient) CreateImage(createOpts images.CreateOptsBuilder) (*images.Image, error) {
+	mc := metrics.NewMetricPrometheusContext("image", "create")
+	image, err := images.Create(c.client, createOpts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return image, nil
+}
+
+func (c imageClient) DeleteImage(id string) error {
+	mc := metrics.NewMetricPrometheusContext("image", "delete")
+	err := images.Delete(c.client, id).ExtractErr()
+	return mc.ObserveRequestIgnoreNotFound(err)
+}
+
+func (c imageClient) StageImageData(id string, data io.Reader) error {
+	mc := metrics.NewMetricPrometheusContext("image_data", "stage")
+	err := imagedata.Stage(c.client, id, data).ExtractErr()
+	return mc.ObserveRequest(err)
+}
+
+func (c imageClient) ImportImage(id string, opts imageimport.CreateOptsBuilder) error {
+	mc := metrics.NewMetricPrometheusContext("image_import", "create")
+	err := imageimport.Create(c.client, id, opts).ExtractErr()
+	return mc.ObserveRequest(err)
+}
+
 type imageErrorClient struct{ error }
 
 // NewImageErrorClient returns an ImageClient in which every method returns the given error.
@@ -65,3 +109,23 @@ func NewImageErrorClient(e error) ImageClient {
 func (e imageErrorClient) ListImages(_ images.ListOptsBuilder) ([]images.Image, error) {
 	return nil, e.error
 }
+
+func (e imageErrorClient) GetImage(_ string) (*images.Image, error) {
+	return nil, e.error
+}
+
+func (e imageErrorClient) CreateImage(_ images.CreateOptsBuilder) (*images.Image, error) {
+	return nil, e.error
+}
+
+func (e imageErrorClient) DeleteImage(_ string) error {
+	return e.error
+}
+
+func (e imageErrorClient) StageImageData(_ string, _ io.Reader) error {
+	return e.error
+}
+
+func (e imageErrorClient) ImportImage(_ string, _ imageimport.CreateOptsBuilder) error {
+	return e.error
+}
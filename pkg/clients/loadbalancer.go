@@ -46,6 +46,7 @@ type LbClient interface {
 	CreatePool(opts pools.CreateOptsBuilder) (*pools.Pool, error)
 	ListPools(opts pools.ListOptsBuilder) ([]pools.Pool, error)
 	GetPool(id string) (*pools.Pool, error)
+	UpdatePool(id string, opts pools.UpdateOptsBuilder) (*pools.Pool, error)
 	DeletePool(id string) error
 	CreatePoolMember(poolID string, opts pools.CreateMemberOptsBuilder) (*pools.Member, error)
 	ListPoolMember(poolID string, opts pools.ListMembersOptsBuilder) ([]pools.Member, error)
@@ -182,6 +183,15 @@ func (l lbClient) GetPool(id string) (*pools.Pool, error) {
 	return pool, nil
 }
 
+func (l lbClient) UpdatePool(id string, opts pools.UpdateOptsBuilder) (*pools.Pool, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_pool", "update")
+	pool, err := pools.Update(l.serviceClient, id, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
 func (l lbClient) DeletePool(id string) error {
 	mc := metrics.NewMetricPrometheusContext("loadbalancer_pool", "delete")
 	err := pools.Delete(l.serviceClient, id).ExtractErr()
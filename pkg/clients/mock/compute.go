@@ -27,6 +27,7 @@ import (
 	attachinterfaces "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/attachinterfaces"
 	availabilityzones "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	servergroups "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	tags "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/tags"
 	flavors "github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	servers "github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	clients "sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
@@ -98,6 +99,20 @@ func (mr *MockComputeClientMockRecorder) DeleteServer(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServer", reflect.TypeOf((*MockComputeClient)(nil).DeleteServer), arg0)
 }
 
+// DeleteVolumeAttachment mocks base method.
+func (m *MockComputeClient) DeleteVolumeAttachment(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumeAttachment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolumeAttachment indicates an expected call of DeleteVolumeAttachment.
+func (mr *MockComputeClientMockRecorder) DeleteVolumeAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeAttachment", reflect.TypeOf((*MockComputeClient)(nil).DeleteVolumeAttachment), arg0, arg1)
+}
+
 // GetFlavorFromName mocks base method.
 func (m *MockComputeClient) GetFlavorFromName(arg0 string) (*flavors.Flavor, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +173,21 @@ func (mr *MockComputeClientMockRecorder) ListAvailabilityZones() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailabilityZones", reflect.TypeOf((*MockComputeClient)(nil).ListAvailabilityZones))
 }
 
+// ListFlavorExtraSpecs mocks base method.
+func (m *MockComputeClient) ListFlavorExtraSpecs(arg0 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlavorExtraSpecs", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlavorExtraSpecs indicates an expected call of ListFlavorExtraSpecs.
+func (mr *MockComputeClientMockRecorder) ListFlavorExtraSpecs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlavorExtraSpecs", reflect.TypeOf((*MockComputeClient)(nil).ListFlavorExtraSpecs), arg0)
+}
+
 // ListServerGroups mocks base method.
 func (m *MockComputeClient) ListServerGroups() ([]servergroups.ServerGroup, error) {
 	m.ctrl.T.Helper()
@@ -187,3 +217,62 @@ func (mr *MockComputeClientMockRecorder) ListServers(arg0 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServers", reflect.TypeOf((*MockComputeClient)(nil).ListServers), arg0)
 }
+
+// RebootServer mocks base method.
+func (m *MockComputeClient) RebootServer(arg0 string, arg1 servers.RebootOptsBuilder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootServer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebootServer indicates an expected call of RebootServer.
+func (mr *MockComputeClientMockRecorder) RebootServer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootServer", reflect.TypeOf((*MockComputeClient)(nil).RebootServer), arg0, arg1)
+}
+
+// RebuildServer mocks base method.
+func (m *MockComputeClient) RebuildServer(arg0 string, arg1 servers.RebuildOptsBuilder) (*clients.ServerExt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildServer", arg0, arg1)
+	ret0, _ := ret[0].(*clients.ServerExt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildServer indicates an expected call of RebuildServer.
+func (mr *MockComputeClientMockRecorder) RebuildServer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildServer", reflect.TypeOf((*MockComputeClient)(nil).RebuildServer), arg0, arg1)
+}
+
+// ReplaceAllServerTags mocks base method.
+func (m *MockComputeClient) ReplaceAllServerTags(arg0 string, arg1 tags.ReplaceAllOptsBuilder) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAllServerTags", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceAllServerTags indicates an expected call of ReplaceAllServerTags.
+func (mr *MockComputeClientMockRecorder) ReplaceAllServerTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAllServerTags", reflect.TypeOf((*MockComputeClient)(nil).ReplaceAllServerTags), arg0, arg1)
+}
+
+// UpdateServerMetadata mocks base method.
+func (m *MockComputeClient) UpdateServerMetadata(arg0 string, arg1 servers.UpdateMetadataOptsBuilder) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServerMetadata", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServerMetadata indicates an expected call of UpdateServerMetadata.
+func (mr *MockComputeClientMockRecorder) UpdateServerMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServerMetadata", reflect.TypeOf((*MockComputeClient)(nil).UpdateServerMetadata), arg0, arg1)
+}
@@ -21,9 +21,11 @@ limitations under the License.
 package mock
 
 import (
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	imageimport "github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
 	images "github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
 )
 
@@ -50,6 +52,64 @@ func (m *MockImageClient) EXPECT() *MockImageClientMockRecorder {
 	return m.recorder
 }
 
+// CreateImage mocks base method.
+func (m *MockImageClient) CreateImage(arg0 images.CreateOptsBuilder) (*images.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImage", arg0)
+	ret0, _ := ret[0].(*images.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImage indicates an expected call of CreateImage.
+func (mr *MockImageClientMockRecorder) CreateImage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*MockImageClient)(nil).CreateImage), arg0)
+}
+
+// DeleteImage mocks base method.
+func (m *MockImageClient) DeleteImage(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockImageClientMockRecorder) DeleteImage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockImageClient)(nil).DeleteImage), arg0)
+}
+
+// GetImage mocks base method.
+func (m *MockImageClient) GetImage(arg0 string) (*images.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", arg0)
+	ret0, _ := ret[0].(*images.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImage indicates an expected call of GetImage.
+func (mr *MockImageClientMockRecorder) GetImage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockImageClient)(nil).GetImage), arg0)
+}
+
+// ImportImage mocks base method.
+func (m *MockImageClient) ImportImage(arg0 string, arg1 imageimport.CreateOptsBuilder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportImage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportImage indicates an expected call of ImportImage.
+func (mr *MockImageClientMockRecorder) ImportImage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportImage", reflect.TypeOf((*MockImageClient)(nil).ImportImage), arg0, arg1)
+}
+
 // ListImages mocks base method.
 func (m *MockImageClient) ListImages(arg0 images.ListOptsBuilder) ([]images.Image, error) {
 	m.ctrl.T.Helper()
@@ -64,3 +124,17 @@ func (mr *MockImageClientMockRecorder) ListImages(arg0 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockImageClient)(nil).ListImages), arg0)
 }
+
+// StageImageData mocks base method.
+func (m *MockImageClient) StageImageData(arg0 string, arg1 io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StageImageData", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StageImageData indicates an expected call of StageImageData.
+func (mr *MockImageClientMockRecorder) StageImageData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StageImageData", reflect.TypeOf((*MockImageClient)(nil).StageImageData), arg0, arg1)
+}
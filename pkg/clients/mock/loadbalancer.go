@@ -364,3 +364,18 @@ func (mr *MockLbClientMockRecorder) UpdateListener(arg0, arg1 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListener", reflect.TypeOf((*MockLbClient)(nil).UpdateListener), arg0, arg1)
 }
+
+// UpdatePool mocks base method.
+func (m *MockLbClient) UpdatePool(arg0 string, arg1 pools.UpdateOptsBuilder) (*pools.Pool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePool", arg0, arg1)
+	ret0, _ := ret[0].(*pools.Pool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePool indicates an expected call of UpdatePool.
+func (mr *MockLbClientMockRecorder) UpdatePool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePool", reflect.TypeOf((*MockLbClient)(nil).UpdatePool), arg0, arg1)
+}
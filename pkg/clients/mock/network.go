@@ -27,13 +27,18 @@ import (
 	extensions "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
 	attributestags "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	floatingips "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	portforwarding "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
 	routers "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	policies "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	quotas "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
 	groups "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	rules "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	subnetpools "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	trunks "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
 	networks "github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	ports "github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	subnets "github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	clients "sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 )
 
 // MockNetworkClient is a mock of NetworkClient interface.
@@ -119,6 +124,21 @@ func (mr *MockNetworkClientMockRecorder) CreatePort(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePort", reflect.TypeOf((*MockNetworkClient)(nil).CreatePort), arg0)
 }
 
+// CreatePortForwarding mocks base method.
+func (m *MockNetworkClient) CreatePortForwarding(arg0 string, arg1 portforwarding.CreateOptsBuilder) (*portforwarding.PortForwarding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePortForwarding", arg0, arg1)
+	ret0, _ := ret[0].(*portforwarding.PortForwarding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePortForwarding indicates an expected call of CreatePortForwarding.
+func (mr *MockNetworkClientMockRecorder) CreatePortForwarding(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortForwarding", reflect.TypeOf((*MockNetworkClient)(nil).CreatePortForwarding), arg0, arg1)
+}
+
 // CreateRouter mocks base method.
 func (m *MockNetworkClient) CreateRouter(arg0 routers.CreateOptsBuilder) (*routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -135,10 +155,10 @@ func (mr *MockNetworkClientMockRecorder) CreateRouter(arg0 interface{}) *gomock.
 }
 
 // CreateSecGroup mocks base method.
-func (m *MockNetworkClient) CreateSecGroup(arg0 groups.CreateOptsBuilder) (*groups.SecGroup, error) {
+func (m *MockNetworkClient) CreateSecGroup(arg0 groups.CreateOptsBuilder) (*clients.SecGroupExt, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSecGroup", arg0)
-	ret0, _ := ret[0].(*groups.SecGroup)
+	ret0, _ := ret[0].(*clients.SecGroupExt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -150,10 +170,10 @@ func (mr *MockNetworkClientMockRecorder) CreateSecGroup(arg0 interface{}) *gomoc
 }
 
 // CreateSecGroupRule mocks base method.
-func (m *MockNetworkClient) CreateSecGroupRule(arg0 rules.CreateOptsBuilder) (*rules.SecGroupRule, error) {
+func (m *MockNetworkClient) CreateSecGroupRule(arg0 rules.CreateOptsBuilder) (*clients.SecGroupRuleExt, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSecGroupRule", arg0)
-	ret0, _ := ret[0].(*rules.SecGroupRule)
+	ret0, _ := ret[0].(*clients.SecGroupRuleExt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -236,6 +256,20 @@ func (mr *MockNetworkClientMockRecorder) DeletePort(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePort", reflect.TypeOf((*MockNetworkClient)(nil).DeletePort), arg0)
 }
 
+// DeletePortForwarding mocks base method.
+func (m *MockNetworkClient) DeletePortForwarding(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePortForwarding", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePortForwarding indicates an expected call of DeletePortForwarding.
+func (mr *MockNetworkClientMockRecorder) DeletePortForwarding(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortForwarding", reflect.TypeOf((*MockNetworkClient)(nil).DeletePortForwarding), arg0, arg1)
+}
+
 // DeleteRouter mocks base method.
 func (m *MockNetworkClient) DeleteRouter(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -336,6 +370,21 @@ func (mr *MockNetworkClientMockRecorder) GetNetwork(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetwork", reflect.TypeOf((*MockNetworkClient)(nil).GetNetwork), arg0)
 }
 
+// GetNetworkExt mocks base method.
+func (m *MockNetworkClient) GetNetworkExt(arg0 string) (*clients.NetworkExt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkExt", arg0)
+	ret0, _ := ret[0].(*clients.NetworkExt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkExt indicates an expected call of GetNetworkExt.
+func (mr *MockNetworkClientMockRecorder) GetNetworkExt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkExt", reflect.TypeOf((*MockNetworkClient)(nil).GetNetworkExt), arg0)
+}
+
 // GetPort mocks base method.
 func (m *MockNetworkClient) GetPort(arg0 string) (*ports.Port, error) {
 	m.ctrl.T.Helper()
@@ -351,6 +400,21 @@ func (mr *MockNetworkClientMockRecorder) GetPort(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPort", reflect.TypeOf((*MockNetworkClient)(nil).GetPort), arg0)
 }
 
+// GetQuotaDetail mocks base method.
+func (m *MockNetworkClient) GetQuotaDetail(arg0 string) (*quotas.QuotaDetailSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaDetail", arg0)
+	ret0, _ := ret[0].(*quotas.QuotaDetailSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaDetail indicates an expected call of GetQuotaDetail.
+func (mr *MockNetworkClientMockRecorder) GetQuotaDetail(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaDetail", reflect.TypeOf((*MockNetworkClient)(nil).GetQuotaDetail), arg0)
+}
+
 // GetRouter mocks base method.
 func (m *MockNetworkClient) GetRouter(arg0 string) (*routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -367,10 +431,10 @@ func (mr *MockNetworkClientMockRecorder) GetRouter(arg0 interface{}) *gomock.Cal
 }
 
 // GetSecGroup mocks base method.
-func (m *MockNetworkClient) GetSecGroup(arg0 string) (*groups.SecGroup, error) {
+func (m *MockNetworkClient) GetSecGroup(arg0 string) (*clients.SecGroupExt, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSecGroup", arg0)
-	ret0, _ := ret[0].(*groups.SecGroup)
+	ret0, _ := ret[0].(*clients.SecGroupExt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -382,10 +446,10 @@ func (mr *MockNetworkClientMockRecorder) GetSecGroup(arg0 interface{}) *gomock.C
 }
 
 // GetSecGroupRule mocks base method.
-func (m *MockNetworkClient) GetSecGroupRule(arg0 string) (*rules.SecGroupRule, error) {
+func (m *MockNetworkClient) GetSecGroupRule(arg0 string) (*clients.SecGroupRuleExt, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSecGroupRule", arg0)
-	ret0, _ := ret[0].(*rules.SecGroupRule)
+	ret0, _ := ret[0].(*clients.SecGroupRuleExt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -411,6 +475,21 @@ func (mr *MockNetworkClientMockRecorder) GetSubnet(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnet", reflect.TypeOf((*MockNetworkClient)(nil).GetSubnet), arg0)
 }
 
+// ListAddressGroup mocks base method.
+func (m *MockNetworkClient) ListAddressGroup(arg0 clients.AddressGroupListOpts) ([]clients.AddressGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAddressGroup", arg0)
+	ret0, _ := ret[0].([]clients.AddressGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAddressGroup indicates an expected call of ListAddressGroup.
+func (mr *MockNetworkClientMockRecorder) ListAddressGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAddressGroup", reflect.TypeOf((*MockNetworkClient)(nil).ListAddressGroup), arg0)
+}
+
 // ListExtensions mocks base method.
 func (m *MockNetworkClient) ListExtensions() ([]extensions.Extension, error) {
 	m.ctrl.T.Helper()
@@ -471,6 +550,36 @@ func (mr *MockNetworkClientMockRecorder) ListPort(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPort", reflect.TypeOf((*MockNetworkClient)(nil).ListPort), arg0)
 }
 
+// ListPortForwarding mocks base method.
+func (m *MockNetworkClient) ListPortForwarding(arg0 string, arg1 portforwarding.ListOptsBuilder) ([]portforwarding.PortForwarding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPortForwarding", arg0, arg1)
+	ret0, _ := ret[0].([]portforwarding.PortForwarding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPortForwarding indicates an expected call of ListPortForwarding.
+func (mr *MockNetworkClientMockRecorder) ListPortForwarding(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortForwarding", reflect.TypeOf((*MockNetworkClient)(nil).ListPortForwarding), arg0, arg1)
+}
+
+// ListQoSPolicy mocks base method.
+func (m *MockNetworkClient) ListQoSPolicy(arg0 policies.PolicyListOptsBuilder) ([]policies.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQoSPolicy", arg0)
+	ret0, _ := ret[0].([]policies.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQoSPolicy indicates an expected call of ListQoSPolicy.
+func (mr *MockNetworkClientMockRecorder) ListQoSPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQoSPolicy", reflect.TypeOf((*MockNetworkClient)(nil).ListQoSPolicy), arg0)
+}
+
 // ListRouter mocks base method.
 func (m *MockNetworkClient) ListRouter(arg0 routers.ListOpts) ([]routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -487,10 +596,10 @@ func (mr *MockNetworkClientMockRecorder) ListRouter(arg0 interface{}) *gomock.Ca
 }
 
 // ListSecGroup mocks base method.
-func (m *MockNetworkClient) ListSecGroup(arg0 groups.ListOpts) ([]groups.SecGroup, error) {
+func (m *MockNetworkClient) ListSecGroup(arg0 groups.ListOpts) ([]clients.SecGroupExt, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListSecGroup", arg0)
-	ret0, _ := ret[0].([]groups.SecGroup)
+	ret0, _ := ret[0].([]clients.SecGroupExt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -502,10 +611,10 @@ func (mr *MockNetworkClientMockRecorder) ListSecGroup(arg0 interface{}) *gomock.
 }
 
 // ListSecGroupRule mocks base method.
-func (m *MockNetworkClient) ListSecGroupRule(arg0 rules.ListOpts) ([]rules.SecGroupRule, error) {
+func (m *MockNetworkClient) ListSecGroupRule(arg0 rules.ListOpts) ([]clients.SecGroupRuleExt, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListSecGroupRule", arg0)
-	ret0, _ := ret[0].([]rules.SecGroupRule)
+	ret0, _ := ret[0].([]clients.SecGroupRuleExt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -531,6 +640,21 @@ func (mr *MockNetworkClientMockRecorder) ListSubnet(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnet", reflect.TypeOf((*MockNetworkClient)(nil).ListSubnet), arg0)
 }
 
+// ListSubnetPool mocks base method.
+func (m *MockNetworkClient) ListSubnetPool(arg0 subnetpools.ListOptsBuilder) ([]subnetpools.SubnetPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubnetPool", arg0)
+	ret0, _ := ret[0].([]subnetpools.SubnetPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubnetPool indicates an expected call of ListSubnetPool.
+func (mr *MockNetworkClientMockRecorder) ListSubnetPool(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnetPool", reflect.TypeOf((*MockNetworkClient)(nil).ListSubnetPool), arg0)
+}
+
 // ListTrunk mocks base method.
 func (m *MockNetworkClient) ListTrunk(arg0 trunks.ListOptsBuilder) ([]trunks.Trunk, error) {
 	m.ctrl.T.Helper()
@@ -637,10 +761,10 @@ func (mr *MockNetworkClientMockRecorder) UpdateRouter(arg0, arg1 interface{}) *g
 }
 
 // UpdateSecGroup mocks base method.
-func (m *MockNetworkClient) UpdateSecGroup(arg0 string, arg1 groups.UpdateOptsBuilder) (*groups.SecGroup, error) {
+func (m *MockNetworkClient) UpdateSecGroup(arg0 string, arg1 groups.UpdateOptsBuilder) (*clients.SecGroupExt, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateSecGroup", arg0, arg1)
-	ret0, _ := ret[0].(*groups.SecGroup)
+	ret0, _ := ret[0].(*clients.SecGroupExt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package clients
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/gophercloud/gophercloud"
@@ -24,9 +25,14 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/mtu"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
@@ -36,6 +42,128 @@ import (
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
 )
 
+// NetworkExt is the base gophercloud Network with extensions used by the networking service.
+type NetworkExt struct {
+	networks.Network
+	mtu.NetworkMTUExt
+}
+
+// SecGroupExt is the base gophercloud SecGroup with extensions used by the networking service.
+type SecGroupExt struct {
+	groups.SecGroup
+
+	// Stateful is whether the security group is stateful. It is nil if the
+	// stateful-security-group extension is not available.
+	Stateful *bool `json:"stateful"`
+
+	// Rules are the rules of the security group, including their extensions.
+	Rules []SecGroupRuleExt `json:"security_group_rules"`
+}
+
+// UnmarshalJSON unmarshals the base SecGroup, which has its own unmarshaller, and its extensions.
+func (r *SecGroupExt) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &r.SecGroup); err != nil {
+		return err
+	}
+
+	var ext struct {
+		Stateful *bool             `json:"stateful"`
+		Rules    []SecGroupRuleExt `json:"security_group_rules"`
+	}
+	if err := json.Unmarshal(b, &ext); err != nil {
+		return err
+	}
+	r.Stateful = ext.Stateful
+	r.Rules = ext.Rules
+	return nil
+}
+
+// SecGroupCreateOptsExt adds the stateful attribute to the options of a security group.
+type SecGroupCreateOptsExt struct {
+	groups.CreateOptsBuilder
+
+	// Stateful is whether the security group is stateful.
+	// It requires the stateful-security-group extension.
+	Stateful *bool
+}
+
+func (opts SecGroupCreateOptsExt) ToSecGroupCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToSecGroupCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Stateful != nil {
+		base["security_group"].(map[string]interface{})["stateful"] = *opts.Stateful
+	}
+	return base, nil
+}
+
+// SecGroupUpdateOptsExt adds the stateful attribute to the update options of a security group.
+type SecGroupUpdateOptsExt struct {
+	groups.UpdateOptsBuilder
+
+	// Stateful is whether the security group is stateful.
+	// It requires the stateful-security-group extension.
+	Stateful *bool
+}
+
+func (opts SecGroupUpdateOptsExt) ToSecGroupUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToSecGroupUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Stateful != nil {
+		base["security_group"].(map[string]interface{})["stateful"] = *opts.Stateful
+	}
+	return base, nil
+}
+
+// SecGroupRuleExt is the base gophercloud SecGroupRule with extensions used by the networking service.
+type SecGroupRuleExt struct {
+	rules.SecGroupRule
+
+	// RemoteAddressGroupID is the ID of the address group matched by the rule.
+	RemoteAddressGroupID string `json:"remote_address_group_id"`
+}
+
+// SecGroupRuleCreateOptsExt adds the remote address group to the options of a security group rule.
+type SecGroupRuleCreateOptsExt struct {
+	rules.CreateOptsBuilder
+
+	// RemoteAddressGroupID is the ID of the address group matched by the rule.
+	// It requires the address-group extension.
+	RemoteAddressGroupID string
+}
+
+func (opts SecGroupRuleCreateOptsExt) ToSecGroupRuleCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToSecGroupRuleCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.RemoteAddressGroupID != "" {
+		base["security_group_rule"].(map[string]interface{})["remote_address_group_id"] = opts.RemoteAddressGroupID
+	}
+	return base, nil
+}
+
+// AddressGroup is a Neutron address group, a named set of CIDRs that can be
+// referenced by security group rules.
+type AddressGroup struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	ProjectID   string   `json:"project_id"`
+	Addresses   []string `json:"addresses"`
+}
+
+// AddressGroupListOpts filters the address groups returned by ListAddressGroup.
+type AddressGroupListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	ProjectID   string `q:"project_id"`
+}
+
 type NetworkClient interface {
 	ListFloatingIP(opts floatingips.ListOptsBuilder) ([]floatingips.FloatingIP, error)
 	CreateFloatingIP(opts floatingips.CreateOptsBuilder) (*floatingips.FloatingIP, error)
@@ -43,6 +171,10 @@ type NetworkClient interface {
 	GetFloatingIP(id string) (*floatingips.FloatingIP, error)
 	UpdateFloatingIP(id string, opts floatingips.UpdateOptsBuilder) (*floatingips.FloatingIP, error)
 
+	ListPortForwarding(floatingIPID string, opts portforwarding.ListOptsBuilder) ([]portforwarding.PortForwarding, error)
+	CreatePortForwarding(floatingIPID string, opts portforwarding.CreateOptsBuilder) (*portforwarding.PortForwarding, error)
+	DeletePortForwarding(floatingIPID string, id string) error
+
 	ListPort(opts ports.ListOptsBuilder) ([]ports.Port, error)
 	CreatePort(opts ports.CreateOptsBuilder) (*ports.Port, error)
 	DeletePort(id string) error
@@ -61,22 +193,25 @@ type NetworkClient interface {
 	AddRouterInterface(id string, opts routers.AddInterfaceOptsBuilder) (*routers.InterfaceInfo, error)
 	RemoveRouterInterface(id string, opts routers.RemoveInterfaceOptsBuilder) (*routers.InterfaceInfo, error)
 
-	ListSecGroup(opts groups.ListOpts) ([]groups.SecGroup, error)
-	CreateSecGroup(opts groups.CreateOptsBuilder) (*groups.SecGroup, error)
+	ListSecGroup(opts groups.ListOpts) ([]SecGroupExt, error)
+	CreateSecGroup(opts groups.CreateOptsBuilder) (*SecGroupExt, error)
 	DeleteSecGroup(id string) error
-	GetSecGroup(id string) (*groups.SecGroup, error)
-	UpdateSecGroup(id string, opts groups.UpdateOptsBuilder) (*groups.SecGroup, error)
+	GetSecGroup(id string) (*SecGroupExt, error)
+	UpdateSecGroup(id string, opts groups.UpdateOptsBuilder) (*SecGroupExt, error)
 
-	ListSecGroupRule(opts rules.ListOpts) ([]rules.SecGroupRule, error)
-	CreateSecGroupRule(opts rules.CreateOptsBuilder) (*rules.SecGroupRule, error)
+	ListSecGroupRule(opts rules.ListOpts) ([]SecGroupRuleExt, error)
+	CreateSecGroupRule(opts rules.CreateOptsBuilder) (*SecGroupRuleExt, error)
 	DeleteSecGroupRule(id string) error
-	GetSecGroupRule(id string) (*rules.SecGroupRule, error)
+	GetSecGroupRule(id string) (*SecGroupRuleExt, error)
+
+	ListAddressGroup(opts AddressGroupListOpts) ([]AddressGroup, error)
 
 	ListNetwork(opts networks.ListOptsBuilder) ([]networks.Network, error)
 	CreateNetwork(opts networks.CreateOptsBuilder) (*networks.Network, error)
 	DeleteNetwork(id string) error
 	GetNetwork(id string) (*networks.Network, error)
 	UpdateNetwork(id string, opts networks.UpdateOptsBuilder) (*networks.Network, error)
+	GetNetworkExt(id string) (*NetworkExt, error)
 
 	ListSubnet(opts subnets.ListOptsBuilder) ([]subnets.Subnet, error)
 	CreateSubnet(opts subnets.CreateOptsBuilder) (*subnets.Subnet, error)
@@ -84,8 +219,14 @@ type NetworkClient interface {
 	GetSubnet(id string) (*subnets.Subnet, error)
 	UpdateSubnet(id string, opts subnets.UpdateOptsBuilder) (*subnets.Subnet, error)
 
+	ListSubnetPool(opts subnetpools.ListOptsBuilder) ([]subnetpools.SubnetPool, error)
+
+	ListQoSPolicy(opts policies.PolicyListOptsBuilder) ([]policies.Policy, error)
+
 	ListExtensions() ([]extensions.Extension, error)
 
+	GetQuotaDetail(projectID string) (*quotas.QuotaDetailSet, error)
+
 	ReplaceAllAttributesTags(resourceType string, resourceID string, opts attributestags.ReplaceAllOptsBuilder) ([]string, error)
 }
 
@@ -183,6 +324,29 @@ func (c networkClient) UpdateFloatingIP(id string, opts floatingips.UpdateOptsBu
 	return fip, nil
 }
 
+func (c networkClient) ListPortForwarding(floatingIPID string, opts portforwarding.ListOptsBuilder) ([]portforwarding.PortForwarding, error) {
+	mc := metrics.NewMetricPrometheusContext("port_forwarding", "list")
+	allPages, err := portforwarding.List(c.serviceClient, opts, floatingIPID).AllPages()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return portforwarding.ExtractPortForwardings(allPages)
+}
+
+func (c networkClient) CreatePortForwarding(floatingIPID string, opts portforwarding.CreateOptsBuilder) (*portforwarding.PortForwarding, error) {
+	mc := metrics.NewMetricPrometheusContext("port_forwarding", "create")
+	pf, err := portforwarding.Create(c.serviceClient, floatingIPID, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return pf, nil
+}
+
+func (c networkClient) DeletePortForwarding(floatingIPID string, id string) error {
+	mc := metrics.NewMetricPrometheusContext("port_forwarding", "delete")
+	return mc.ObserveRequestIgnoreNotFound(portforwarding.Delete(c.serviceClient, floatingIPID, id).ExtractErr())
+}
+
 func (c networkClient) ListPort(opts ports.ListOptsBuilder) ([]ports.Port, error) {
 	mc := metrics.NewMetricPrometheusContext("port", "list")
 	allPages, err := ports.List(c.serviceClient, opts).AllPages()
@@ -279,22 +443,25 @@ func (c networkClient) UpdateRouter(id string, opts routers.UpdateOptsBuilder) (
 	return router, nil
 }
 
-func (c networkClient) ListSecGroup(opts groups.ListOpts) ([]groups.SecGroup, error) {
+func (c networkClient) ListSecGroup(opts groups.ListOpts) ([]SecGroupExt, error) {
 	mc := metrics.NewMetricPrometheusContext("group", "list")
 	allPages, err := groups.List(c.serviceClient, opts).AllPages()
 	if mc.ObserveRequest(err) != nil {
 		return nil, err
 	}
-	return groups.ExtractGroups(allPages)
+	var secGroups []SecGroupExt
+	err = allPages.(groups.SecGroupPage).ExtractIntoSlicePtr(&secGroups, "security_groups")
+	return secGroups, err
 }
 
-func (c networkClient) CreateSecGroup(opts groups.CreateOptsBuilder) (*groups.SecGroup, error) {
+func (c networkClient) CreateSecGroup(opts groups.CreateOptsBuilder) (*SecGroupExt, error) {
+	var group SecGroupExt
 	mc := metrics.NewMetricPrometheusContext("security_group", "create")
-	group, err := groups.Create(c.serviceClient, opts).Extract()
+	err := groups.Create(c.serviceClient, opts).ExtractIntoStructPtr(&group, "security_group")
 	if mc.ObserveRequest(err) != nil {
 		return nil, err
 	}
-	return group, nil
+	return &group, nil
 }
 
 func (c networkClient) DeleteSecGroup(id string) error {
@@ -302,40 +469,45 @@ func (c networkClient) DeleteSecGroup(id string) error {
 	return mc.ObserveRequestIgnoreNotFound(groups.Delete(c.serviceClient, id).ExtractErr())
 }
 
-func (c networkClient) GetSecGroup(id string) (*groups.SecGroup, error) {
+func (c networkClient) GetSecGroup(id string) (*SecGroupExt, error) {
+	var group SecGroupExt
 	mc := metrics.NewMetricPrometheusContext("security_group", "get")
-	group, err := groups.Get(c.serviceClient, id).Extract()
+	err := groups.Get(c.serviceClient, id).ExtractIntoStructPtr(&group, "security_group")
 	if mc.ObserveRequestIgnoreNotFound(err) != nil {
 		return nil, err
 	}
-	return group, nil
+	return &group, nil
 }
 
-func (c networkClient) UpdateSecGroup(id string, opts groups.UpdateOptsBuilder) (*groups.SecGroup, error) {
+func (c networkClient) UpdateSecGroup(id string, opts groups.UpdateOptsBuilder) (*SecGroupExt, error) {
+	var group SecGroupExt
 	mc := metrics.NewMetricPrometheusContext("security_group", "update")
-	group, err := groups.Update(c.serviceClient, id, opts).Extract()
+	err := groups.Update(c.serviceClient, id, opts).ExtractIntoStructPtr(&group, "security_group")
 	if mc.ObserveRequest(err) != nil {
 		return nil, err
 	}
-	return group, nil
+	return &group, nil
 }
 
-func (c networkClient) ListSecGroupRule(opts rules.ListOpts) ([]rules.SecGroupRule, error) {
+func (c networkClient) ListSecGroupRule(opts rules.ListOpts) ([]SecGroupRuleExt, error) {
 	mc := metrics.NewMetricPrometheusContext("security_group_rule", "list")
 	allPages, err := rules.List(c.serviceClient, opts).AllPages()
 	if mc.ObserveRequest(err) != nil {
 		return nil, err
 	}
-	return rules.ExtractRules(allPages)
+	var secGroupRules []SecGroupRuleExt
+	err = allPages.(rules.SecGroupRulePage).ExtractIntoSlicePtr(&secGroupRules, "security_group_rules")
+	return secGroupRules, err
 }
 
-func (c networkClient) CreateSecGroupRule(opts rules.CreateOptsBuilder) (*rules.SecGroupRule, error) {
+func (c networkClient) CreateSecGroupRule(opts rules.CreateOptsBuilder) (*SecGroupRuleExt, error) {
+	var rule SecGroupRuleExt
 	mc := metrics.NewMetricPrometheusContext("security_group_rule", "create")
-	rule, err := rules.Create(c.serviceClient, opts).Extract()
+	err := rules.Create(c.serviceClient, opts).ExtractIntoStructPtr(&rule, "security_group_rule")
 	if mc.ObserveRequest(err) != nil {
 		return nil, err
 	}
-	return rule, nil
+	return &rule, nil
 }
 
 func (c networkClient) DeleteSecGroupRule(id string) error {
@@ -343,13 +515,33 @@ func (c networkClient) DeleteSecGroupRule(id string) error {
 	return mc.ObserveRequestIgnoreNotFound(rules.Delete(c.serviceClient, id).ExtractErr())
 }
 
-func (c networkClient) GetSecGroupRule(id string) (*rules.SecGroupRule, error) {
+func (c networkClient) GetSecGroupRule(id string) (*SecGroupRuleExt, error) {
+	var rule SecGroupRuleExt
 	mc := metrics.NewMetricPrometheusContext("security_group_rule", "get")
-	rule, err := rules.Get(c.serviceClient, id).Extract()
+	err := rules.Get(c.serviceClient, id).ExtractIntoStructPtr(&rule, "security_group_rule")
 	if mc.ObserveRequestIgnoreNotFound(err) != nil {
 		return nil, err
 	}
-	return rule, nil
+	return &rule, nil
+}
+
+// ListAddressGroup lists the address groups matching opts. Address groups
+// are not supported by gophercloud, so the request is built here.
+func (c networkClient) ListAddressGroup(opts AddressGroupListOpts) ([]AddressGroup, error) {
+	query, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var body struct {
+		AddressGroups []AddressGroup `json:"address_groups"`
+	}
+	mc := metrics.NewMetricPrometheusContext("address_group", "list")
+	_, err = c.serviceClient.Get(c.serviceClient.ServiceURL("address-groups")+query.String(), &body, nil)
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return body.AddressGroups, nil
 }
 
 func (c networkClient) ListNetwork(opts networks.ListOptsBuilder) ([]networks.Network, error) {
@@ -393,6 +585,16 @@ func (c networkClient) UpdateNetwork(id string, opts networks.UpdateOptsBuilder)
 	return net, nil
 }
 
+func (c networkClient) GetNetworkExt(id string) (*NetworkExt, error) {
+	var net NetworkExt
+	mc := metrics.NewMetricPrometheusContext("network", "get")
+	err := networks.Get(c.serviceClient, id).ExtractInto(&net)
+	if mc.ObserveRequestIgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	return &net, nil
+}
+
 func (c networkClient) ListSubnet(opts subnets.ListOptsBuilder) ([]subnets.Subnet, error) {
 	mc := metrics.NewMetricPrometheusContext("subnet", "list")
 	allPages, err := subnets.List(c.serviceClient, opts).AllPages()
@@ -434,6 +636,24 @@ func (c networkClient) UpdateSubnet(id string, opts subnets.UpdateOptsBuilder) (
 	return subnet, nil
 }
 
+func (c networkClient) ListSubnetPool(opts subnetpools.ListOptsBuilder) ([]subnetpools.SubnetPool, error) {
+	mc := metrics.NewMetricPrometheusContext("subnetpool", "list")
+	allPages, err := subnetpools.List(c.serviceClient, opts).AllPages()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return subnetpools.ExtractSubnetPools(allPages)
+}
+
+func (c networkClient) ListQoSPolicy(opts policies.PolicyListOptsBuilder) ([]policies.Policy, error) {
+	mc := metrics.NewMetricPrometheusContext("qos_policy", "list")
+	allPages, err := policies.List(c.serviceClient, opts).AllPages()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return policies.ExtractPolicies(allPages)
+}
+
 func (c networkClient) ListExtensions() ([]extensions.Extension, error) {
 	mc := metrics.NewMetricPrometheusContext("network_extension", "list")
 	allPages, err := extensions.List(c.serviceClient).AllPages()
@@ -442,3 +662,12 @@ func (c networkClient) ListExtensions() ([]extensions.Extension, error) {
 	}
 	return extensions.ExtractExtensions(allPages)
 }
+
+func (c networkClient) GetQuotaDetail(projectID string) (*quotas.QuotaDetailSet, error) {
+	mc := metrics.NewMetricPrometheusContext("network_quota", "get")
+	quotaDetail, err := quotas.GetDetail(c.serviceClient, projectID).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return quotaDetail, nil
+}
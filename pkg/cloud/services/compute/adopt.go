@@ -0,0 +1,152 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/tags"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+)
+
+// OwnerMetadataKey is the server metadata key marking a server as owned by
+// cluster-api-provider-openstack. Its value is the namespaced name of the owner.
+const OwnerMetadataKey = "cluster-api-provider-openstack-owner"
+
+// AdoptInstance takes ownership of an existing server: the given tags are added to
+// the server and it is marked as owned by the given owner. It returns an error if
+// the server does not exist or is already owned by another object.
+func (s *Service) AdoptInstance(eventObject runtime.Object, instanceID, owner string, instanceTags []string) (*InstanceStatus, error) {
+	instanceStatus, err := s.GetInstanceStatus(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if instanceStatus == nil {
+		return nil, fmt.Errorf("server %s does not exist", instanceID)
+	}
+	server := instanceStatus.server
+
+	if currentOwner, ok := server.Metadata[OwnerMetadataKey]; ok && currentOwner != owner {
+		return nil, fmt.Errorf("server %s is already owned by %s", instanceID, currentOwner)
+	}
+
+	var serverTags []string
+	if server.Tags != nil {
+		serverTags = *server.Tags
+	}
+	if mergedTags, changed := mergeTags(serverTags, instanceTags); changed {
+		serverTags, err = s.getComputeClient().ReplaceAllServerTags(server.ID, tags.ReplaceAllOpts{Tags: mergedTags})
+		if err != nil {
+			record.Warnf(eventObject, "FailedAdoptServer", "Failed to tag server %s with id %s: %v", server.Name, server.ID, err)
+			return nil, fmt.Errorf("error tagging server %s: %v", server.ID, err)
+		}
+		server.Tags = &serverTags
+	}
+
+	if _, ok := server.Metadata[OwnerMetadataKey]; !ok {
+		metadata, err := s.getComputeClient().UpdateServerMetadata(server.ID, servers.MetadataOpts{OwnerMetadataKey: owner})
+		if err != nil {
+			record.Warnf(eventObject, "FailedAdoptServer", "Failed to set owner of server %s with id %s: %v", server.Name, server.ID, err)
+			return nil, fmt.Errorf("error setting owner of server %s: %v", server.ID, err)
+		}
+		server.Metadata = make(map[string]string, len(metadata))
+		for k, v := range metadata {
+			server.Metadata[k] = v
+		}
+	}
+
+	record.Eventf(eventObject, "SuccessfulAdoptServer", "Adopted server %s with id %s", server.Name, server.ID)
+	return instanceStatus, nil
+}
+
+// GetInstanceSpecDrift returns a description of each field of the instance spec
+// which differs from the given server. Fields which cannot be changed without
+// recreating the server are compared.
+func GetInstanceSpecDrift(instanceStatus *InstanceStatus, instanceSpec *InstanceSpec) []string {
+	server := instanceStatus.server
+	var drift []string
+
+	if flavor, _ := server.Flavor["original_name"].(string); flavor != instanceSpec.Flavor {
+		drift = append(drift, fmt.Sprintf("flavor: spec %q, server %q", instanceSpec.Flavor, flavor))
+	}
+
+	// A server booted from volume has no image
+	if !hasRootVolume(instanceSpec) && instanceSpec.ImageID != "" {
+		if imageID, _ := server.Image["id"].(string); imageID != instanceSpec.ImageID {
+			drift = append(drift, fmt.Sprintf("image: spec %q, server %q", instanceSpec.ImageID, imageID))
+		}
+	}
+
+	if server.KeyName != instanceSpec.SSHKeyName {
+		drift = append(drift, fmt.Sprintf("sshKeyName: spec %q, server %q", instanceSpec.SSHKeyName, server.KeyName))
+	}
+
+	if instanceSpec.FailureDomain != "" && server.AvailabilityZone != instanceSpec.FailureDomain {
+		drift = append(drift, fmt.Sprintf("failureDomain: spec %q, server %q", instanceSpec.FailureDomain, server.AvailabilityZone))
+	}
+
+	keys := make([]string, 0, len(instanceSpec.Metadata))
+	for key := range instanceSpec.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if value, ok := server.Metadata[key]; !ok || value != instanceSpec.Metadata[key] {
+			drift = append(drift, fmt.Sprintf("serverMetadata[%s]: spec %q, server %q", key, instanceSpec.Metadata[key], value))
+		}
+	}
+
+	return drift
+}
+
+// AttachedVolumes returns the status of the volumes attached to the server.
+func (is *InstanceStatus) AttachedVolumes() []infrav1.VolumeStatus {
+	if len(is.server.AttachedVolumes) == 0 {
+		return nil
+	}
+
+	volumes := make([]infrav1.VolumeStatus, len(is.server.AttachedVolumes))
+	for i := range is.server.AttachedVolumes {
+		volumes[i] = infrav1.VolumeStatus{ID: is.server.AttachedVolumes[i].ID}
+	}
+	return volumes
+}
+
+// mergeTags returns the union of the current and desired tags, and whether it
+// differs from the current tags.
+func mergeTags(current, desired []string) ([]string, bool) {
+	merged := append([]string{}, current...)
+	seen := make(map[string]struct{}, len(current))
+	for _, tag := range current {
+		seen[tag] = struct{}{}
+	}
+
+	changed := false
+	for _, tag := range desired {
+		if _, ok := seen[tag]; !ok {
+			seen[tag] = struct{}{}
+			merged = append(merged, tag)
+			changed = true
+		}
+	}
+	return merged, changed
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+func TestGetInstanceSpecDrift(t *testing.T) {
+	server := func() *clients.ServerExt {
+		s := &clients.ServerExt{}
+		s.ID = "server-id"
+		s.Flavor = map[string]interface{}{"original_name": "m1.small"}
+		s.Image = map[string]interface{}{"id": "image-id"}
+		s.KeyName = "key"
+		s.AvailabilityZone = "az1"
+		s.Metadata = map[string]string{"foo": "bar"}
+		return s
+	}
+	spec := func() *InstanceSpec {
+		return &InstanceSpec{
+			Flavor:        "m1.small",
+			ImageID:       "image-id",
+			SSHKeyName:    "key",
+			FailureDomain: "az1",
+			Metadata:      map[string]string{"foo": "bar"},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		server func() *clients.ServerExt
+		spec   func() *InstanceSpec
+		want   []string
+	}{
+		{
+			name:   "No drift",
+			server: server,
+			spec:   spec,
+		},
+		{
+			name: "Different flavor, key and metadata",
+			server: func() *clients.ServerExt {
+				s := server()
+				s.Flavor["original_name"] = "m1.large"
+				s.KeyName = "other"
+				s.Metadata = map[string]string{}
+				return s
+			},
+			spec: spec,
+			want: []string{
+				`flavor: spec "m1.small", server "m1.large"`,
+				`sshKeyName: spec "key", server "other"`,
+				`serverMetadata[foo]: spec "bar", server ""`,
+			},
+		},
+		{
+			name: "Different image and failure domain",
+			server: func() *clients.ServerExt {
+				s := server()
+				s.Image = map[string]interface{}{"id": "other-image"}
+				s.AvailabilityZone = "az2"
+				return s
+			},
+			spec: spec,
+			want: []string{
+				`image: spec "image-id", server "other-image"`,
+				`failureDomain: spec "az1", server "az2"`,
+			},
+		},
+		{
+			name: "Image is ignored for a server booted from volume",
+			server: func() *clients.ServerExt {
+				s := server()
+				s.Image = nil
+				return s
+			},
+			spec: func() *InstanceSpec {
+				s := spec()
+				s.RootVolume = &infrav1.RootVolume{Size: 50}
+				return s
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			is := &InstanceStatus{
+				server: tt.server(),
+				logger: testr.New(t),
+			}
+			g.Expect(GetInstanceSpecDrift(is, tt.spec())).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestInstanceStatusAttachedVolumes(t *testing.T) {
+	g := NewWithT(t)
+
+	s := &clients.ServerExt{}
+	s.AttachedVolumes = []servers.AttachedVolume{{ID: "volume-1"}, {ID: "volume-2"}}
+	is := &InstanceStatus{server: s, logger: testr.New(t)}
+
+	g.Expect(is.AttachedVolumes()).To(Equal([]infrav1.VolumeStatus{{ID: "volume-1"}, {ID: "volume-2"}}))
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+const (
+	// ResourceGPU is the resource name used to report virtual GPUs in the capacity of a machine.
+	ResourceGPU corev1.ResourceName = "nvidia.com/gpu"
+
+	// extraSpecPCIPassthroughAlias is the flavor extra spec requesting PCI
+	// passthrough devices, e.g. "a1:2,a2:1".
+	extraSpecPCIPassthroughAlias = "pci_passthrough:alias"
+	// extraSpecVGPU is the flavor extra spec requesting virtual GPUs.
+	extraSpecVGPU = "resources:VGPU"
+)
+
+// GetMachineCapacity returns the resource capacity of a server created from the given machine spec.
+// CPU, memory and ephemeral storage are taken from the flavor, unless the machine boots from a root
+// volume, in which case ephemeral storage is the size of the root volume. Virtual GPUs requested in
+// the flavor extra specs are reported as ResourceGPU. PCI passthrough devices are reported only for
+// aliases which have an entry in aliasResources, under the resource name it maps them to.
+func (s *Service) GetMachineCapacity(spec *infrav1.OpenStackMachineSpec, aliasResources map[string]corev1.ResourceName) (corev1.ResourceList, error) {
+	flavor, err := s.getAndValidateFlavor(spec.Flavor)
+	if err != nil {
+		return nil, err
+	}
+
+	extraSpecs, err := s.getComputeClient().ListFlavorExtraSpecs(flavor.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting extra specs of flavor %s: %v", flavor.Name, err)
+	}
+
+	diskGiB := flavor.Disk
+	if spec.RootVolume != nil && spec.RootVolume.Size > 0 {
+		diskGiB = spec.RootVolume.Size
+	}
+
+	capacity := corev1.ResourceList{
+		corev1.ResourceCPU:              *resource.NewQuantity(int64(flavor.VCPUs), resource.DecimalSI),
+		corev1.ResourceMemory:           *resource.NewQuantity(int64(flavor.RAM)*1024*1024, resource.BinarySI),
+		corev1.ResourceEphemeralStorage: *resource.NewQuantity(int64(diskGiB)*1024*1024*1024, resource.BinarySI),
+	}
+
+	devices, err := getFlavorDevices(extraSpecs, aliasResources)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing extra specs of flavor %s: %v", flavor.Name, err)
+	}
+	for name, count := range devices {
+		if count > 0 {
+			capacity[name] = *resource.NewQuantity(count, resource.DecimalSI)
+		}
+	}
+
+	return capacity, nil
+}
+
+// getFlavorDevices returns the number of devices of each resource requested
+// by the given flavor extra specs. PCI passthrough aliases which are not in
+// aliasResources are ignored.
+func getFlavorDevices(extraSpecs map[string]string, aliasResources map[string]corev1.ResourceName) (map[corev1.ResourceName]int64, error) {
+	devices := make(map[corev1.ResourceName]int64)
+
+	if vgpus, ok := extraSpecs[extraSpecVGPU]; ok {
+		n, err := strconv.ParseInt(strings.TrimSpace(vgpus), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", extraSpecVGPU, vgpus, err)
+		}
+		devices[ResourceGPU] += n
+	}
+
+	if aliases, ok := extraSpecs[extraSpecPCIPassthroughAlias]; ok {
+		for _, alias := range strings.Split(aliases, ",") {
+			alias = strings.TrimSpace(alias)
+			if alias == "" {
+				continue
+			}
+
+			// The count is optional and defaults to 1
+			name, count, found := strings.Cut(alias, ":")
+			n := int64(1)
+			if found {
+				var err error
+				n, err = strconv.ParseInt(strings.TrimSpace(count), 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s %q: %w", extraSpecPCIPassthroughAlias, aliases, err)
+				}
+			}
+
+			if resourceName, ok := aliasResources[strings.TrimSpace(name)]; ok {
+				devices[resourceName] += n
+			}
+		}
+	}
+
+	return devices, nil
+}
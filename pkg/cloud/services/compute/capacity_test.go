@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func TestService_GetMachineCapacity(t *testing.T) {
+	const flavorID = "d6a8c8b2-3e1b-4c3a-9e5d-2f4f1c3c9a01"
+
+	flavor := &flavors.Flavor{ID: flavorID, Name: "m1.gpu", VCPUs: 4, RAM: 8192, Disk: 20}
+
+	aliasResources := map[string]corev1.ResourceName{
+		"a100":  "nvidia.com/gpu",
+		"mi210": "amd.com/gpu",
+	}
+
+	tests := []struct {
+		testName string
+		spec     *infrav1.OpenStackMachineSpec
+		expect   func(m *mock.MockComputeClientMockRecorder)
+		want     corev1.ResourceList
+		wantErr  bool
+	}{
+		{
+			testName: "Capacity from flavor",
+			spec:     &infrav1.OpenStackMachineSpec{Flavor: "m1.gpu"},
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.GetFlavorFromName("m1.gpu").Return(flavor, nil)
+				m.ListFlavorExtraSpecs(flavorID).Return(map[string]string{}, nil)
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("4"),
+				corev1.ResourceMemory:           resource.MustParse("8Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("20Gi"),
+			},
+		},
+		{
+			testName: "Ephemeral storage from root volume",
+			spec:     &infrav1.OpenStackMachineSpec{Flavor: "m1.gpu", RootVolume: &infrav1.RootVolume{Size: 50}},
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.GetFlavorFromName("m1.gpu").Return(flavor, nil)
+				m.ListFlavorExtraSpecs(flavorID).Return(map[string]string{}, nil)
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("4"),
+				corev1.ResourceMemory:           resource.MustParse("8Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("50Gi"),
+			},
+		},
+		{
+			testName: "GPUs from extra specs",
+			spec:     &infrav1.OpenStackMachineSpec{Flavor: "m1.gpu"},
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.GetFlavorFromName("m1.gpu").Return(flavor, nil)
+				m.ListFlavorExtraSpecs(flavorID).Return(map[string]string{
+					"resources:VGPU":        "1",
+					"pci_passthrough:alias": "a100:2, mi210",
+				}, nil)
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("4"),
+				corev1.ResourceMemory:           resource.MustParse("8Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("20Gi"),
+				ResourceGPU:                     resource.MustParse("3"),
+				"amd.com/gpu":                   resource.MustParse("1"),
+			},
+		},
+		{
+			testName: "Unmapped PCI passthrough aliases are not reported",
+			spec:     &infrav1.OpenStackMachineSpec{Flavor: "m1.gpu"},
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.GetFlavorFromName("m1.gpu").Return(flavor, nil)
+				m.ListFlavorExtraSpecs(flavorID).Return(map[string]string{
+					"pci_passthrough:alias": "nic:2",
+				}, nil)
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("4"),
+				corev1.ResourceMemory:           resource.MustParse("8Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("20Gi"),
+			},
+		},
+		{
+			testName: "Invalid PCI passthrough alias",
+			spec:     &infrav1.OpenStackMachineSpec{Flavor: "m1.gpu"},
+			expect: func(m *mock.MockComputeClientMockRecorder) {
+				m.GetFlavorFromName("m1.gpu").Return(flavor, nil)
+				m.ListFlavorExtraSpecs(flavorID).Return(map[string]string{
+					"pci_passthrough:alias": "a100:two",
+				}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+			g.Expect(err).NotTo(HaveOccurred())
+			tt.expect(mockScopeFactory.ComputeClient.EXPECT())
+
+			got, err := s.GetMachineCapacity(tt.spec, aliasResources)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(HaveLen(len(tt.want)))
+			for name, want := range tt.want {
+				g.Expect(got).To(HaveKey(name))
+				quantity := got[name]
+				g.Expect(quantity.Cmp(want)).To(Equal(0), "%s: got %s, want %s", name, quantity.String(), want.String())
+			}
+		})
+	}
+}
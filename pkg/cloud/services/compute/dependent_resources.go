@@ -22,7 +22,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
 )
 
-func ResolveDependentMachineResources(scope *scope.WithLogger, openStackMachine *infrav1.OpenStackMachine) (changed bool, err error) {
+func ResolveDependentMachineResources(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine) (changed bool, err error) {
 	changed = false
 
 	networkingService, err := networking.NewService(scope)
@@ -30,7 +30,7 @@ func ResolveDependentMachineResources(scope *scope.WithLogger, openStackMachine
 		return changed, err
 	}
 
-	return networkingService.AdoptMachinePorts(scope, openStackMachine, openStackMachine.Status.ReferencedResources.Ports)
+	return networkingService.AdoptMachinePorts(scope, openStackCluster, openStackMachine, openStackMachine.Status.ReferencedResources.Ports)
 }
 
 func ResolveDependentBastionResources(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, bastionName string) (changed bool, err error) {
@@ -104,7 +104,7 @@ func Test_ResolveDependentMachineResources(t *testing.T) {
 				Status: tt.openStackMachineStatus,
 			}
 
-			_, err := ResolveDependentMachineResources(scope.NewWithLogger(mockScopeFactory, log), defaultOpenStackMachine)
+			_, err := ResolveDependentMachineResources(scope.NewWithLogger(mockScopeFactory, log), &infrav1.OpenStackCluster{}, defaultOpenStackMachine)
 			if tt.wantErr {
 				g.Expect(err).Error()
 				return
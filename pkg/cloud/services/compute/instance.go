@@ -18,8 +18,10 @@ package compute
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"time"
 
@@ -30,6 +32,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -39,12 +42,25 @@ import (
 	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/hash"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
 
 const (
 	retryIntervalInstanceStatus = 10 * time.Second
 	timeoutInstanceCreate       = 5
 	timeoutInstanceDelete       = 5 * time.Minute
+	// timeoutExistingVolumeDetach is how long after the deletion of the machine the server waits for its existing
+	// volumes to be detached. After it, the server is deleted anyway, which also detaches them.
+	timeoutExistingVolumeDetach = 5 * time.Minute
+)
+
+var (
+	// ErrExistingVolumeNotAvailable is returned when an existing volume cannot be attached to a new server yet,
+	// e.g. because it is still attached to the server of the machine being replaced.
+	ErrExistingVolumeNotAvailable = errors.New("existing volume is not available")
+	// ErrExistingVolumeDetaching is returned when the server cannot be deleted yet because its existing volumes
+	// are being detached.
+	ErrExistingVolumeDetaching = errors.New("existing volume is being detached")
 )
 
 func (s *Service) CreateInstance(eventObject runtime.Object, instanceSpec *InstanceSpec, portIDs []string) (*InstanceStatus, error) {
@@ -125,8 +141,13 @@ func (s *Service) createInstanceImpl(eventObject runtime.Object, instanceSpec *I
 	return &InstanceStatus{server, s.scope.Logger()}, nil
 }
 
-func volumeName(instanceName string, nameSuffix string) string {
-	return fmt.Sprintf("%s-%s", instanceName, nameSuffix)
+// volumeName returns the name of the volume of the instance with the given
+// name suffix, rendering the volume naming template of the instance spec if set.
+func volumeName(instanceSpec *InstanceSpec, nameSuffix string) (string, error) {
+	data := instanceSpec.NameData
+	data.MachineName = instanceSpec.Name
+	data.Role = nameSuffix
+	return names.GetResourceName(instanceSpec.VolumeNameTemplate, data, fmt.Sprintf("%s-%s", instanceSpec.Name, nameSuffix))
 }
 
 func hasRootVolume(instanceSpec *InstanceSpec) bool {
@@ -214,8 +235,13 @@ func (s *Service) getOrCreateVolumeBuilder(eventObject runtime.Object, instanceS
 		volumeType = blockDevice.Storage.Volume.Type
 	}
 
+	name, err := volumeName(instanceSpec, blockDevice.Name)
+	if err != nil {
+		return nil, err
+	}
+
 	createOpts := volumes.CreateOpts{
-		Name:             volumeName(instanceSpec.Name, blockDevice.Name),
+		Name:             name,
 		Description:      description,
 		Size:             blockDevice.SizeGiB,
 		ImageID:          imageID,
@@ -227,6 +253,76 @@ func (s *Service) getOrCreateVolumeBuilder(eventObject runtime.Object, instanceS
 	return s.getOrCreateVolume(eventObject, createOpts)
 }
 
+// existingVolumeName renders the name template of an existing volume for the instance. The machine name is not
+// available to the template, as it changes when the machine is replaced, but the failure domain is.
+func existingVolumeName(instanceSpec *InstanceSpec, blockDeviceName string, nameTemplate string) (string, error) {
+	data := instanceSpec.NameData
+	data.Role = blockDeviceName
+	data.FailureDomain = instanceSpec.FailureDomain
+	return names.GetResourceName(&nameTemplate, data, "")
+}
+
+// findExistingVolume returns the existing volume referenced by the block device, or nil if it does not exist.
+func (s *Service) findExistingVolume(instanceSpec *InstanceSpec, blockDevice *infrav1.AdditionalBlockDevice) (*volumes.Volume, error) {
+	existingVolume := blockDevice.Storage.ExistingVolume
+	if existingVolume == nil {
+		return nil, fmt.Errorf("block device %s of type %s does not reference an existing volume", blockDevice.Name, blockDevice.Storage.Type)
+	}
+
+	if existingVolume.Filter != nil && existingVolume.Filter.ID != "" {
+		volume, err := s.getVolumeClient().GetVolume(existingVolume.Filter.ID)
+		if err != nil {
+			if capoerrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("error getting volume %s: %w", existingVolume.Filter.ID, err)
+		}
+		if existingVolume.Filter.Name != "" && volume.Name != existingVolume.Filter.Name {
+			return nil, fmt.Errorf("volume %s is named %s, expected %s", volume.ID, volume.Name, existingVolume.Filter.Name)
+		}
+		return volume, nil
+	}
+
+	var name string
+	if existingVolume.Filter != nil {
+		name = existingVolume.Filter.Name
+	} else if existingVolume.NameTemplate != nil {
+		var err error
+		name, err = existingVolumeName(instanceSpec, blockDevice.Name, *existingVolume.NameTemplate)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, fmt.Errorf("block device %s does not specify a filter or a name template for its existing volume", blockDevice.Name)
+	}
+	return s.getVolumeByName(name)
+}
+
+// getExistingVolume returns the existing volume referenced by the block device. It returns an error if the volume
+// does not exist or is smaller than the block device, and ErrExistingVolumeNotAvailable if the volume cannot be
+// attached yet.
+func (s *Service) getExistingVolume(eventObject runtime.Object, instanceSpec *InstanceSpec, blockDevice *infrav1.AdditionalBlockDevice) (*volumes.Volume, error) {
+	volume, err := s.findExistingVolume(instanceSpec, blockDevice)
+	if err != nil {
+		return nil, err
+	}
+	if volume == nil {
+		record.Warnf(eventObject, "FailedGetVolume", "Existing volume for block device %s was not found", blockDevice.Name)
+		return nil, fmt.Errorf("existing volume for block device %s was not found", blockDevice.Name)
+	}
+	if volume.Size < blockDevice.SizeGiB {
+		return nil, fmt.Errorf("expected existing volume %s to have a size of at least %d; found size %d", volume.ID, blockDevice.SizeGiB, volume.Size)
+	}
+	// A server created with a volume which cannot be attached goes into ERROR state, so wait for the volume instead
+	if volume.Status != "available" && (!volume.Multiattach || volume.Status != "in-use") {
+		record.Eventf(eventObject, "WaitingForVolume", "Waiting for existing volume %s with id %s to become available; status=%s", volume.Name, volume.ID, volume.Status)
+		return nil, fmt.Errorf("%w: volume %s has status %s", ErrExistingVolumeNotAvailable, volume.ID, volume.Status)
+	}
+
+	s.scope.Logger().V(3).Info("Using existing volume", "name", volume.Name, "id", volume.ID)
+	return volume, nil
+}
+
 // getBlockDevices returns a list of block devices that were created and attached to the instance. It returns an error
 // if the root volume or any of the additional block devices could not be created.
 func (s *Service) getBlockDevices(eventObject runtime.Object, instanceSpec *InstanceSpec, imageID string, timeout time.Duration, retryInterval time.Duration) ([]bootfromvolume.BlockDevice, error) {
@@ -270,6 +366,7 @@ func (s *Service) getBlockDevices(eventObject runtime.Object, instanceSpec *Inst
 		var localDiskSizeGiB int
 		var sourceType bootfromvolume.SourceType
 		var destinationType bootfromvolume.DestinationType
+		deleteOnTermination := true
 
 		// There is also a validation in the openstackmachine webhook.
 		if blockDeviceSpec.Name == "root" {
@@ -284,6 +381,16 @@ func (s *Service) getBlockDevices(eventObject runtime.Object, instanceSpec *Inst
 			bdUUID = blockDevice.ID
 			sourceType = bootfromvolume.SourceVolume
 			destinationType = bootfromvolume.DestinationVolume
+		} else if blockDeviceSpec.Storage.Type == infrav1.ExistingVolumeBlockDevice {
+			existingVolume, err := s.getExistingVolume(eventObject, instanceSpec, &blockDeviceSpec)
+			if err != nil {
+				return []bootfromvolume.BlockDevice{}, err
+			}
+			bdUUID = existingVolume.ID
+			sourceType = bootfromvolume.SourceVolume
+			destinationType = bootfromvolume.DestinationVolume
+			// Existing volumes outlive the server, so that they can be attached to the next one
+			deleteOnTermination = false
 		} else if blockDeviceSpec.Storage.Type == infrav1.LocalBlockDevice {
 			sourceType = bootfromvolume.SourceBlank
 			destinationType = bootfromvolume.DestinationLocal
@@ -297,16 +404,16 @@ func (s *Service) getBlockDevices(eventObject runtime.Object, instanceSpec *Inst
 			DestinationType:     destinationType,
 			UUID:                bdUUID,
 			BootIndex:           -1,
-			DeleteOnTermination: true,
+			DeleteOnTermination: deleteOnTermination,
 			VolumeSize:          localDiskSizeGiB,
 			Tag:                 blockDeviceSpec.Name,
 		})
 	}
 
-	// Wait for any volumes in the block devices to become available
+	// Wait for any volumes created for the block devices to become available. Existing volumes were checked above.
 	if len(blockDevices) > 0 {
 		for _, bd := range blockDevices {
-			if bd.SourceType == bootfromvolume.SourceVolume {
+			if bd.SourceType == bootfromvolume.SourceVolume && bd.DeleteOnTermination {
 				if err := s.waitForVolume(bd.UUID, timeout, retryInterval); err != nil {
 					return []bootfromvolume.BlockDevice{}, fmt.Errorf("volume %s did not become available: %w", bd.UUID, err)
 				}
@@ -396,29 +503,89 @@ func (s *Service) DeleteInstance(eventObject runtime.Object, instanceStatus *Ins
 
 			Note that we don't need to separately delete the volumes when deleting the instance because
 			DeleteOnTermination will ensure it is deleted in that case.
+
+			Existing volumes are never deleted.
 		*/
 		return s.deleteVolumes(instanceSpec)
 	}
 
+	if err := s.detachExistingVolumes(eventObject, instanceStatus, instanceSpec); err != nil {
+		if !existingVolumeDetachTimedOut(eventObject) {
+			return err
+		}
+		record.Warnf(eventObject, "FailedDetachVolume", "Timed out detaching existing volumes from server %s, deleting it anyway: %v", instanceStatus.ID(), err)
+	}
+
 	return s.deleteInstance(eventObject, instanceStatus.InstanceIdentifier())
 }
 
+// detachExistingVolumes detaches the existing volumes of the instance, so that they can be attached to the server
+// replacing it. It returns ErrExistingVolumeDetaching until all of them are detached. DeleteInstance stops waiting
+// for it after timeoutExistingVolumeDetach.
+func (s *Service) detachExistingVolumes(eventObject runtime.Object, instanceStatus *InstanceStatus, instanceSpec *InstanceSpec) error {
+	detaching := false
+	for i := range instanceSpec.AdditionalBlockDevices {
+		blockDevice := &instanceSpec.AdditionalBlockDevices[i]
+		if blockDevice.Storage.Type != infrav1.ExistingVolumeBlockDevice {
+			continue
+		}
+
+		volume, err := s.findExistingVolume(instanceSpec, blockDevice)
+		if err != nil {
+			return err
+		}
+		if volume == nil || !slices.ContainsFunc(volume.Attachments, func(a volumes.Attachment) bool { return a.ServerID == instanceStatus.ID() }) {
+			continue
+		}
+
+		detaching = true
+		if volume.Status == "detaching" {
+			continue
+		}
+		if err := s.getComputeClient().DeleteVolumeAttachment(instanceStatus.ID(), volume.ID); err != nil {
+			record.Warnf(eventObject, "FailedDetachVolume", "Failed to detach volume %s with id %s from server %s: %v", volume.Name, volume.ID, instanceStatus.ID(), err)
+			return err
+		}
+		record.Eventf(eventObject, "DetachingVolume", "Detaching volume %s with id %s from server %s", volume.Name, volume.ID, instanceStatus.ID())
+	}
+	if detaching {
+		return ErrExistingVolumeDetaching
+	}
+	return nil
+}
+
+// existingVolumeDetachTimedOut returns true if the object has been deleted for longer than timeoutExistingVolumeDetach.
+func existingVolumeDetachTimedOut(eventObject runtime.Object) bool {
+	obj, err := meta.Accessor(eventObject)
+	if err != nil {
+		return false
+	}
+	deletionTimestamp := obj.GetDeletionTimestamp()
+	return deletionTimestamp != nil && time.Since(deletionTimestamp.Time) > timeoutExistingVolumeDetach
+}
+
 func (s *Service) deleteVolumes(instanceSpec *InstanceSpec) error {
 	if hasRootVolume(instanceSpec) {
-		if err := s.deleteVolume(instanceSpec.Name, "root"); err != nil {
+		if err := s.deleteVolume(instanceSpec, "root"); err != nil {
 			return err
 		}
 	}
 	for _, volumeSpec := range instanceSpec.AdditionalBlockDevices {
-		if err := s.deleteVolume(instanceSpec.Name, volumeSpec.Name); err != nil {
+		if volumeSpec.Storage.Type == infrav1.ExistingVolumeBlockDevice {
+			continue
+		}
+		if err := s.deleteVolume(instanceSpec, volumeSpec.Name); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (s *Service) deleteVolume(instanceName string, nameSuffix string) error {
-	volumeName := volumeName(instanceName, nameSuffix)
+func (s *Service) deleteVolume(instanceSpec *InstanceSpec, nameSuffix string) error {
+	volumeName, err := volumeName(instanceSpec, nameSuffix)
+	if err != nil {
+		return err
+	}
 	volume, err := s.getVolumeByName(volumeName)
 	if err != nil {
 		return err
@@ -461,6 +628,39 @@ func (s *Service) deleteInstance(eventObject runtime.Object, instance *InstanceI
 	return nil
 }
 
+// RebootInstance soft or hard reboots the server.
+func (s *Service) RebootInstance(eventObject runtime.Object, instanceStatus *InstanceStatus, hard bool) error {
+	method := servers.SoftReboot
+	if hard {
+		method = servers.HardReboot
+	}
+
+	if err := s.getComputeClient().RebootServer(instanceStatus.ID(), servers.RebootOpts{Type: method}); err != nil {
+		record.Warnf(eventObject, "FailedRebootServer", "Failed to %s reboot server %s with id %s: %v", method, instanceStatus.Name(), instanceStatus.ID(), err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulRebootServer", "Requested %s reboot of server %s with id %s", method, instanceStatus.Name(), instanceStatus.ID())
+	return nil
+}
+
+// RebuildInstance rebuilds the server from the image it was booted from.
+// A server booted from volume can not be rebuilt.
+func (s *Service) RebuildInstance(eventObject runtime.Object, instanceStatus *InstanceStatus) error {
+	imageID := instanceStatus.ImageID()
+	if imageID == "" {
+		return fmt.Errorf("server %s was booted from volume and can not be rebuilt", instanceStatus.ID())
+	}
+
+	if _, err := s.getComputeClient().RebuildServer(instanceStatus.ID(), servers.RebuildOpts{ImageRef: imageID}); err != nil {
+		record.Warnf(eventObject, "FailedRebuildServer", "Failed to rebuild server %s with id %s: %v", instanceStatus.Name(), instanceStatus.ID(), err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulRebuildServer", "Requested rebuild of server %s with id %s from image %s", instanceStatus.Name(), instanceStatus.ID(), imageID)
+	return nil
+}
+
 func (s *Service) GetInstanceStatus(resourceID string) (instance *InstanceStatus, err error) {
 	if resourceID == "" {
 		return nil, fmt.Errorf("resourceId should be specified to get detail")
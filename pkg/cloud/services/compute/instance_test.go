@@ -31,6 +31,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
 
@@ -156,6 +157,7 @@ const (
 	serverGroupUUID                 = "7b940d62-68ef-4e42-a76a-1a62e290509c"
 	rootVolumeUUID                  = "d84fe775-e25d-4f80-9888-f701e996c689"
 	additionalBlockDeviceVolumeUUID = "1d1d5a56-c433-41dd-8446-cba2077e96e9"
+	existingVolumeUUID              = "5b3e4d4c-6a35-4b8e-8d4a-0bb9a8b3a7f2"
 
 	openStackMachineName = "test-openstack-machine"
 	portName             = "test-openstack-machine-0"
@@ -643,6 +645,118 @@ func TestService_ReconcileInstance(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Existing volume block device success",
+			getInstanceSpec: func() *InstanceSpec {
+				s := getDefaultInstanceSpec()
+				s.AdditionalBlockDevices = []infrav1.AdditionalBlockDevice{
+					{
+						Name:    "etcd",
+						SizeGiB: 50,
+						Storage: infrav1.BlockDeviceStorage{
+							Type: "ExistingVolume",
+							ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+								NameTemplate: pointer.String("persistent-{{ .Role }}-{{ .FailureDomain }}"),
+							},
+						},
+					},
+				}
+				return s
+			},
+			expect: func(r *recorders) {
+				expectDefaultFlavor(r.compute)
+
+				volumeName := fmt.Sprintf("persistent-etcd-%s", failureDomain)
+				r.volume.ListVolumes(volumes.ListOpts{Name: volumeName}).
+					Return([]volumes.Volume{{
+						ID:     existingVolumeUUID,
+						Name:   volumeName,
+						Size:   100,
+						Status: "available",
+					}}, nil)
+
+				createMap := getDefaultServerMap()
+				serverMap := createMap["server"].(map[string]interface{})
+				serverMap["block_device_mapping_v2"] = []map[string]interface{}{
+					{
+						"source_type":           "image",
+						"uuid":                  imageUUID,
+						"boot_index":            float64(0),
+						"delete_on_termination": true,
+						"destination_type":      "local",
+					},
+					{
+						"source_type":           "volume",
+						"uuid":                  existingVolumeUUID,
+						"boot_index":            float64(-1),
+						"delete_on_termination": false,
+						"destination_type":      "volume",
+						"tag":                   "etcd",
+					},
+				}
+				expectCreateServer(r.compute, createMap, false)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Existing volume block device error when the volume is too small",
+			getInstanceSpec: func() *InstanceSpec {
+				s := getDefaultInstanceSpec()
+				s.AdditionalBlockDevices = []infrav1.AdditionalBlockDevice{
+					{
+						Name:    "etcd",
+						SizeGiB: 50,
+						Storage: infrav1.BlockDeviceStorage{
+							Type: "ExistingVolume",
+							ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+								Filter: &infrav1.VolumeFilter{ID: existingVolumeUUID},
+							},
+						},
+					},
+				}
+				return s
+			},
+			expect: func(r *recorders) {
+				expectDefaultFlavor(r.compute)
+
+				r.volume.GetVolume(existingVolumeUUID).Return(&volumes.Volume{
+					ID:     existingVolumeUUID,
+					Size:   10,
+					Status: "available",
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Existing volume block device error when the volume is still in use",
+			getInstanceSpec: func() *InstanceSpec {
+				s := getDefaultInstanceSpec()
+				s.AdditionalBlockDevices = []infrav1.AdditionalBlockDevice{
+					{
+						Name:    "etcd",
+						SizeGiB: 50,
+						Storage: infrav1.BlockDeviceStorage{
+							Type: "ExistingVolume",
+							ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+								Filter: &infrav1.VolumeFilter{ID: existingVolumeUUID},
+							},
+						},
+					},
+				}
+				return s
+			},
+			expect: func(r *recorders) {
+				expectDefaultFlavor(r.compute)
+
+				r.volume.GetVolume(existingVolumeUUID).Return(&volumes.Volume{
+					ID:          existingVolumeUUID,
+					Size:        100,
+					Status:      "in-use",
+					Attachments: []volumes.Attachment{{ServerID: "other-server"}},
+				}, nil)
+			},
+			wantErr: true,
+		},
 		{
 			name: "Additional block device error when using wrong type",
 			getInstanceSpec: func() *InstanceSpec {
@@ -716,12 +830,13 @@ func TestService_DeleteInstance(t *testing.T) {
 	}
 
 	tests := []struct {
-		name           string
-		eventObject    runtime.Object
-		instanceStatus func() *InstanceStatus
-		rootVolume     *infrav1.RootVolume
-		expect         func(r *recorders)
-		wantErr        bool
+		name                   string
+		eventObject            runtime.Object
+		instanceStatus         func() *InstanceStatus
+		rootVolume             *infrav1.RootVolume
+		additionalBlockDevices []infrav1.AdditionalBlockDevice
+		expect                 func(r *recorders)
+		wantErr                bool
 	}{
 		{
 			name:           "Defaults",
@@ -757,6 +872,133 @@ func TestService_DeleteInstance(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:           "Existing volume is being detached",
+			eventObject:    &infrav1.OpenStackMachine{},
+			instanceStatus: getDefaultInstanceStatus,
+			additionalBlockDevices: []infrav1.AdditionalBlockDevice{
+				{
+					Name:    "etcd",
+					SizeGiB: 50,
+					Storage: infrav1.BlockDeviceStorage{
+						Type: "ExistingVolume",
+						ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+							Filter: &infrav1.VolumeFilter{ID: existingVolumeUUID},
+						},
+					},
+				},
+			},
+			expect: func(r *recorders) {
+				r.volume.GetVolume(existingVolumeUUID).Return(&volumes.Volume{
+					ID:          existingVolumeUUID,
+					Status:      "in-use",
+					Attachments: []volumes.Attachment{{ServerID: instanceUUID}},
+				}, nil)
+				r.compute.DeleteVolumeAttachment(instanceUUID, existingVolumeUUID).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:           "Server is deleted when the existing volume is detached",
+			eventObject:    &infrav1.OpenStackMachine{},
+			instanceStatus: getDefaultInstanceStatus,
+			additionalBlockDevices: []infrav1.AdditionalBlockDevice{
+				{
+					Name:    "etcd",
+					SizeGiB: 50,
+					Storage: infrav1.BlockDeviceStorage{
+						Type: "ExistingVolume",
+						ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+							Filter: &infrav1.VolumeFilter{ID: existingVolumeUUID},
+						},
+					},
+				},
+			},
+			expect: func(r *recorders) {
+				r.volume.GetVolume(existingVolumeUUID).Return(&volumes.Volume{
+					ID:     existingVolumeUUID,
+					Status: "available",
+				}, nil)
+
+				r.compute.DeleteServer(instanceUUID).Return(nil)
+				r.compute.GetServer(instanceUUID).Return(nil, gophercloud.ErrDefault404{})
+			},
+			wantErr: false,
+		},
+		{
+			name:           "Detaching the existing volume fails",
+			eventObject:    &infrav1.OpenStackMachine{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &metav1.Time{Time: time.Now()}}},
+			instanceStatus: getDefaultInstanceStatus,
+			additionalBlockDevices: []infrav1.AdditionalBlockDevice{
+				{
+					Name:    "etcd",
+					SizeGiB: 50,
+					Storage: infrav1.BlockDeviceStorage{
+						Type: "ExistingVolume",
+						ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+							Filter: &infrav1.VolumeFilter{ID: existingVolumeUUID},
+						},
+					},
+				},
+			},
+			expect: func(r *recorders) {
+				r.volume.GetVolume(existingVolumeUUID).Return(&volumes.Volume{
+					ID:          existingVolumeUUID,
+					Status:      "in-use",
+					Attachments: []volumes.Attachment{{ServerID: instanceUUID}},
+				}, nil)
+				r.compute.DeleteVolumeAttachment(instanceUUID, existingVolumeUUID).Return(gophercloud.ErrDefault500{})
+			},
+			wantErr: true,
+		},
+		{
+			name:           "Server is deleted when detaching the existing volume times out",
+			eventObject:    &infrav1.OpenStackMachine{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &metav1.Time{Time: time.Now().Add(-10 * time.Minute)}}},
+			instanceStatus: getDefaultInstanceStatus,
+			additionalBlockDevices: []infrav1.AdditionalBlockDevice{
+				{
+					Name:    "etcd",
+					SizeGiB: 50,
+					Storage: infrav1.BlockDeviceStorage{
+						Type: "ExistingVolume",
+						ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+							Filter: &infrav1.VolumeFilter{ID: existingVolumeUUID},
+						},
+					},
+				},
+			},
+			expect: func(r *recorders) {
+				r.volume.GetVolume(existingVolumeUUID).Return(&volumes.Volume{
+					ID:          existingVolumeUUID,
+					Status:      "in-use",
+					Attachments: []volumes.Attachment{{ServerID: instanceUUID}},
+				}, nil)
+				r.compute.DeleteVolumeAttachment(instanceUUID, existingVolumeUUID).Return(gophercloud.ErrDefault500{})
+
+				r.compute.DeleteServer(instanceUUID).Return(nil)
+				r.compute.GetServer(instanceUUID).Return(nil, gophercloud.ErrDefault404{})
+			},
+			wantErr: false,
+		},
+		{
+			name:           "Dangling existing volume is not deleted",
+			eventObject:    &infrav1.OpenStackMachine{},
+			instanceStatus: func() *InstanceStatus { return nil },
+			additionalBlockDevices: []infrav1.AdditionalBlockDevice{
+				{
+					Name:    "etcd",
+					SizeGiB: 50,
+					Storage: infrav1.BlockDeviceStorage{
+						Type: "ExistingVolume",
+						ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+							Filter: &infrav1.VolumeFilter{ID: existingVolumeUUID},
+						},
+					},
+				},
+			},
+			expect:  func(r *recorders) {},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -776,8 +1018,9 @@ func TestService_DeleteInstance(t *testing.T) {
 			}
 
 			instanceSpec := &InstanceSpec{
-				Name:       openStackMachineName,
-				RootVolume: tt.rootVolume,
+				Name:                   openStackMachineName,
+				RootVolume:             tt.rootVolume,
+				AdditionalBlockDevices: tt.additionalBlockDevices,
 			}
 
 			if err := s.DeleteInstance(tt.eventObject, tt.instanceStatus(), instanceSpec); (err != nil) != tt.wantErr {
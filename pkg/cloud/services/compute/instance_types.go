@@ -26,6 +26,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
 
 // InstanceSpec defines the fields which can be set on a new OpenStack instance.
@@ -48,6 +49,10 @@ type InstanceSpec struct {
 	Tags                   []string
 	SecurityGroups         []infrav1.SecurityGroupFilter
 	Ports                  []infrav1.PortOpts
+	// VolumeNameTemplate is the naming template of the volumes of the instance.
+	VolumeNameTemplate *string
+	// NameData is the data used to render VolumeNameTemplate.
+	NameData names.ResourceNameData
 }
 
 // InstanceIdentifier describes an instance which has not necessarily been fetched.
@@ -99,6 +104,13 @@ func (is *InstanceStatus) AvailabilityZone() string {
 	return is.server.AvailabilityZone
 }
 
+// ImageID returns the ID of the image the server was booted from.
+// It is empty for a server booted from volume.
+func (is *InstanceStatus) ImageID() string {
+	imageID, _ := is.server.Image["id"].(string)
+	return imageID
+}
+
 // BastionStatus updates BastionStatus in openStackCluster.
 func (is *InstanceStatus) UpdateBastionStatus(openStackCluster *infrav1.OpenStackCluster) {
 	if openStackCluster.Status.Bastion == nil {
@@ -52,7 +52,8 @@ func ResolveReferencedMachineResources(scope *scope.WithLogger, openStackCluster
 	}
 
 	// Image is required, so we need to resolve it if it's not set in ReferencedMachineResources yet.
-	if resources.ImageID == "" {
+	// An image referenced by ImageRef is resolved by the controller from the referenced OpenStackImage.
+	if resources.ImageID == "" && spec.Image.ImageRef == nil {
 		imageID, err := computeService.GetImageID(spec.Image)
 		if err != nil {
 			return changed, err
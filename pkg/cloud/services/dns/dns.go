@@ -0,0 +1,212 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
+)
+
+const (
+	recordTypeA    = "A"
+	recordTypeAAAA = "AAAA"
+)
+
+// ReconcileRecords ensures that the A and AAAA recordsets with the given name,
+// relative to the zone of the spec, resolve to the given addresses. Recordsets
+// of a type for which there is no address are deleted. Recordsets which were not
+// created for the cluster are never updated or deleted.
+func (s *Service) ReconcileRecords(eventObject runtime.Object, clusterName string, spec *infrav1.DNSRecords, name string, addresses []string) (*infrav1.DNSRecordStatus, error) {
+	zone, err := s.getZone(&spec.Zone)
+	if err != nil {
+		return nil, err
+	}
+
+	records := map[string][]string{}
+	recordAddresses := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid address %q for DNS record %s", address, name)
+		}
+		recordType := recordTypeAAAA
+		if ip.To4() != nil {
+			recordType = recordTypeA
+		}
+		records[recordType] = append(records[recordType], ip.String())
+		recordAddresses = append(recordAddresses, ip.String())
+	}
+
+	fqdn := name + "." + zone.Name
+	for _, recordType := range []string{recordTypeA, recordTypeAAAA} {
+		if err := s.reconcileRecordSet(eventObject, clusterName, spec, zone.ID, fqdn, recordType, records[recordType]); err != nil {
+			return nil, err
+		}
+	}
+
+	slices.Sort(recordAddresses)
+	return &infrav1.DNSRecordStatus{
+		ZoneID:    zone.ID,
+		Name:      strings.TrimSuffix(fqdn, "."),
+		Addresses: recordAddresses,
+	}, nil
+}
+
+func (s *Service) reconcileRecordSet(eventObject runtime.Object, clusterName string, spec *infrav1.DNSRecords, zoneID, fqdn, recordType string, records []string) error {
+	recordSets, err := s.dnsClient.ListRecordSets(zoneID, recordsets.ListOpts{Name: fqdn, Type: recordType})
+	if err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		return s.deleteOwnedRecordSets(eventObject, clusterName, recordSets)
+	}
+
+	slices.Sort(records)
+
+	if len(recordSets) > 1 {
+		return fmt.Errorf("found %d %s recordsets with name %s", len(recordSets), recordType, fqdn)
+	}
+
+	if len(recordSets) == 0 {
+		createOpts := recordsets.CreateOpts{
+			Name:        fqdn,
+			Description: names.GetDescription(clusterName),
+			Records:     records,
+			Type:        recordType,
+		}
+		if spec.TTL != nil {
+			createOpts.TTL = *spec.TTL
+		}
+		recordSet, err := s.dnsClient.CreateRecordSet(zoneID, createOpts)
+		if err != nil {
+			record.Warnf(eventObject, "FailedCreateRecordSet", "Failed to create %s recordset %s: %v", recordType, fqdn, err)
+			return err
+		}
+		record.Eventf(eventObject, "SuccessfulCreateRecordSet", "Created %s recordset %s with id %s", recordType, fqdn, recordSet.ID)
+		return nil
+	}
+
+	recordSet := &recordSets[0]
+	if !isOwnedRecordSet(recordSet, clusterName) {
+		return fmt.Errorf("%s recordset %s with id %s was not created by cluster %s", recordType, fqdn, recordSet.ID, clusterName)
+	}
+	currentRecords := slices.Clone(recordSet.Records)
+	slices.Sort(currentRecords)
+	if slices.Equal(records, currentRecords) && (spec.TTL == nil || *spec.TTL == recordSet.TTL) {
+		return nil
+	}
+
+	updateOpts := recordsets.UpdateOpts{
+		Records: records,
+		TTL:     spec.TTL,
+	}
+	if _, err := s.dnsClient.UpdateRecordSet(zoneID, recordSet.ID, updateOpts); err != nil {
+		record.Warnf(eventObject, "FailedUpdateRecordSet", "Failed to update %s recordset %s: %v", recordType, fqdn, err)
+		return err
+	}
+	record.Eventf(eventObject, "SuccessfulUpdateRecordSet", "Updated %s recordset %s with id %s", recordType, fqdn, recordSet.ID)
+	return nil
+}
+
+// DeleteRecords deletes the A and AAAA recordsets described by the status
+// which were created for the cluster.
+func (s *Service) DeleteRecords(eventObject runtime.Object, clusterName string, status *infrav1.DNSRecordStatus) error {
+	fqdn := status.Name + "."
+	for _, recordType := range []string{recordTypeA, recordTypeAAAA} {
+		recordSets, err := s.dnsClient.ListRecordSets(status.ZoneID, recordsets.ListOpts{Name: fqdn, Type: recordType})
+		if err != nil {
+			// The zone has been deleted together with its records
+			if capoerrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		if err := s.deleteOwnedRecordSets(eventObject, clusterName, recordSets); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteOwnedRecordSets deletes the given recordsets which were created for the cluster.
+func (s *Service) deleteOwnedRecordSets(eventObject runtime.Object, clusterName string, recordSets []recordsets.RecordSet) error {
+	for i := range recordSets {
+		recordSet := &recordSets[i]
+		if !isOwnedRecordSet(recordSet, clusterName) {
+			s.scope.Logger().Info("Not deleting recordset which was not created by the cluster", "name", recordSet.Name, "type", recordSet.Type, "id", recordSet.ID)
+			continue
+		}
+		if err := s.deleteRecordSet(eventObject, recordSet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isOwnedRecordSet returns true if the recordset was created for the cluster.
+// Recordsets are identified by their description, as Designate does not support tags.
+func isOwnedRecordSet(recordSet *recordsets.RecordSet, clusterName string) bool {
+	return recordSet.Description == names.GetDescription(clusterName)
+}
+
+func (s *Service) deleteRecordSet(eventObject runtime.Object, recordSet *recordsets.RecordSet) error {
+	if err := s.dnsClient.DeleteRecordSet(recordSet.ZoneID, recordSet.ID); err != nil && !capoerrors.IsNotFound(err) {
+		record.Warnf(eventObject, "FailedDeleteRecordSet", "Failed to delete %s recordset %s with id %s: %v", recordSet.Type, recordSet.Name, recordSet.ID, err)
+		return err
+	}
+	record.Eventf(eventObject, "SuccessfulDeleteRecordSet", "Deleted %s recordset %s with id %s", recordSet.Type, recordSet.Name, recordSet.ID)
+	return nil
+}
+
+// getZone returns the zone matching the filter.
+func (s *Service) getZone(filter *infrav1.DNSZoneFilter) (*zones.Zone, error) {
+	if filter.ID != "" {
+		return s.dnsClient.GetZone(filter.ID)
+	}
+
+	// Designate only matches fully qualified zone names
+	name := filter.Name
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+
+	zoneList, err := s.dnsClient.ListZones(zones.ListOpts{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(zoneList) {
+	case 0:
+		return nil, fmt.Errorf("no DNS zone found with name %s", name)
+	case 1:
+		return &zoneList[0], nil
+	}
+	return nil, fmt.Errorf("found %d DNS zones with name %s", len(zoneList), name)
+}
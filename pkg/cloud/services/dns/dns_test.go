@@ -0,0 +1,365 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+	"github.com/gophercloud/utils/openstack/clientconfig"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
+)
+
+const zoneID = "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3"
+
+// fakeDesignate is a minimal in-memory implementation of the recordset API of
+// Designate serving a single zone.
+type fakeDesignate struct {
+	mu         sync.Mutex
+	zone       zones.Zone
+	recordSets map[string]*recordsets.RecordSet
+	lastID     int
+}
+
+func newFakeDesignate(t *testing.T, zoneName string) (*fakeDesignate, clients.DNSClient) {
+	t.Helper()
+
+	f := &fakeDesignate{
+		zone:       zones.Zone{ID: zoneID, Name: zoneName},
+		recordSets: map[string]*recordsets.RecordSet{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/zones", f.listZones)
+	mux.HandleFunc("GET /v2/zones/{zoneID}/recordsets", f.listRecordSets)
+	mux.HandleFunc("POST /v2/zones/{zoneID}/recordsets", f.createRecordSet)
+	mux.HandleFunc("PUT /v2/zones/{zoneID}/recordsets/{id}", f.updateRecordSet)
+	mux.HandleFunc("DELETE /v2/zones/{zoneID}/recordsets/{id}", f.deleteRecordSet)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	providerClient := &gophercloud.ProviderClient{
+		EndpointLocator: func(gophercloud.EndpointOpts) (string, error) {
+			return server.URL + "/", nil
+		},
+	}
+	dnsClient, err := clients.NewDNSClient(providerClient, &clientconfig.ClientOpts{})
+	if err != nil {
+		t.Fatalf("failed to create dns client: %v", err)
+	}
+	return f, dnsClient
+}
+
+func (f *fakeDesignate) listZones(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	zoneList := []zones.Zone{}
+	if name := r.URL.Query().Get("name"); name == "" || name == f.zone.Name {
+		zoneList = append(zoneList, f.zone)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"zones": zoneList, "links": map[string]string{}})
+}
+
+func (f *fakeDesignate) listRecordSets(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.PathValue("zoneID") != f.zone.ID {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+	recordSetList := []recordsets.RecordSet{}
+	for _, recordSet := range f.recordSets {
+		if name := query.Get("name"); name != "" && name != recordSet.Name {
+			continue
+		}
+		if recordType := query.Get("type"); recordType != "" && recordType != recordSet.Type {
+			continue
+		}
+		recordSetList = append(recordSetList, *recordSet)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"recordsets": recordSetList, "links": map[string]string{}})
+}
+
+func (f *fakeDesignate) createRecordSet(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var recordSet recordsets.RecordSet
+	if err := json.NewDecoder(r.Body).Decode(&recordSet); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f.lastID++
+	recordSet.ID = fmt.Sprintf("recordset-%d", f.lastID)
+	recordSet.ZoneID = f.zone.ID
+	if recordSet.TTL == 0 {
+		recordSet.TTL = 3600
+	}
+	f.recordSets[recordSet.ID] = &recordSet
+	writeJSON(w, http.StatusCreated, recordSet)
+}
+
+func (f *fakeDesignate) updateRecordSet(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	recordSet, ok := f.recordSets[r.PathValue("id")]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	var update recordsets.UpdateOpts
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if update.Records != nil {
+		recordSet.Records = update.Records
+	}
+	if update.TTL != nil {
+		recordSet.TTL = *update.TTL
+	}
+	writeJSON(w, http.StatusAccepted, recordSet)
+}
+
+func (f *fakeDesignate) deleteRecordSet(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := f.recordSets[id]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	delete(f.recordSets, id)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// addRecordSet adds a recordset which was not created through the API.
+func (f *fakeDesignate) addRecordSet(recordSet recordsets.RecordSet) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.lastID++
+	recordSet.ID = fmt.Sprintf("recordset-%d", f.lastID)
+	recordSet.ZoneID = f.zone.ID
+	f.recordSets[recordSet.ID] = &recordSet
+}
+
+// records returns the records of all recordsets by type.
+func (f *fakeDesignate) records() map[string][]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	records := map[string][]string{}
+	for _, recordSet := range f.recordSets {
+		records[recordSet.Name+" "+recordSet.Type] = recordSet.Records
+	}
+	return records
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func Test_ReconcileRecords_FakeDesignate(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	designate, dnsClient := newFakeDesignate(t, "example.com.")
+	s := Service{
+		scope:     scope.NewWithLogger(scope.NewMockScopeFactory(mockCtrl, ""), testr.New(t)),
+		dnsClient: dnsClient,
+	}
+	openStackCluster := &infrav1.OpenStackCluster{}
+	spec := &infrav1.DNSRecords{Zone: infrav1.DNSZoneFilter{Name: "example.com"}}
+
+	// Create the records
+	status, err := s.ReconcileRecords(openStackCluster, "test-cluster", spec, "api.test", []string{"10.0.0.1", "2001:db8::1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status).To(Equal(&infrav1.DNSRecordStatus{
+		ZoneID:    zoneID,
+		Name:      "api.test.example.com",
+		Addresses: []string{"10.0.0.1", "2001:db8::1"},
+	}))
+	g.Expect(designate.records()).To(Equal(map[string][]string{
+		"api.test.example.com. A":    {"10.0.0.1"},
+		"api.test.example.com. AAAA": {"2001:db8::1"},
+	}))
+
+	// Update the A record and remove the AAAA record
+	_, err = s.ReconcileRecords(openStackCluster, "test-cluster", spec, "api.test", []string{"10.0.0.2"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(designate.records()).To(Equal(map[string][]string{
+		"api.test.example.com. A": {"10.0.0.2"},
+	}))
+
+	// Update the TTL
+	spec.TTL = pointer.Int(60)
+	_, err = s.ReconcileRecords(openStackCluster, "test-cluster", spec, "api.test", []string{"10.0.0.2"})
+	g.Expect(err).NotTo(HaveOccurred())
+	for _, recordSet := range designate.recordSets {
+		g.Expect(recordSet.TTL).To(Equal(60))
+	}
+
+	// Delete the records
+	err = s.DeleteRecords(openStackCluster, "test-cluster", status)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(designate.records()).To(BeEmpty())
+
+	// Deleting the records again is a no-op
+	err = s.DeleteRecords(openStackCluster, "test-cluster", status)
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func Test_ReconcileRecords_FakeDesignate_NotOwned(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	designate, dnsClient := newFakeDesignate(t, "example.com.")
+	designate.addRecordSet(recordsets.RecordSet{Name: "api.test.example.com.", Type: "A", Records: []string{"192.0.2.1"}})
+	designate.addRecordSet(recordsets.RecordSet{Name: "api.test.example.com.", Type: "AAAA", Records: []string{"2001:db8::1"}, Description: names.GetDescription("other-cluster")})
+	s := Service{
+		scope:     scope.NewWithLogger(scope.NewMockScopeFactory(mockCtrl, ""), testr.New(t)),
+		dnsClient: dnsClient,
+	}
+	openStackCluster := &infrav1.OpenStackCluster{}
+	spec := &infrav1.DNSRecords{Zone: infrav1.DNSZoneFilter{Name: "example.com"}}
+	wantRecords := map[string][]string{
+		"api.test.example.com. A":    {"192.0.2.1"},
+		"api.test.example.com. AAAA": {"2001:db8::1"},
+	}
+
+	// A recordset which was not created by the cluster is not updated
+	_, err := s.ReconcileRecords(openStackCluster, "test-cluster", spec, "api.test", []string{"10.0.0.1"})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(designate.records()).To(Equal(wantRecords))
+
+	// Recordsets which were not created by the cluster are not deleted
+	status := &infrav1.DNSRecordStatus{ZoneID: zoneID, Name: "api.test.example.com"}
+	err = s.DeleteRecords(openStackCluster, "test-cluster", status)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(designate.records()).To(Equal(wantRecords))
+}
+
+func Test_ReconcileRecords(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	const fqdn = "api.test.example.com."
+
+	tests := []struct {
+		name      string
+		spec      infrav1.DNSRecords
+		addresses []string
+		expect    func(m *mock.MockDNSClientMockRecorder)
+		wantErr   bool
+	}{
+		{
+			name:      "zone by ID, records are up to date",
+			spec:      infrav1.DNSRecords{Zone: infrav1.DNSZoneFilter{ID: zoneID}},
+			addresses: []string{"10.0.0.2", "10.0.0.1"},
+			expect: func(m *mock.MockDNSClientMockRecorder) {
+				m.GetZone(zoneID).Return(&zones.Zone{ID: zoneID, Name: "example.com."}, nil)
+				m.ListRecordSets(zoneID, recordsets.ListOpts{Name: fqdn, Type: "A"}).
+					Return([]recordsets.RecordSet{{ID: "recordset-1", Records: []string{"10.0.0.1", "10.0.0.2"}, TTL: 3600, Description: names.GetDescription("test-cluster")}}, nil)
+				m.ListRecordSets(zoneID, recordsets.ListOpts{Name: fqdn, Type: "AAAA"}).Return([]recordsets.RecordSet{}, nil)
+			},
+		},
+		{
+			name:      "recordset not created by the cluster",
+			spec:      infrav1.DNSRecords{Zone: infrav1.DNSZoneFilter{ID: zoneID}},
+			addresses: []string{"10.0.0.1"},
+			expect: func(m *mock.MockDNSClientMockRecorder) {
+				m.GetZone(zoneID).Return(&zones.Zone{ID: zoneID, Name: "example.com."}, nil)
+				m.ListRecordSets(zoneID, recordsets.ListOpts{Name: fqdn, Type: "A"}).
+					Return([]recordsets.RecordSet{{ID: "recordset-1", Records: []string{"10.0.0.2"}}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "zone not found",
+			spec:      infrav1.DNSRecords{Zone: infrav1.DNSZoneFilter{Name: "example.com."}},
+			addresses: []string{"10.0.0.1"},
+			expect: func(m *mock.MockDNSClientMockRecorder) {
+				m.ListZones(zones.ListOpts{Name: "example.com."}).Return([]zones.Zone{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "duplicate recordsets",
+			spec:      infrav1.DNSRecords{Zone: infrav1.DNSZoneFilter{ID: zoneID}},
+			addresses: []string{"10.0.0.1"},
+			expect: func(m *mock.MockDNSClientMockRecorder) {
+				m.GetZone(zoneID).Return(&zones.Zone{ID: zoneID, Name: "example.com."}, nil)
+				m.ListRecordSets(zoneID, recordsets.ListOpts{Name: fqdn, Type: "A"}).
+					Return([]recordsets.RecordSet{{ID: "recordset-1"}, {ID: "recordset-2"}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "invalid address",
+			spec:      infrav1.DNSRecords{Zone: infrav1.DNSZoneFilter{ID: zoneID}},
+			addresses: []string{"api.example.com"},
+			expect: func(m *mock.MockDNSClientMockRecorder) {
+				m.GetZone(zoneID).Return(&zones.Zone{ID: zoneID, Name: "example.com."}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			tt.expect(mockScopeFactory.DNSClient.EXPECT())
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			_, err = s.ReconcileRecords(&infrav1.OpenStackCluster{}, "test-cluster", &tt.spec, "api.test", tt.addresses)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// imageStatusUploading is the status of an image whose data has been staged
+// but not yet imported. It is not defined by gophercloud.
+const imageStatusUploading images.ImageStatus = "uploading"
+
+// imageUploadTimeout bounds the time taken to download image data from its
+// source URL and stage it in glance.
+const imageUploadTimeout = time.Hour
+
+// GetImageByTag returns the image with the given tag, or nil if there is none.
+func (s *Service) GetImageByTag(tag string) (*images.Image, error) {
+	allImages, err := s.imageClient.ListImages(images.ListOpts{Tags: []string{tag}})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(allImages) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &allImages[0], nil
+	default:
+		return nil, fmt.Errorf("found %d images with tag %s", len(allImages), tag)
+	}
+}
+
+// GetImage returns the image with the given ID, or nil if it does not exist.
+func (s *Service) GetImage(id string) (*images.Image, error) {
+	image, err := s.imageClient.GetImage(id)
+	if err != nil {
+		if capoerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return image, nil
+}
+
+// CreateImage creates an empty glance image for the given OpenStackImage. The
+// image content must subsequently be imported with ImportImage.
+func (s *Service) CreateImage(openStackImage *infrav1alpha1.OpenStackImage) (*images.Image, error) {
+	spec := &openStackImage.Spec
+
+	tags := make([]string, 0, len(spec.Tags)+1)
+	tags = append(tags, spec.Tags...)
+	tags = append(tags, openStackImage.GetImageTag())
+
+	var properties map[string]string
+	if len(spec.Properties) > 0 {
+		properties = make(map[string]string, len(spec.Properties))
+		for _, property := range spec.Properties {
+			properties[property.Name] = property.Value
+		}
+	}
+
+	createOpts := images.CreateOpts{
+		Name:            openStackImage.GetImageName(),
+		ContainerFormat: spec.Content.ContainerFormat,
+		DiskFormat:      spec.Content.DiskFormat,
+		Tags:            tags,
+		Properties:      properties,
+	}
+
+	image, err := s.imageClient.CreateImage(createOpts)
+	if err != nil {
+		record.Warnf(openStackImage, "FailedCreateImage", "Failed to create image %s: %v", createOpts.Name, err)
+		return nil, err
+	}
+
+	record.Eventf(openStackImage, "SuccessfulCreateImage", "Created image %s with id %s", image.Name, image.ID)
+	return image, nil
+}
+
+// ImportImage starts importing the content of the given OpenStackImage into
+// the glance image. It does nothing if the image is not waiting for content.
+// With the Upload import method the image data is first staged in the
+// background by uploader; ImportImage returns without error while the transfer
+// is still running and must be called again to complete the import.
+func (s *Service) ImportImage(uploader *Uploader, openStackImage *infrav1alpha1.OpenStackImage, image *images.Image) error {
+	content := &openStackImage.Spec.Content
+
+	var importOpts imageimport.CreateOpts
+	switch content.ImportMethod {
+	case infrav1alpha1.ImageImportMethodUpload:
+		if image.Status == images.ImageStatusQueued {
+			running, err := uploader.start(image.ID, func(ctx context.Context) error {
+				return s.stageImageData(ctx, image.ID, content.URL)
+			})
+			if err != nil {
+				record.Warnf(openStackImage, "FailedUploadImage", "Failed to upload image data for image %s from %s: %v", image.ID, content.URL, err)
+				return err
+			}
+			if running {
+				return nil
+			}
+			image.Status = imageStatusUploading
+		}
+		if image.Status != imageStatusUploading {
+			return nil
+		}
+		importOpts = imageimport.CreateOpts{Name: imageimport.GlanceDirectMethod}
+	default:
+		if image.Status != images.ImageStatusQueued {
+			return nil
+		}
+		importOpts = imageimport.CreateOpts{Name: imageimport.WebDownloadMethod, URI: content.URL}
+	}
+
+	if err := s.imageClient.ImportImage(image.ID, importOpts); err != nil {
+		record.Warnf(openStackImage, "FailedImportImage", "Failed to import image %s: %v", image.ID, err)
+		return err
+	}
+
+	record.Eventf(openStackImage, "SuccessfulImportImage", "Started %s import of image %s", importOpts.Name, image.ID)
+	return nil
+}
+
+// stageImageData downloads the image data from url and stages it in glance.
+// The download uses the TLS configuration of the identity, so a source served
+// with the cloud's CA is trusted.
+func (s *Service) stageImageData(ctx context.Context, id, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.scope.NewHTTPClient(imageUploadTimeout).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status downloading %s: %s", url, resp.Status)
+	}
+
+	s.scope.Logger().Info("Uploading image data", "id", id, "url", url)
+	return s.imageClient.StageImageData(id, resp.Body)
+}
+
+// DeleteImage deletes the glance image with the given ID.
+func (s *Service) DeleteImage(openStackImage *infrav1alpha1.OpenStackImage, id string) error {
+	if err := s.imageClient.DeleteImage(id); err != nil && !capoerrors.IsNotFound(err) {
+		record.Warnf(openStackImage, "FailedDeleteImage", "Failed to delete image %s: %v", id, err)
+		return err
+	}
+
+	record.Eventf(openStackImage, "SuccessfulDeleteImage", "Deleted image %s", id)
+	return nil
+}
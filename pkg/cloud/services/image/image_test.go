@@ -0,0 +1,179 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_CreateImage(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	openStackImage := &infrav1alpha1.OpenStackImage{
+		ObjectMeta: metav1.ObjectMeta{Name: "ubuntu", Namespace: "default"},
+		Spec: infrav1alpha1.OpenStackImageSpec{
+			Content: infrav1alpha1.ImageContent{
+				ContainerFormat: "bare",
+				DiskFormat:      "qcow2",
+				URL:             "https://example.com/ubuntu.qcow2",
+			},
+			Properties: []infrav1alpha1.ImageProperty{
+				{Name: "hw_disk_bus", Value: "scsi"},
+			},
+			Tags: []string{"ubuntu"},
+		},
+	}
+
+	g := NewWithT(t)
+	mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+	mockScopeFactory.ImageClient.EXPECT().
+		CreateImage(images.CreateOpts{
+			Name:            "ubuntu",
+			ContainerFormat: "bare",
+			DiskFormat:      "qcow2",
+			Tags:            []string{"ubuntu", "cluster-api-provider-openstack-image-default/ubuntu"},
+			Properties:      map[string]string{"hw_disk_bus": "scsi"},
+		}).
+		Return(&images.Image{ID: "image-id", Name: "ubuntu", Status: images.ImageStatusQueued}, nil)
+
+	s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	got, err := s.CreateImage(openStackImage)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.ID).To(Equal("image-id"))
+}
+
+func Test_ImportImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("image data"))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		importMethod infrav1alpha1.ImageImportMethod
+		status       images.ImageStatus
+		expect       func(m *mock.MockImageClientMockRecorder)
+	}{
+		{
+			name:         "queued image is imported with web-download",
+			importMethod: infrav1alpha1.ImageImportMethodWebDownload,
+			status:       images.ImageStatusQueued,
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.ImportImage("image-id", imageimport.CreateOpts{Name: imageimport.WebDownloadMethod, URI: server.URL}).Return(nil)
+			},
+		},
+		{
+			name:         "importing image is not imported again",
+			importMethod: infrav1alpha1.ImageImportMethodWebDownload,
+			status:       images.ImageStatusImporting,
+			expect:       func(m *mock.MockImageClientMockRecorder) {},
+		},
+		{
+			name:         "staged image is imported with glance-direct",
+			importMethod: infrav1alpha1.ImageImportMethodUpload,
+			status:       imageStatusUploading,
+			expect: func(m *mock.MockImageClientMockRecorder) {
+				m.ImportImage("image-id", imageimport.CreateOpts{Name: imageimport.GlanceDirectMethod}).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			tt.expect(mockScopeFactory.ImageClient.EXPECT())
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			openStackImage := &infrav1alpha1.OpenStackImage{
+				Spec: infrav1alpha1.OpenStackImageSpec{
+					Content: infrav1alpha1.ImageContent{
+						DiskFormat:   "qcow2",
+						URL:          server.URL,
+						ImportMethod: tt.importMethod,
+					},
+				},
+			}
+			image := &images.Image{ID: "image-id", Status: tt.status}
+			g.Expect(s.ImportImage(NewUploader(), openStackImage, image)).To(Succeed())
+		})
+	}
+}
+
+func Test_ImportImageUpload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("image data"))
+	}))
+	defer server.Close()
+
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+
+	staged := make(chan struct{})
+	mockScopeFactory.ImageClient.EXPECT().StageImageData("image-id", gomock.Any()).DoAndReturn(func(_ string, _ io.Reader) error {
+		close(staged)
+		return nil
+	})
+	mockScopeFactory.ImageClient.EXPECT().ImportImage("image-id", imageimport.CreateOpts{Name: imageimport.GlanceDirectMethod}).Return(nil)
+
+	s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	openStackImage := &infrav1alpha1.OpenStackImage{
+		Spec: infrav1alpha1.OpenStackImageSpec{
+			Content: infrav1alpha1.ImageContent{
+				DiskFormat:   "qcow2",
+				URL:          server.URL,
+				ImportMethod: infrav1alpha1.ImageImportMethodUpload,
+			},
+		},
+	}
+	uploader := NewUploader()
+
+	// The first call starts the transfer in the background and does not import.
+	g.Expect(s.ImportImage(uploader, openStackImage, &images.Image{ID: "image-id", Status: images.ImageStatusQueued})).To(Succeed())
+	<-staged
+
+	// Once the transfer has finished the image is imported.
+	g.Eventually(func() bool {
+		uploader.mu.Lock()
+		defer uploader.mu.Unlock()
+		return uploader.uploads["image-id"].done
+	}).Should(BeTrue())
+	g.Expect(s.ImportImage(uploader, openStackImage, &images.Image{ID: "image-id", Status: images.ImageStatusQueued})).To(Succeed())
+	g.Expect(uploader.uploads).To(BeEmpty())
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"sync"
+)
+
+// upload is the state of a single background image data transfer.
+type upload struct {
+	cancel context.CancelFunc
+	done   bool
+	err    error
+}
+
+// Uploader tracks image data transfers which run in the background, so that
+// a reconcile does not block for the duration of a download. It is safe for
+// concurrent use and is expected to live as long as the controller.
+type Uploader struct {
+	mu      sync.Mutex
+	uploads map[string]*upload
+}
+
+// NewUploader returns an empty Uploader.
+func NewUploader() *Uploader {
+	return &Uploader{uploads: make(map[string]*upload)}
+}
+
+// start runs fn in the background for the given image unless a transfer for
+// it is already tracked. It returns true if a transfer is still running, and
+// the result of a finished transfer otherwise. A finished transfer is
+// forgotten once its result has been returned.
+func (u *Uploader) start(id string, fn func(ctx context.Context) error) (running bool, err error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if current, ok := u.uploads[id]; ok {
+		if !current.done {
+			return true, nil
+		}
+		delete(u.uploads, id)
+		return false, current.err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), imageUploadTimeout)
+	current := &upload{cancel: cancel}
+	u.uploads[id] = current
+
+	go func() {
+		defer cancel()
+		err := fn(ctx)
+
+		u.mu.Lock()
+		defer u.mu.Unlock()
+		current.done = true
+		current.err = err
+	}()
+
+	return true, nil
+}
+
+// Cancel aborts any transfer running for the given image and forgets it.
+func (u *Uploader) Cancel(id string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if current, ok := u.uploads[id]; ok {
+		current.cancel()
+		delete(u.uploads, id)
+	}
+}
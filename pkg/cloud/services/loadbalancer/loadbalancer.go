@@ -73,7 +73,10 @@ func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackClust
 		return false, nil
 	}
 
-	loadBalancerName := getLoadBalancerName(clusterName)
+	loadBalancerName, err := getAPILoadBalancerName(openStackCluster, clusterName)
+	if err != nil {
+		return false, err
+	}
 	s.scope.Logger().Info("Reconciling load balancer", "name", loadBalancerName)
 
 	lbStatus := openStackCluster.Status.APIServerLoadBalancer
@@ -100,7 +103,20 @@ func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackClust
 		}
 	}
 
-	if !pointer.BoolDeref(openStackCluster.Spec.DisableAPIServerFloatingIP, false) {
+	switch {
+	// No floating IP is allocated for an existing load balancer. Use the
+	// floating IP associated with its VIP, if any.
+	case lbSpec.LoadBalancer != nil && !pointer.BoolDeref(openStackCluster.Spec.DisableAPIServerFloatingIP, false):
+		fp, err := s.networkingService.GetFloatingIPByPortID(lb.VipPortID)
+		if err != nil {
+			return false, err
+		}
+		lbStatus.IP = ""
+		if fp != nil {
+			lbStatus.IP = fp.FloatingIP
+		}
+
+	case !pointer.BoolDeref(openStackCluster.Spec.DisableAPIServerFloatingIP, false):
 		floatingIPAddress, err := getAPIServerFloatingIP(openStackCluster)
 		if err != nil {
 			return false, err
@@ -196,10 +212,20 @@ func getAPIServerFloatingIP(openStackCluster *infrav1.OpenStackCluster) (*string
 // Invalid CIDRs are filtered from the list and emil a warning event.
 // It returns a canonical representation that can be directly compared with other canonicalized lists.
 func getCanonicalAllowedCIDRs(openStackCluster *infrav1.OpenStackCluster) []string {
+	var specCIDRs []string
+	if openStackCluster.Spec.APIServerLoadBalancer != nil {
+		specCIDRs = openStackCluster.Spec.APIServerLoadBalancer.AllowedCIDRs
+	}
+	return canonicalizeAllowedCIDRs(openStackCluster, specCIDRs)
+}
+
+// canonicalizeAllowedCIDRs returns the canonical representation of the given
+// allowed CIDRs, extended with the known IPs of the cluster if the list is not empty.
+func canonicalizeAllowedCIDRs(openStackCluster *infrav1.OpenStackCluster, specCIDRs []string) []string {
 	allowedCIDRs := []string{}
 
-	if openStackCluster.Spec.APIServerLoadBalancer != nil && len(openStackCluster.Spec.APIServerLoadBalancer.AllowedCIDRs) > 0 {
-		allowedCIDRs = append(allowedCIDRs, openStackCluster.Spec.APIServerLoadBalancer.AllowedCIDRs...)
+	if len(specCIDRs) > 0 {
+		allowedCIDRs = append(allowedCIDRs, specCIDRs...)
 
 		// In the first reconciliation loop, only the Ready field is set in openStackCluster.Status
 		// All other fields are empty/nil
@@ -256,8 +282,23 @@ func (s *Service) isAllowsCIDRSSupported(lb *loadbalancers.LoadBalancer) (bool,
 }
 
 // getOrCreateAPILoadBalancer returns an existing API loadbalancer if it already exists, or creates a new one if it does not.
+// A load balancer given explicitly in the cluster spec is never created.
 func (s *Service) getOrCreateAPILoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterName string) (*loadbalancers.LoadBalancer, error) {
-	loadBalancerName := getLoadBalancerName(clusterName)
+	if filter := getLoadBalancerFilter(openStackCluster); filter != nil {
+		lb, err := s.getExistingLoadBalancer(filter)
+		if err != nil {
+			return nil, err
+		}
+		if lb == nil {
+			return nil, fmt.Errorf("load balancer %s not found", loadBalancerFilterString(filter))
+		}
+		return lb, nil
+	}
+
+	loadBalancerName, err := getAPILoadBalancerName(openStackCluster, clusterName)
+	if err != nil {
+		return nil, err
+	}
 	lb, err := s.checkIfLbExists(loadBalancerName)
 	if err != nil {
 		return nil, err
@@ -322,21 +363,31 @@ func (s *Service) getOrCreateAPILoadBalancer(openStackCluster *infrav1.OpenStack
 
 // reconcileAPILoadBalancerListener ensures that the listener on the given port exists and is configured correctly.
 func (s *Service) reconcileAPILoadBalancerListener(lb *loadbalancers.LoadBalancer, openStackCluster *infrav1.OpenStackCluster, clusterName string, port int) error {
-	loadBalancerName := getLoadBalancerName(clusterName)
+	loadBalancerName, err := getAPILoadBalancerName(openStackCluster, clusterName)
+	if err != nil {
+		return err
+	}
 	lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
 
 	if openStackCluster.Status.APIServerLoadBalancer == nil {
 		return fmt.Errorf("APIServerLoadBalancer is not yet available in OpenStackCluster.Status")
 	}
 
+	listenerSpec := getAPIServerLoadBalancerListener(openStackCluster, port)
+
+	// allowedCIDRs is nil if allowedCIDRs is not supported by the Octavia provider
+	// A non-nil empty slice is an explicitly empty list
 	allowedCIDRs := openStackCluster.Status.APIServerLoadBalancer.AllowedCIDRs
+	if allowedCIDRs != nil && len(listenerSpec.AllowedCIDRs) > 0 {
+		allowedCIDRs = canonicalizeAllowedCIDRs(openStackCluster, listenerSpec.AllowedCIDRs)
+	}
 
-	listener, err := s.getOrCreateListener(openStackCluster, lbPortObjectsName, lb.ID, allowedCIDRs, port)
+	listener, err := s.getOrCreateListener(openStackCluster, lbPortObjectsName, lb.ID, allowedCIDRs, port, listenerSpec)
 	if err != nil {
 		return err
 	}
 
-	pool, err := s.getOrCreatePool(openStackCluster, lbPortObjectsName, listener.ID, lb.ID, lb.Provider)
+	pool, err := s.getOrCreatePool(openStackCluster, lbPortObjectsName, listener.ID, lb.ID, lb.Provider, listenerSpec)
 	if err != nil {
 		return err
 	}
@@ -345,20 +396,29 @@ func (s *Service) reconcileAPILoadBalancerListener(lb *loadbalancers.LoadBalance
 		return err
 	}
 
-	// allowedCIDRs is nil if allowedCIDRs is not supported by the Octavia provider
-	// A non-nil empty slice is an explicitly empty list
-	if allowedCIDRs != nil {
-		if err := s.getOrUpdateAllowedCIDRs(openStackCluster, listener, allowedCIDRs); err != nil {
-			return err
-		}
+	if err := s.getOrUpdateListener(openStackCluster, listener, lb.ID, allowedCIDRs, listenerSpec); err != nil {
+		return err
 	}
 
-	return nil
+	return s.getOrUpdatePool(openStackCluster, pool, lb.ID, listenerSpec)
+}
+
+// getAPIServerLoadBalancerListener returns the settings of the listener on the
+// given port. All settings are unset if the listener has no entry in the spec.
+func getAPIServerLoadBalancerListener(openStackCluster *infrav1.OpenStackCluster, port int) *infrav1.APIServerLoadBalancerListener {
+	if openStackCluster.Spec.APIServerLoadBalancer != nil {
+		for i := range openStackCluster.Spec.APIServerLoadBalancer.Listeners {
+			if listenerSpec := &openStackCluster.Spec.APIServerLoadBalancer.Listeners[i]; listenerSpec.Port == port {
+				return listenerSpec
+			}
+		}
+	}
+	return &infrav1.APIServerLoadBalancerListener{Port: port}
 }
 
 // getOrCreateListener returns an existing listener for the given loadbalancer
 // and port if it already exists, or creates a new one if it does not.
-func (s *Service) getOrCreateListener(openStackCluster *infrav1.OpenStackCluster, listenerName, lbID string, allowedCIDRs []string, port int) (*listeners.Listener, error) {
+func (s *Service) getOrCreateListener(openStackCluster *infrav1.OpenStackCluster, listenerName, lbID string, allowedCIDRs []string, port int, listenerSpec *infrav1.APIServerLoadBalancerListener) (*listeners.Listener, error) {
 	listener, err := s.checkIfListenerExists(listenerName)
 	if err != nil {
 		return nil, err
@@ -371,12 +431,17 @@ func (s *Service) getOrCreateListener(openStackCluster *infrav1.OpenStackCluster
 	s.scope.Logger().Info("Creating load balancer listener", "name", listenerName, "loadBalancerID", lbID)
 
 	listenerCreateOpts := listeners.CreateOpts{
-		Name:           listenerName,
-		Protocol:       "TCP",
-		ProtocolPort:   port,
-		LoadbalancerID: lbID,
-		Tags:           openStackCluster.Spec.Tags,
-		AllowedCIDRs:   allowedCIDRs,
+		Name:                 listenerName,
+		Protocol:             "TCP",
+		ProtocolPort:         port,
+		LoadbalancerID:       lbID,
+		Tags:                 openStackCluster.Spec.Tags,
+		AllowedCIDRs:         allowedCIDRs,
+		ConnLimit:            listenerSpec.ConnectionLimit,
+		TimeoutClientData:    listenerSpec.TimeoutClientData,
+		TimeoutMemberData:    listenerSpec.TimeoutMemberData,
+		TimeoutMemberConnect: listenerSpec.TimeoutMemberConnect,
+		TimeoutTCPInspect:    listenerSpec.TimeoutTCPInspect,
 	}
 	listener, err = s.loadbalancerClient.CreateListener(listenerCreateOpts)
 	if err != nil {
@@ -398,35 +463,66 @@ func (s *Service) getOrCreateListener(openStackCluster *infrav1.OpenStackCluster
 	return listener, nil
 }
 
-// getOrUpdateAllowedCIDRs ensures that the allowed CIDRs configured on a listener correspond to the expected list.
-func (s *Service) getOrUpdateAllowedCIDRs(openStackCluster *infrav1.OpenStackCluster, listener *listeners.Listener, allowedCIDRs []string) error {
-	// Sort and remove duplicates
-	listener.AllowedCIDRs = capostrings.Canonicalize(listener.AllowedCIDRs)
+// getOrUpdateListener ensures that the allowed CIDRs and the settings of a
+// listener correspond to the spec. allowedCIDRs is nil if allowed CIDRs are
+// not supported by the Octavia provider.
+func (s *Service) getOrUpdateListener(openStackCluster *infrav1.OpenStackCluster, listener *listeners.Listener, lbID string, allowedCIDRs []string, listenerSpec *infrav1.APIServerLoadBalancerListener) error {
+	listenerUpdateOpts := listeners.UpdateOpts{}
+	needsUpdate := false
 
-	if !slices.Equal(allowedCIDRs, listener.AllowedCIDRs) {
-		s.scope.Logger().Info("CIDRs do not match, updating listener", "expectedCIDRs", allowedCIDRs, "currentCIDRs", listener.AllowedCIDRs)
-		listenerUpdateOpts := listeners.UpdateOpts{
-			AllowedCIDRs: &allowedCIDRs,
-		}
+	if allowedCIDRs != nil {
+		// Sort and remove duplicates
+		listener.AllowedCIDRs = capostrings.Canonicalize(listener.AllowedCIDRs)
 
-		listenerID := listener.ID
-		listener, err := s.loadbalancerClient.UpdateListener(listener.ID, listenerUpdateOpts)
-		if err != nil {
-			record.Warnf(openStackCluster, "FailedUpdateListener", "Failed to update listener %s: %v", listenerID, err)
-			return err
+		if !slices.Equal(allowedCIDRs, listener.AllowedCIDRs) {
+			s.scope.Logger().Info("CIDRs do not match, updating listener", "expectedCIDRs", allowedCIDRs, "currentCIDRs", listener.AllowedCIDRs)
+			listenerUpdateOpts.AllowedCIDRs = &allowedCIDRs
+			needsUpdate = true
 		}
+	}
 
-		if err := s.waitForListener(listener.ID, "ACTIVE"); err != nil {
-			record.Warnf(openStackCluster, "FailedUpdateListener", "Failed to update listener %s with id %s: wait for listener active: %v", listener.Name, listener.ID, err)
-			return err
+	for _, setting := range []struct {
+		want    *int
+		current int
+		update  **int
+	}{
+		{listenerSpec.ConnectionLimit, listener.ConnLimit, &listenerUpdateOpts.ConnLimit},
+		{listenerSpec.TimeoutClientData, listener.TimeoutClientData, &listenerUpdateOpts.TimeoutClientData},
+		{listenerSpec.TimeoutMemberData, listener.TimeoutMemberData, &listenerUpdateOpts.TimeoutMemberData},
+		{listenerSpec.TimeoutMemberConnect, listener.TimeoutMemberConnect, &listenerUpdateOpts.TimeoutMemberConnect},
+		{listenerSpec.TimeoutTCPInspect, listener.TimeoutTCPInspect, &listenerUpdateOpts.TimeoutTCPInspect},
+	} {
+		if setting.want != nil && *setting.want != setting.current {
+			*setting.update = setting.want
+			needsUpdate = true
 		}
+	}
 
-		record.Eventf(openStackCluster, "SuccessfulUpdateListener", "Updated allowed_cidrs %s for listener %s with id %s", listener.AllowedCIDRs, listener.Name, listener.ID)
+	if !needsUpdate {
+		return nil
 	}
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		return err
+	}
+
+	listenerID := listener.ID
+	listener, err := s.loadbalancerClient.UpdateListener(listener.ID, listenerUpdateOpts)
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateListener", "Failed to update listener %s: %v", listenerID, err)
+		return err
+	}
+
+	if err := s.waitForListener(listener.ID, "ACTIVE"); err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateListener", "Failed to update listener %s with id %s: wait for listener active: %v", listener.Name, listener.ID, err)
+		return err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulUpdateListener", "Updated listener %s with id %s", listener.Name, listener.ID)
 	return nil
 }
 
-func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, poolName, listenerID, lbID string, lbProvider string) (*pools.Pool, error) {
+func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, poolName, listenerID, lbID string, lbProvider string, listenerSpec *infrav1.APIServerLoadBalancerListener) (*pools.Pool, error) {
 	pool, err := s.checkIfPoolExists(poolName)
 	if err != nil {
 		return nil, err
@@ -443,14 +539,25 @@ func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, po
 	if lbProvider == "ovn" {
 		method = pools.LBMethodSourceIpPort
 	}
+	if listenerSpec.PoolAlgorithm != "" {
+		method = pools.LBMethod(listenerSpec.PoolAlgorithm)
+	}
+
+	protocol := pools.ProtocolTCP
+	if listenerSpec.PoolProtocol != "" {
+		protocol = pools.Protocol(listenerSpec.PoolProtocol)
+	}
 
 	poolCreateOpts := pools.CreateOpts{
 		Name:       poolName,
-		Protocol:   "TCP",
+		Protocol:   protocol,
 		LBMethod:   method,
 		ListenerID: listenerID,
 		Tags:       openStackCluster.Spec.Tags,
 	}
+	if listenerSpec.SessionPersistence != "" {
+		poolCreateOpts.Persistence = &pools.SessionPersistence{Type: listenerSpec.SessionPersistence}
+	}
 	pool, err = s.loadbalancerClient.CreatePool(poolCreateOpts)
 	if err != nil {
 		record.Warnf(openStackCluster, "FailedCreatePool", "Failed to create pool %s: %v", poolName, err)
@@ -466,6 +573,46 @@ func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, po
 	return pool, nil
 }
 
+// getOrUpdatePool ensures that the load balancing algorithm and the session
+// persistence of a pool correspond to the spec. The protocol of a pool cannot
+// be changed.
+func (s *Service) getOrUpdatePool(openStackCluster *infrav1.OpenStackCluster, pool *pools.Pool, lbID string, listenerSpec *infrav1.APIServerLoadBalancerListener) error {
+	poolUpdateOpts := pools.UpdateOpts{}
+	needsUpdate := false
+
+	if listenerSpec.PoolAlgorithm != "" && listenerSpec.PoolAlgorithm != pool.LBMethod {
+		poolUpdateOpts.LBMethod = pools.LBMethod(listenerSpec.PoolAlgorithm)
+		needsUpdate = true
+	}
+	if listenerSpec.SessionPersistence != "" && listenerSpec.SessionPersistence != pool.Persistence.Type {
+		poolUpdateOpts.Persistence = &pools.SessionPersistence{Type: listenerSpec.SessionPersistence}
+		needsUpdate = true
+	}
+
+	if !needsUpdate {
+		return nil
+	}
+
+	s.scope.Logger().Info("Updating load balancer pool", "name", pool.Name, "poolID", pool.ID)
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		return err
+	}
+
+	if _, err := s.loadbalancerClient.UpdatePool(pool.ID, poolUpdateOpts); err != nil {
+		record.Warnf(openStackCluster, "FailedUpdatePool", "Failed to update pool %s with id %s: %v", pool.Name, pool.ID, err)
+		return err
+	}
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		record.Warnf(openStackCluster, "FailedUpdatePool", "Failed to update pool %s with id %s: wait for load balancer active %s: %v", pool.Name, pool.ID, lbID, err)
+		return err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulUpdatePool", "Updated pool %s with id %s", pool.Name, pool.ID)
+	return nil
+}
+
 func (s *Service) getOrCreateMonitor(openStackCluster *infrav1.OpenStackCluster, monitorName, poolID, lbID string) error {
 	monitor, err := s.checkIfMonitorExists(monitorName)
 	if err != nil {
@@ -522,7 +669,10 @@ func (s *Service) ReconcileLoadBalancerMember(openStackCluster *infrav1.OpenStac
 		return errors.New("ControlPlaneEndpoint is not yet set in openStackCluster.Spec")
 	}
 
-	loadBalancerName := getLoadBalancerName(clusterName)
+	loadBalancerName, err := getAPILoadBalancerName(openStackCluster, clusterName)
+	if err != nil {
+		return err
+	}
 	s.scope.Logger().Info("Reconciling load balancer member", "loadBalancerName", loadBalancerName)
 
 	lbID := openStackCluster.Status.APIServerLoadBalancer.ID
@@ -599,8 +749,11 @@ func (s *Service) ReconcileLoadBalancerMember(openStackCluster *infrav1.OpenStac
 }
 
 func (s *Service) DeleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterName string) error {
-	loadBalancerName := getLoadBalancerName(clusterName)
-	lb, err := s.checkIfLbExists(loadBalancerName)
+	loadBalancerName, err := getAPILoadBalancerName(openStackCluster, clusterName)
+	if err != nil {
+		return err
+	}
+	lb, err := s.getAPILoadBalancer(openStackCluster, loadBalancerName)
 	if err != nil {
 		return err
 	}
@@ -609,6 +762,12 @@ func (s *Service) DeleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster,
 		return nil
 	}
 
+	// An existing load balancer is left intact apart from the objects
+	// created for the cluster.
+	if getLoadBalancerFilter(openStackCluster) != nil {
+		return s.deleteAPILoadBalancerListeners(openStackCluster, lb, loadBalancerName)
+	}
+
 	if lb.VipPortID != "" {
 		fip, err := s.networkingService.GetFloatingIPByPortID(lb.VipPortID)
 		if err != nil {
@@ -650,8 +809,16 @@ func (s *Service) DeleteLoadBalancerMember(openStackCluster *infrav1.OpenStackCl
 		return nil
 	}
 
-	loadBalancerName := getLoadBalancerName(clusterName)
-	lb, err := s.checkIfLbExists(loadBalancerName)
+	return s.DeleteInstanceLoadBalancerMember(openStackCluster, openStackMachine.Name, clusterName)
+}
+
+// DeleteInstanceLoadBalancerMember removes the server with the given name from the pools of the API server load balancer.
+func (s *Service) DeleteInstanceLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, instanceName, clusterName string) error {
+	loadBalancerName, err := getAPILoadBalancerName(openStackCluster, clusterName)
+	if err != nil {
+		return err
+	}
+	lb, err := s.getAPILoadBalancer(openStackCluster, loadBalancerName)
 	if err != nil {
 		return err
 	}
@@ -671,7 +838,7 @@ func (s *Service) DeleteLoadBalancerMember(openStackCluster *infrav1.OpenStackCl
 	}
 	for _, port := range portList {
 		lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
-		name := lbPortObjectsName + "-" + openStackMachine.Name
+		name := lbPortObjectsName + "-" + instanceName
 
 		pool, err := s.checkIfPoolExists(lbPortObjectsName)
 		if err != nil {
@@ -705,10 +872,114 @@ func (s *Service) DeleteLoadBalancerMember(openStackCluster *infrav1.OpenStackCl
 	return nil
 }
 
+// deleteAPILoadBalancerListeners deletes the listeners and pools created for
+// the cluster on the load balancer. Deleting a pool also deletes its members
+// and monitor.
+func (s *Service) deleteAPILoadBalancerListeners(openStackCluster *infrav1.OpenStackCluster, lb *loadbalancers.LoadBalancer, loadBalancerName string) error {
+	var portList []int
+	if openStackCluster.Spec.ControlPlaneEndpoint != nil {
+		portList = append(portList, int(openStackCluster.Spec.ControlPlaneEndpoint.Port))
+	}
+	if openStackCluster.Spec.APIServerLoadBalancer != nil {
+		portList = append(portList, openStackCluster.Spec.APIServerLoadBalancer.AdditionalPorts...)
+	}
+	for _, port := range portList {
+		lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
+
+		pool, err := s.checkIfPoolExists(lbPortObjectsName)
+		if err != nil {
+			return err
+		}
+		if pool != nil {
+			if _, err := s.waitForLoadBalancerActive(lb.ID); err != nil {
+				return err
+			}
+			if err := s.loadbalancerClient.DeletePool(pool.ID); err != nil && !capoerrors.IsNotFound(err) {
+				record.Warnf(openStackCluster, "FailedDeletePool", "Failed to delete pool %s with id %s: %v", pool.Name, pool.ID, err)
+				return err
+			}
+			record.Eventf(openStackCluster, "SuccessfulDeletePool", "Deleted pool %s with id %s", pool.Name, pool.ID)
+		}
+
+		listener, err := s.checkIfListenerExists(lbPortObjectsName)
+		if err != nil {
+			return err
+		}
+		if listener != nil {
+			if _, err := s.waitForLoadBalancerActive(lb.ID); err != nil {
+				return err
+			}
+			if err := s.loadbalancerClient.DeleteListener(listener.ID); err != nil && !capoerrors.IsNotFound(err) {
+				record.Warnf(openStackCluster, "FailedDeleteListener", "Failed to delete listener %s with id %s: %v", listener.Name, listener.ID, err)
+				return err
+			}
+			record.Eventf(openStackCluster, "SuccessfulDeleteListener", "Deleted listener %s with id %s", listener.Name, listener.ID)
+		}
+	}
+	return nil
+}
+
 func getLoadBalancerName(clusterName string) string {
 	return fmt.Sprintf("%s-cluster-%s-%s", networkPrefix, clusterName, kubeapiLBSuffix)
 }
 
+// getAPILoadBalancerName returns the name of the API server load balancer of the cluster.
+func getAPILoadBalancerName(openStackCluster *infrav1.OpenStackCluster, clusterName string) (string, error) {
+	return names.GetResourceName(names.GetResourceNaming(openStackCluster).LoadBalancer, names.GetClusterResourceNameData(openStackCluster), getLoadBalancerName(clusterName))
+}
+
+// getAPILoadBalancer returns the API server load balancer of the cluster, or
+// nil if it does not exist.
+func (s *Service) getAPILoadBalancer(openStackCluster *infrav1.OpenStackCluster, loadBalancerName string) (*loadbalancers.LoadBalancer, error) {
+	if filter := getLoadBalancerFilter(openStackCluster); filter != nil {
+		return s.getExistingLoadBalancer(filter)
+	}
+	return s.checkIfLbExists(loadBalancerName)
+}
+
+// getLoadBalancerFilter returns the filter of an existing load balancer given
+// in the cluster spec, or nil if CAPO manages the load balancer.
+func getLoadBalancerFilter(openStackCluster *infrav1.OpenStackCluster) *infrav1.LoadBalancerFilter {
+	if openStackCluster.Spec.APIServerLoadBalancer == nil {
+		return nil
+	}
+	return openStackCluster.Spec.APIServerLoadBalancer.LoadBalancer
+}
+
+// getExistingLoadBalancer returns the load balancer matching the filter, or
+// nil if it does not exist.
+func (s *Service) getExistingLoadBalancer(filter *infrav1.LoadBalancerFilter) (*loadbalancers.LoadBalancer, error) {
+	if filter.ID != "" {
+		lb, err := s.loadbalancerClient.GetLoadBalancer(filter.ID)
+		if err != nil {
+			if capoerrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return lb, nil
+	}
+
+	lbList, err := s.loadbalancerClient.ListLoadBalancers(loadbalancers.ListOpts{Name: filter.Name})
+	if err != nil {
+		return nil, err
+	}
+	switch len(lbList) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &lbList[0], nil
+	}
+	return nil, fmt.Errorf("found %d load balancers with name %s", len(lbList), filter.Name)
+}
+
+func loadBalancerFilterString(filter *infrav1.LoadBalancerFilter) string {
+	if filter.ID != "" {
+		return "with id " + filter.ID
+	}
+	return "with name " + filter.Name
+}
+
 func (s *Service) checkIfLbExists(name string) (*loadbalancers.LoadBalancer, error) {
 	lbList, err := s.loadbalancerClient.ListLoadBalancers(loadbalancers.ListOpts{Name: name})
 	if err != nil {
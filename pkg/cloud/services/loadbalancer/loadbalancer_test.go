@@ -488,6 +488,53 @@ func Test_getOrCreateAPILoadBalancer(t *testing.T) {
 				ID: "AAAAA",
 			},
 		},
+		{
+			name: "existing loadbalancer by id",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						LoadBalancer: &infrav1.LoadBalancerFilter{ID: "BBBBB"},
+					},
+				},
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.GetLoadBalancer("BBBBB").Return(&loadbalancers.LoadBalancer{ID: "BBBBB"}, nil)
+			},
+			want: &loadbalancers.LoadBalancer{
+				ID: "BBBBB",
+			},
+		},
+		{
+			name: "existing loadbalancer by name",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						LoadBalancer: &infrav1.LoadBalancerFilter{Name: "shared-lb"},
+					},
+				},
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: "shared-lb"}).Return([]loadbalancers.LoadBalancer{{ID: "BBBBB", Name: "shared-lb"}}, nil)
+			},
+			want: &loadbalancers.LoadBalancer{
+				ID:   "BBBBB",
+				Name: "shared-lb",
+			},
+		},
+		{
+			name: "existing loadbalancer is never created",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						LoadBalancer: &infrav1.LoadBalancerFilter{Name: "shared-lb"},
+					},
+				},
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: "shared-lb"}).Return([]loadbalancers.LoadBalancer{}, nil)
+			},
+			wantError: fmt.Errorf("load balancer with name shared-lb not found"),
+		},
 	}
 	for _, tt := range lbtests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -509,3 +556,168 @@ func Test_getOrCreateAPILoadBalancer(t *testing.T) {
 		})
 	}
 }
+
+func Test_DeleteLoadBalancer(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	lbtests := []struct {
+		name               string
+		openStackCluster   *infrav1.OpenStackCluster
+		expectLoadBalancer func(m *mock.MockLbClientMockRecorder)
+	}{
+		{
+			name: "existing loadbalancer only loses the listeners of the cluster",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled:      pointer.Bool(true),
+						LoadBalancer: &infrav1.LoadBalancerFilter{ID: "aaaaaaaa-bbbb-cccc-dddd-333333333333"},
+					},
+					ControlPlaneEndpoint: &clusterv1.APIEndpoint{
+						Host: apiHostname,
+						Port: 6443,
+					},
+				},
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				activeLB := loadbalancers.LoadBalancer{
+					ID:                 "aaaaaaaa-bbbb-cccc-dddd-333333333333",
+					Name:               "shared-lb",
+					ProvisioningStatus: "ACTIVE",
+				}
+				m.GetLoadBalancer(activeLB.ID).Return(&activeLB, nil).AnyTimes()
+
+				pool := pools.Pool{
+					ID:   "aaaaaaaa-bbbb-cccc-dddd-555555555555",
+					Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-6443",
+				}
+				m.ListPools(pools.ListOpts{Name: pool.Name}).Return([]pools.Pool{pool}, nil)
+				m.DeletePool(pool.ID).Return(nil)
+
+				listener := listeners.Listener{
+					ID:   "aaaaaaaa-bbbb-cccc-dddd-444444444444",
+					Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-6443",
+				}
+				m.ListListeners(listeners.ListOpts{Name: listener.Name}).Return([]listeners.Listener{listener}, nil)
+				m.DeleteListener(listener.ID).Return(nil)
+			},
+		},
+	}
+	for _, tt := range lbtests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			lbs, err := NewService(scope.NewWithLogger(mockScopeFactory, log))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tt.expectLoadBalancer(mockScopeFactory.LbClient.EXPECT())
+			err = lbs.DeleteLoadBalancer(tt.openStackCluster, "AAAAA")
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func Test_reconcileAPILoadBalancerListener(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	lb := &loadbalancers.LoadBalancer{
+		ID:                 "aaaaaaaa-bbbb-cccc-dddd-333333333333",
+		Name:               "k8s-clusterapi-cluster-AAAAA-kubeapi",
+		ProvisioningStatus: "ACTIVE",
+	}
+	listenerName := "k8s-clusterapi-cluster-AAAAA-kubeapi-8132"
+	existingListener := listeners.Listener{
+		ID:                "aaaaaaaa-bbbb-cccc-dddd-444444444444",
+		Name:              listenerName,
+		ConnLimit:         -1,
+		TimeoutClientData: 50000,
+		TimeoutMemberData: 50000,
+		AllowedCIDRs:      []string{"10.0.0.0/8"},
+	}
+	existingPool := pools.Pool{
+		ID:       "aaaaaaaa-bbbb-cccc-dddd-555555555555",
+		Name:     listenerName,
+		LBMethod: "ROUND_ROBIN",
+	}
+	monitorList := []monitors.Monitor{{ID: "aaaaaaaa-bbbb-cccc-dddd-666666666666", Name: listenerName}}
+
+	lbtests := []struct {
+		name               string
+		listenerSpec       infrav1.APIServerLoadBalancerListener
+		expectLoadBalancer func(m *mock.MockLbClientMockRecorder)
+	}{
+		{
+			name:         "listener without settings is left unchanged",
+			listenerSpec: infrav1.APIServerLoadBalancerListener{Port: 6443},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.ListListeners(listeners.ListOpts{Name: listenerName}).Return([]listeners.Listener{existingListener}, nil)
+				m.ListPools(pools.ListOpts{Name: listenerName}).Return([]pools.Pool{existingPool}, nil)
+				m.ListMonitors(monitors.ListOpts{Name: listenerName}).Return(monitorList, nil)
+			},
+		},
+		{
+			name: "listener and pool settings are updated",
+			listenerSpec: infrav1.APIServerLoadBalancerListener{
+				Port:               8132,
+				TimeoutClientData:  pointer.Int(3600000),
+				TimeoutMemberData:  pointer.Int(3600000),
+				ConnectionLimit:    pointer.Int(-1),
+				AllowedCIDRs:       []string{"192.168.0.0/16"},
+				PoolAlgorithm:      "LEAST_CONNECTIONS",
+				SessionPersistence: "SOURCE_IP",
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				m.ListListeners(listeners.ListOpts{Name: listenerName}).Return([]listeners.Listener{existingListener}, nil)
+				m.ListPools(pools.ListOpts{Name: listenerName}).Return([]pools.Pool{existingPool}, nil)
+				m.ListMonitors(monitors.ListOpts{Name: listenerName}).Return(monitorList, nil)
+				m.GetLoadBalancer(lb.ID).Return(lb, nil).AnyTimes()
+
+				m.UpdateListener(existingListener.ID, listeners.UpdateOpts{
+					AllowedCIDRs:      &[]string{"192.168.0.0/16"},
+					TimeoutClientData: pointer.Int(3600000),
+					TimeoutMemberData: pointer.Int(3600000),
+				}).Return(&existingListener, nil)
+				m.GetListener(existingListener.ID).Return(&listeners.Listener{ID: existingListener.ID, ProvisioningStatus: "ACTIVE"}, nil)
+
+				m.UpdatePool(existingPool.ID, pools.UpdateOpts{
+					LBMethod:    pools.LBMethodLeastConnections,
+					Persistence: &pools.SessionPersistence{Type: "SOURCE_IP"},
+				}).Return(&existingPool, nil)
+			},
+		},
+	}
+	for _, tt := range lbtests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+
+			openStackCluster := &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled:         pointer.Bool(true),
+						AdditionalPorts: []int{8132},
+						AllowedCIDRs:    []string{"10.0.0.0/8"},
+						Listeners:       []infrav1.APIServerLoadBalancerListener{tt.listenerSpec},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					APIServerLoadBalancer: &infrav1.LoadBalancer{
+						AllowedCIDRs: []string{"10.0.0.0/8"},
+					},
+				},
+			}
+
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			lbs, err := NewService(scope.NewWithLogger(mockScopeFactory, log))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tt.expectLoadBalancer(mockScopeFactory.LbClient.EXPECT())
+			err = lbs.reconcileAPILoadBalancerListener(lb, openStackCluster, "AAAAA", 8132)
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
+)
+
+// getClusterUIDTag returns the tag identifying the resources created for the
+// cluster, or an empty string if the cluster has no UID. The UID recorded in
+// the ClusterUIDAnnotation takes precedence over the UID of the object, which
+// changes when the cluster is moved.
+func getClusterUIDTag(openStackCluster *infrav1.OpenStackCluster) string {
+	if uid := openStackCluster.Annotations[infrav1.ClusterUIDAnnotation]; uid != "" {
+		return names.GetClusterUIDTag(uid)
+	}
+	if openStackCluster.UID == "" {
+		return ""
+	}
+	return names.GetClusterUIDTag(string(openStackCluster.UID))
+}
+
+// getClusterTags returns the tags of a resource created for the cluster: the
+// tags from the spec, the cluster UID tag and the given extra tags.
+func getClusterTags(openStackCluster *infrav1.OpenStackCluster, extraTags ...string) []string {
+	tags := append([]string{}, openStackCluster.Spec.Tags...)
+	if uidTag := getClusterUIDTag(openStackCluster); uidTag != "" {
+		tags = append(tags, uidTag)
+		tags = append(tags, extraTags...)
+	}
+	return tags
+}
+
+// getClusterResource looks up a resource created for the cluster by the
+// cluster UID tag and the given extra tags. If none is found, e.g. because
+// the resource was created before it was tagged, it is looked up by name,
+// ignoring resources tagged as belonging to another cluster. A resource found
+// by name is tagged, so that it is found by its tags from then on.
+//
+// list returns the resources with the given comma separated tags, or with the
+// given name if tags is empty. The zero value is returned if no resource is found.
+func getClusterResource[T any](s *Service, openStackCluster *infrav1.OpenStackCluster, resourceType, name string, extraTags []string,
+	list func(tags, name string) ([]T, error), idAndTags func(T) (string, []string),
+) (T, error) {
+	var zero T
+
+	uidTag := getClusterUIDTag(openStackCluster)
+	if uidTag != "" {
+		tagged, err := list(strings.Join(append([]string{uidTag}, extraTags...), ","), "")
+		if err != nil {
+			return zero, err
+		}
+		switch len(tagged) {
+		case 0:
+		case 1:
+			return tagged[0], nil
+		default:
+			return zero, fmt.Errorf("found %d %s tagged with %s, which should not happen", len(tagged), resourceType, uidTag)
+		}
+	}
+
+	named, err := list("", name)
+	if err != nil {
+		return zero, err
+	}
+	named = slices.DeleteFunc(named, func(resource T) bool {
+		_, tags := idAndTags(resource)
+		return uidTag != "" && slices.ContainsFunc(tags, func(tag string) bool {
+			return strings.HasPrefix(tag, names.ClusterUIDTagPrefix) && tag != uidTag
+		})
+	})
+	switch len(named) {
+	case 0:
+		return zero, nil
+	case 1:
+	default:
+		return zero, fmt.Errorf("found %d %s with the name %s, which should not happen", len(named), resourceType, name)
+	}
+
+	resource := named[0]
+	if uidTag != "" {
+		id, currentTags := idAndTags(resource)
+		tags := append([]string{}, currentTags...)
+		s.scope.Logger().V(4).Info("Tagging resource found by name", "type", resourceType, "name", name, "id", id)
+		for _, tag := range append([]string{uidTag}, extraTags...) {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		if _, err := s.client.ReplaceAllAttributesTags(resourceType, id, attributestags.ReplaceAllOpts{Tags: tags}); err != nil {
+			return zero, fmt.Errorf("failed to tag %s %s: %w", resourceType, id, err)
+		}
+	}
+	return resource, nil
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_getClusterNetwork(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	networkName := getNetworkName("test-cluster")
+	uidTag := "capo-cluster-uid=5b6a1ce0-cc4c-4d0e-9b8f-7dc0b9c2d5b3"
+	openStackCluster := &infrav1.OpenStackCluster{
+		ObjectMeta: metav1.ObjectMeta{UID: "5b6a1ce0-cc4c-4d0e-9b8f-7dc0b9c2d5b3"},
+		Spec: infrav1.OpenStackClusterSpec{
+			Tags: []string{"foo"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		expect  func(m *mock.MockNetworkClientMockRecorder)
+		wantID  string
+		wantErr bool
+	}{
+		{
+			name: "finds the network by tag",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListNetwork(networks.ListOpts{Tags: uidTag}).Return([]networks.Network{{ID: "network", Tags: []string{uidTag}}}, nil)
+			},
+			wantID: "network",
+		},
+		{
+			name: "falls back to the name and tags the network",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListNetwork(networks.ListOpts{Tags: uidTag}).Return([]networks.Network{}, nil)
+				m.ListNetwork(networks.ListOpts{Name: networkName}).Return([]networks.Network{{ID: "network", Tags: []string{"foo"}}}, nil)
+				m.ReplaceAllAttributesTags("networks", "network", attributestags.ReplaceAllOpts{Tags: []string{"foo", uidTag}}).Return([]string{"foo", uidTag}, nil)
+			},
+			wantID: "network",
+		},
+		{
+			name: "ignores networks of other clusters with the same name",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListNetwork(networks.ListOpts{Tags: uidTag}).Return([]networks.Network{}, nil)
+				m.ListNetwork(networks.ListOpts{Name: networkName}).Return([]networks.Network{
+					{ID: "other", Tags: []string{"capo-cluster-uid=0d1e6f31-32f6-4bd2-8b1b-0e4f6e5c3e9a"}},
+				}, nil)
+			},
+			wantID: "",
+		},
+		{
+			name: "fails if more than one network is tagged",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListNetwork(networks.ListOpts{Tags: uidTag}).Return([]networks.Network{{ID: "a"}, {ID: "b"}}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			tt.expect(mockClient.EXPECT())
+
+			scopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			s := Service{
+				client: mockClient,
+				scope:  scope.NewWithLogger(scopeFactory, testr.New(t)),
+			}
+			network, err := s.getClusterNetwork(openStackCluster, networkName)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(network.ID).To(Equal(tt.wantID))
+		})
+	}
+}
+
+func Test_getClusterNetworkAfterUIDChange(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	uidTag := "capo-cluster-uid=5b6a1ce0-cc4c-4d0e-9b8f-7dc0b9c2d5b3"
+
+	// The UID of the OpenStackCluster changed, e.g. after clusterctl move,
+	// but the annotation still records the UID its resources are tagged with.
+	openStackCluster := &infrav1.OpenStackCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:         "9c0e2a5e-7d4b-4b5e-8f33-2f1c6a1d8e70",
+			Annotations: map[string]string{infrav1.ClusterUIDAnnotation: "5b6a1ce0-cc4c-4d0e-9b8f-7dc0b9c2d5b3"},
+		},
+	}
+
+	mockClient := mock.NewMockNetworkClient(mockCtrl)
+	mockClient.EXPECT().
+		ListNetwork(networks.ListOpts{Tags: uidTag}).
+		Return([]networks.Network{{ID: "network", Tags: []string{uidTag}}}, nil)
+
+	scopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+	s := Service{
+		client: mockClient,
+		scope:  scope.NewWithLogger(scopeFactory, testr.New(t)),
+	}
+	network, err := s.getClusterNetwork(openStackCluster, getNetworkName("test-cluster"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(network.ID).To(Equal("network"))
+	g.Expect(getClusterTags(openStackCluster)).To(Equal([]string{uidTag}))
+}
+
+func Test_getClusterSecurityGroup(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	openStackCluster := &infrav1.OpenStackCluster{
+		ObjectMeta: metav1.ObjectMeta{UID: "5b6a1ce0-cc4c-4d0e-9b8f-7dc0b9c2d5b3"},
+	}
+
+	mockClient := mock.NewMockNetworkClient(mockCtrl)
+	mockClient.EXPECT().
+		ListSecGroup(groups.ListOpts{Tags: "capo-cluster-uid=5b6a1ce0-cc4c-4d0e-9b8f-7dc0b9c2d5b3,capo-security-group=worker"}).
+		Return([]clients.SecGroupExt{{SecGroup: groups.SecGroup{ID: "worker", Name: "renamed"}}}, nil)
+
+	scopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+	s := Service{
+		client: mockClient,
+		scope:  scope.NewWithLogger(scopeFactory, testr.New(t)),
+	}
+	group, err := s.getClusterSecurityGroup(openStackCluster, workerSuffix, getSecWorkerGroupName("test-cluster"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(group.ID).To(Equal("worker"))
+}
+
+func Test_getClusterTags(t *testing.T) {
+	g := NewWithT(t)
+
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{Tags: []string{"foo"}},
+	}
+	g.Expect(getClusterTags(openStackCluster, "bar")).To(Equal([]string{"foo"}))
+
+	openStackCluster.UID = "5b6a1ce0-cc4c-4d0e-9b8f-7dc0b9c2d5b3"
+	g.Expect(getClusterTags(openStackCluster, "bar")).To(Equal([]string{"foo", "capo-cluster-uid=5b6a1ce0-cc4c-4d0e-9b8f-7dc0b9c2d5b3", "bar"}))
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// FixedIPReservationDeviceOwner is the device owner of the ports reserving
+// the fixed IPs of an OpenStackFixedIPPool.
+const FixedIPReservationDeviceOwner = "capo:fixed-ip-reservation"
+
+// ErrNoFreeFixedIP is returned when there is no free address in the IP ranges
+// of an OpenStackFixedIPPool.
+var ErrNoFreeFixedIP = errors.New("no free address in the IP ranges of the pool")
+
+// GetOrReserveFixedIP returns the port with the given name reserving a fixed IP
+// for the pool, and creates it if it does not exist yet.
+func (s *Service) GetOrReserveFixedIP(pool *v1alpha1.OpenStackFixedIPPool, name string) (*ports.Port, error) {
+	portList, err := s.client.ListPort(ports.ListOpts{
+		Name:        name,
+		NetworkID:   pool.Status.Network.ID,
+		DeviceOwner: FixedIPReservationDeviceOwner,
+		Tags:        pool.GetFixedIPTag(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(portList) > 0 {
+		return &portList[0], nil
+	}
+
+	createOpts := getFixedIPReservationCreateOpts(pool, name)
+
+	var port *ports.Port
+	if len(pool.Spec.IPRanges) > 0 {
+		port, err = s.reserveFixedIPInRanges(pool, createOpts)
+	} else {
+		createOpts.FixedIPs = []ports.IP{{SubnetID: pool.Status.Subnet.ID}}
+		port, err = s.client.CreatePort(createOpts)
+	}
+	if err != nil {
+		record.Warnf(pool, "FailedReserveFixedIP", "%s failed to reserve fixed IP: %v", pool.Name, err)
+		return nil, err
+	}
+
+	if err = s.replaceAllAttributesTags(pool, portResource, port.ID, []string{pool.GetFixedIPTag()}); err != nil {
+		record.Warnf(pool, "FailedReplaceTags", "Failed to replace port tags %s: %v", name, err)
+		return nil, err
+	}
+
+	record.Eventf(pool, "SuccessfulReserveFixedIP", "%s reserved fixed IP %s with port %s", pool.Name, GetFixedIPAddress(port), port.ID)
+	return port, nil
+}
+
+// ReserveClaimedFixedIPs reserves the claimed fixed IPs of the pool which are
+// not used by any port anymore, e.g. because the port of the machine they were
+// assigned to has been deleted while they are still claimed. claimedIPs maps
+// each claimed fixed IP to the name of the port reserving it.
+func (s *Service) ReserveClaimedFixedIPs(pool *v1alpha1.OpenStackFixedIPPool, claimedIPs map[string]string) error {
+	if len(claimedIPs) == 0 {
+		return nil
+	}
+
+	portList, err := s.client.ListPort(ports.ListOpts{
+		NetworkID: pool.Status.Network.ID,
+		FixedIPs:  []ports.FixedIPOpts{{SubnetID: pool.Status.Subnet.ID}},
+	})
+	if err != nil {
+		return err
+	}
+	usedAddresses := getUsedAddresses(portList)
+
+	var errs []error
+	for ip, name := range claimedIPs {
+		if addr, err := netip.ParseAddr(ip); err == nil {
+			if _, ok := usedAddresses[addr]; ok {
+				continue
+			}
+		}
+
+		createOpts := getFixedIPReservationCreateOpts(pool, name)
+		createOpts.FixedIPs = []ports.IP{{SubnetID: pool.Status.Subnet.ID, IPAddress: ip}}
+		port, err := s.client.CreatePort(createOpts)
+		if err != nil {
+			record.Warnf(pool, "FailedReserveFixedIP", "%s failed to reserve claimed fixed IP %s again: %v", pool.Name, ip, err)
+			errs = append(errs, err)
+			continue
+		}
+		if err := s.replaceAllAttributesTags(pool, portResource, port.ID, []string{pool.GetFixedIPTag()}); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		record.Eventf(pool, "SuccessfulReserveFixedIP", "%s reserved claimed fixed IP %s again with port %s", pool.Name, ip, port.ID)
+	}
+	return errors.Join(errs...)
+}
+
+// getFixedIPReservationCreateOpts returns the options to create the port with
+// the given name reserving a fixed IP for the pool.
+func getFixedIPReservationCreateOpts(pool *v1alpha1.OpenStackFixedIPPool, name string) ports.CreateOpts {
+	return ports.CreateOpts{
+		Name:        name,
+		NetworkID:   pool.Status.Network.ID,
+		Description: fmt.Sprintf("Created by cluster-api-provider-openstack OpenStackFixedIPPool %s", pool.Name),
+		DeviceOwner: FixedIPReservationDeviceOwner,
+	}
+}
+
+// getUsedAddresses returns the fixed IPs of the given ports.
+func getUsedAddresses(portList []ports.Port) map[netip.Addr]struct{} {
+	usedAddresses := make(map[netip.Addr]struct{}, len(portList))
+	for _, port := range portList {
+		for _, fixedIP := range port.FixedIPs {
+			if addr, err := netip.ParseAddr(fixedIP.IPAddress); err == nil {
+				usedAddresses[addr] = struct{}{}
+			}
+		}
+	}
+	return usedAddresses
+}
+
+// reserveFixedIPInRanges creates a port with the first free address of the IP
+// ranges of the pool. Addresses which are claimed from the pool are not free,
+// even if they are not used by a port.
+func (s *Service) reserveFixedIPInRanges(pool *v1alpha1.OpenStackFixedIPPool, createOpts ports.CreateOpts) (*ports.Port, error) {
+	prefix, err := netip.ParsePrefix(pool.Status.Subnet.CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %q of subnet %s: %w", pool.Status.Subnet.CIDR, pool.Status.Subnet.ID, err)
+	}
+
+	// Skip the addresses of the ports which are known to exist. Addresses
+	// can also be used by ports which are not visible to the project, so
+	// creating a port can still fail because its address is in use.
+	portList, err := s.client.ListPort(ports.ListOpts{
+		NetworkID: pool.Status.Network.ID,
+		FixedIPs:  []ports.FixedIPOpts{{SubnetID: pool.Status.Subnet.ID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	usedAddresses := getUsedAddresses(portList)
+	for _, ip := range pool.Status.ClaimedIPs {
+		if addr, err := netip.ParseAddr(ip); err == nil {
+			usedAddresses[addr] = struct{}{}
+		}
+	}
+
+	attempts := 0
+	for _, ipRange := range pool.Spec.IPRanges {
+		start, err := netip.ParseAddr(ipRange.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP range start %q: %w", ipRange.Start, err)
+		}
+		end, err := netip.ParseAddr(ipRange.End)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP range end %q: %w", ipRange.End, err)
+		}
+		if !prefix.Contains(start) || !prefix.Contains(end) {
+			return nil, fmt.Errorf("IP range %s-%s is not within subnet %s", ipRange.Start, ipRange.End, pool.Status.Subnet.CIDR)
+		}
+
+		for addr := start; addr.IsValid() && addr.Compare(end) <= 0; addr = addr.Next() {
+			if _, ok := usedAddresses[addr]; ok {
+				continue
+			}
+
+			createOpts.FixedIPs = []ports.IP{{SubnetID: pool.Status.Subnet.ID, IPAddress: addr.String()}}
+			port, err := s.client.CreatePort(createOpts)
+			if !capoerrors.IsConflict(err) {
+				return port, err
+			}
+
+			attempts++
+			if attempts >= maxFloatingIPAddressAttempts {
+				return nil, fmt.Errorf("failed to reserve fixed IP after %d attempts: %w", attempts, err)
+			}
+		}
+	}
+	return nil, ErrNoFreeFixedIP
+}
+
+// ReleaseFixedIP deletes the ports reserving the given fixed IP for the pool.
+func (s *Service) ReleaseFixedIP(pool *v1alpha1.OpenStackFixedIPPool, ip string) error {
+	portList, err := s.client.ListPort(ports.ListOpts{
+		DeviceOwner: FixedIPReservationDeviceOwner,
+		Tags:        pool.GetFixedIPTag(),
+		FixedIPs:    []ports.FixedIPOpts{{IPAddress: ip}},
+	})
+	if err != nil {
+		return err
+	}
+	for _, port := range portList {
+		if err := s.DeletePort(pool, port.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseFixedIPReservation deletes the ports reserving the given fixed IP on
+// the network, so that the fixed IP can be assigned to a new port. It returns
+// the deleted ports, so that the reservation can be restored if the fixed IP
+// could not be assigned.
+func (s *Service) releaseFixedIPReservation(eventObject runtime.Object, networkID, ip string) ([]ports.Port, error) {
+	portList, err := s.client.ListPort(ports.ListOpts{
+		NetworkID:   networkID,
+		DeviceOwner: FixedIPReservationDeviceOwner,
+		FixedIPs:    []ports.FixedIPOpts{{IPAddress: ip}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, port := range portList {
+		if err := s.DeletePort(eventObject, port.ID); err != nil {
+			return portList[:i], err
+		}
+	}
+	return portList, nil
+}
+
+// restoreFixedIPReservations recreates the given ports reserving fixed IPs,
+// after the fixed IPs could not be assigned to a new port. Otherwise the
+// fixed IPs would not be reserved for their pool anymore.
+func (s *Service) restoreFixedIPReservations(eventObject runtime.Object, reservations []ports.Port) error {
+	var errs []error
+	for _, reservation := range reservations {
+		fixedIPs := make([]ports.IP, 0, len(reservation.FixedIPs))
+		for _, fixedIP := range reservation.FixedIPs {
+			fixedIPs = append(fixedIPs, ports.IP{SubnetID: fixedIP.SubnetID, IPAddress: fixedIP.IPAddress})
+		}
+		port, err := s.client.CreatePort(ports.CreateOpts{
+			Name:        reservation.Name,
+			NetworkID:   reservation.NetworkID,
+			Description: reservation.Description,
+			DeviceOwner: FixedIPReservationDeviceOwner,
+			FixedIPs:    fixedIPs,
+		})
+		if err != nil {
+			record.Warnf(eventObject, "FailedRestoreFixedIPReservation", "Failed to restore reservation of fixed IP %s: %v", GetFixedIPAddress(&reservation), err)
+			errs = append(errs, err)
+			continue
+		}
+		if len(reservation.Tags) > 0 {
+			if err := s.replaceAllAttributesTags(eventObject, portResource, port.ID, reservation.Tags); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		record.Eventf(eventObject, "SuccessfulRestoreFixedIPReservation", "Restored reservation of fixed IP %s with port %s", GetFixedIPAddress(port), port.ID)
+	}
+	return errors.Join(errs...)
+}
+
+// GetFixedIPAddress returns the first fixed IP address of the port.
+func GetFixedIPAddress(port *ports.Port) string {
+	if len(port.FixedIPs) == 0 {
+		return ""
+	}
+	return port.FixedIPs[0].IPAddress
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_GetOrReserveFixedIP(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	const (
+		networkID   = "network-id"
+		subnetID    = "subnet-id"
+		portName    = "default-test-claim"
+		portID      = "port-id"
+		poolTag     = "cluster-api-provider-openstack-fixed-ip-pool-test-pool"
+		description = "Created by cluster-api-provider-openstack OpenStackFixedIPPool test-pool"
+	)
+	listOpts := ports.ListOpts{
+		Name:        portName,
+		NetworkID:   networkID,
+		DeviceOwner: FixedIPReservationDeviceOwner,
+		Tags:        poolTag,
+	}
+	createOpts := func(fixedIPs ...ports.IP) ports.CreateOpts {
+		return ports.CreateOpts{
+			Name:        portName,
+			NetworkID:   networkID,
+			Description: description,
+			DeviceOwner: FixedIPReservationDeviceOwner,
+			FixedIPs:    fixedIPs,
+		}
+	}
+	reservedPort := func(ip string) *ports.Port {
+		return &ports.Port{ID: portID, FixedIPs: []ports.IP{{SubnetID: subnetID, IPAddress: ip}}}
+	}
+	conflict := gophercloud.ErrDefault409{}
+
+	tests := []struct {
+		name       string
+		ipRanges   []v1alpha1.IPRange
+		claimedIPs []string
+		expect     func(m *mock.MockNetworkClientMockRecorder)
+		want       string
+		wantErr    bool
+	}{
+		{
+			name: "returns the existing reservation",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(listOpts).Return([]ports.Port{*reservedPort("10.0.0.10")}, nil)
+			},
+			want: "10.0.0.10",
+		},
+		{
+			name: "reserves an address from the subnet",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(listOpts).Return([]ports.Port{}, nil)
+				m.CreatePort(createOpts(ports.IP{SubnetID: subnetID})).Return(reservedPort("10.0.0.10"), nil)
+				m.ReplaceAllAttributesTags("ports", portID, attributestags.ReplaceAllOpts{Tags: []string{poolTag}}).Return([]string{poolTag}, nil)
+			},
+			want: "10.0.0.10",
+		},
+		{
+			name:     "reserves the first free address of the IP ranges",
+			ipRanges: []v1alpha1.IPRange{{Start: "10.0.0.100", End: "10.0.0.102"}},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(listOpts).Return([]ports.Port{}, nil)
+				m.ListPort(ports.ListOpts{NetworkID: networkID, FixedIPs: []ports.FixedIPOpts{{SubnetID: subnetID}}}).
+					Return([]ports.Port{*reservedPort("10.0.0.100")}, nil)
+				m.CreatePort(createOpts(ports.IP{SubnetID: subnetID, IPAddress: "10.0.0.101"})).Return(nil, conflict)
+				m.CreatePort(createOpts(ports.IP{SubnetID: subnetID, IPAddress: "10.0.0.102"})).Return(reservedPort("10.0.0.102"), nil)
+				m.ReplaceAllAttributesTags("ports", portID, attributestags.ReplaceAllOpts{Tags: []string{poolTag}}).Return([]string{poolTag}, nil)
+			},
+			want: "10.0.0.102",
+		},
+		{
+			name:       "does not reserve claimed addresses which are not used by a port",
+			ipRanges:   []v1alpha1.IPRange{{Start: "10.0.0.100", End: "10.0.0.102"}},
+			claimedIPs: []string{"10.0.0.100", "10.0.0.101"},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(listOpts).Return([]ports.Port{}, nil)
+				m.ListPort(ports.ListOpts{NetworkID: networkID, FixedIPs: []ports.FixedIPOpts{{SubnetID: subnetID}}}).
+					Return([]ports.Port{*reservedPort("10.0.0.100")}, nil)
+				m.CreatePort(createOpts(ports.IP{SubnetID: subnetID, IPAddress: "10.0.0.102"})).Return(reservedPort("10.0.0.102"), nil)
+				m.ReplaceAllAttributesTags("ports", portID, attributestags.ReplaceAllOpts{Tags: []string{poolTag}}).Return([]string{poolTag}, nil)
+			},
+			want: "10.0.0.102",
+		},
+		{
+			name:     "fails when the IP ranges have no free address",
+			ipRanges: []v1alpha1.IPRange{{Start: "10.0.0.100", End: "10.0.0.100"}},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(listOpts).Return([]ports.Port{}, nil)
+				m.ListPort(ports.ListOpts{NetworkID: networkID, FixedIPs: []ports.FixedIPOpts{{SubnetID: subnetID}}}).
+					Return([]ports.Port{*reservedPort("10.0.0.100")}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:     "fails when an IP range is not within the subnet of the pool",
+			ipRanges: []v1alpha1.IPRange{{Start: "10.0.1.100", End: "10.0.1.110"}},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(listOpts).Return([]ports.Port{}, nil)
+				m.ListPort(ports.ListOpts{NetworkID: networkID, FixedIPs: []ports.FixedIPOpts{{SubnetID: subnetID}}}).
+					Return([]ports.Port{}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			tt.expect(mockClient.EXPECT())
+
+			s := Service{
+				scope:  scope.NewWithLogger(mockScopeFactory, log),
+				client: mockClient,
+			}
+			pool := &v1alpha1.OpenStackFixedIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pool"},
+				Spec:       v1alpha1.OpenStackFixedIPPoolSpec{IPRanges: tt.ipRanges},
+				Status: v1alpha1.OpenStackFixedIPPoolStatus{
+					ClaimedIPs: tt.claimedIPs,
+					Network:    &infrav1alpha7.NetworkStatus{ID: networkID},
+					Subnet:     &infrav1alpha7.Subnet{ID: subnetID, CIDR: "10.0.0.0/24"},
+				},
+			}
+			got, err := s.GetOrReserveFixedIP(pool, portName)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(GetFixedIPAddress(got)).To(Equal(tt.want))
+			}
+		})
+	}
+}
+
+func Test_ReserveClaimedFixedIPs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	const (
+		networkID   = "network-id"
+		subnetID    = "subnet-id"
+		portID      = "port-id"
+		poolTag     = "cluster-api-provider-openstack-fixed-ip-pool-test-pool"
+		description = "Created by cluster-api-provider-openstack OpenStackFixedIPPool test-pool"
+	)
+	listOpts := ports.ListOpts{NetworkID: networkID, FixedIPs: []ports.FixedIPOpts{{SubnetID: subnetID}}}
+	usedPort := func(ip string) ports.Port {
+		return ports.Port{ID: "used", FixedIPs: []ports.IP{{SubnetID: subnetID, IPAddress: ip}}}
+	}
+
+	tests := []struct {
+		name       string
+		claimedIPs map[string]string
+		expect     func(m *mock.MockNetworkClientMockRecorder)
+		wantErr    bool
+	}{
+		{
+			name:   "does nothing without claimed IPs",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {},
+		},
+		{
+			name:       "does nothing if the claimed IPs are used",
+			claimedIPs: map[string]string{"10.0.0.10": "default-claim"},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(listOpts).Return([]ports.Port{usedPort("10.0.0.10")}, nil)
+			},
+		},
+		{
+			name:       "reserves the claimed IPs which are not used",
+			claimedIPs: map[string]string{"10.0.0.10": "default-claim", "10.0.0.11": "default-other-claim"},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(listOpts).Return([]ports.Port{usedPort("10.0.0.10")}, nil)
+				m.CreatePort(ports.CreateOpts{
+					Name:        "default-other-claim",
+					NetworkID:   networkID,
+					Description: description,
+					DeviceOwner: FixedIPReservationDeviceOwner,
+					FixedIPs:    []ports.IP{{SubnetID: subnetID, IPAddress: "10.0.0.11"}},
+				}).Return(&ports.Port{ID: portID}, nil)
+				m.ReplaceAllAttributesTags("ports", portID, attributestags.ReplaceAllOpts{Tags: []string{poolTag}}).Return([]string{poolTag}, nil)
+			},
+		},
+		{
+			name:       "fails if a claimed IP cannot be reserved",
+			claimedIPs: map[string]string{"10.0.0.11": "default-claim"},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(listOpts).Return([]ports.Port{}, nil)
+				m.CreatePort(gomock.Any()).Return(nil, gophercloud.ErrDefault409{})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			tt.expect(mockClient.EXPECT())
+
+			s := Service{
+				scope:  scope.NewWithLogger(scope.NewMockScopeFactory(mockCtrl, ""), testr.New(t)),
+				client: mockClient,
+			}
+			pool := &v1alpha1.OpenStackFixedIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pool"},
+				Status: v1alpha1.OpenStackFixedIPPoolStatus{
+					Network: &infrav1alpha7.NetworkStatus{ID: networkID},
+					Subnet:  &infrav1alpha7.Subnet{ID: subnetID, CIDR: "10.0.0.0/24"},
+				},
+			}
+			err := s.ReserveClaimedFixedIPs(pool, tt.claimedIPs)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
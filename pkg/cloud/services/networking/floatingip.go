@@ -17,7 +17,9 @@ limitations under the License.
 package networking
 
 import (
+	"errors"
 	"fmt"
+	"net/netip"
 	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
@@ -26,10 +28,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/pointer"
 
-	"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
 
@@ -75,22 +78,145 @@ func (s *Service) GetOrCreateFloatingIP(eventObject runtime.Object, openStackClu
 	return fp, nil
 }
 
-func (s *Service) CreateFloatingIPForPool(pool *v1alpha1.OpenStackFloatingIPPool) (*floatingips.FloatingIP, error) {
+// ErrFloatingIPAddressForbidden is returned when the policy of the cloud does
+// not allow to choose the address of a floating IP, which is required to
+// allocate floating IPs from the IP ranges of a pool. By default, only admins
+// are allowed to do so.
+var ErrFloatingIPAddressForbidden = errors.New("not allowed to create a floating IP with a specific address, which requires admin credentials by default")
+
+// maxFloatingIPAddressAttempts is the maximum number of addresses which are
+// tried when creating a floating IP in the IP ranges of a pool.
+const maxFloatingIPAddressAttempts = 16
+
+// CreateFloatingIPForPool creates a floating IP for the pool. The floating IP
+// is allocated from the subnets and the IP ranges of the pool, if any.
+func (s *Service) CreateFloatingIPForPool(pool infrav1alpha2.FloatingIPPool) (*floatingips.FloatingIP, error) {
 	var fpCreateOpts floatingips.CreateOpts
 
-	fpCreateOpts.FloatingNetworkID = pool.Status.FloatingIPNetwork.ID
-	fpCreateOpts.Description = fmt.Sprintf("Created by cluster-api-provider-openstack OpenStackFloatingIPPool %s", pool.Name)
+	fpCreateOpts.FloatingNetworkID = pool.GetFloatingIPPoolStatus().FloatingIPNetwork.ID
+	fpCreateOpts.Description = fmt.Sprintf("Created by cluster-api-provider-openstack %s %s", getFloatingIPPoolKind(pool), pool.GetName())
 
-	fp, err := s.client.CreateFloatingIP(fpCreateOpts)
+	var fp *floatingips.FloatingIP
+	var err error
+	switch {
+	case len(pool.GetFloatingIPPoolSpec().IPRanges) > 0:
+		fp, err = s.createFloatingIPInRanges(pool, fpCreateOpts)
+	case len(pool.GetFloatingIPPoolStatus().FloatingIPSubnets) > 0:
+		fp, err = s.createFloatingIPInSubnets(pool, fpCreateOpts)
+	default:
+		fp, err = s.client.CreateFloatingIP(fpCreateOpts)
+	}
 	if err != nil {
-		record.Warnf(pool, "FailedCreateFloatingIP", "%s failed to create floating IP: %v", pool.Name, err)
+		record.Warnf(pool, "FailedCreateFloatingIP", "%s failed to create floating IP: %v", pool.GetName(), err)
 		return nil, err
 	}
 
-	record.Eventf(pool, "SuccessfulCreateFloatingIP", "%s created floating IP %s with id %s", pool.Name, fp.FloatingIP, fp.ID)
+	record.Eventf(pool, "SuccessfulCreateFloatingIP", "%s created floating IP %s with id %s", pool.GetName(), fp.FloatingIP, fp.ID)
 	return fp, nil
 }
 
+// getFloatingIPPoolKind returns the kind of the pool, which is not always set
+// in the TypeMeta of typed objects.
+func getFloatingIPPoolKind(pool infrav1alpha2.FloatingIPPool) string {
+	if _, ok := pool.(*infrav1alpha2.OpenStackClusterFloatingIPPool); ok {
+		return "OpenStackClusterFloatingIPPool"
+	}
+	return "OpenStackFloatingIPPool"
+}
+
+// createFloatingIPInSubnets creates a floating IP in the first subnet of the
+// pool which has a free address.
+func (s *Service) createFloatingIPInSubnets(pool infrav1alpha2.FloatingIPPool, fpCreateOpts floatingips.CreateOpts) (*floatingips.FloatingIP, error) {
+	var err error
+	for _, subnet := range pool.GetFloatingIPPoolStatus().FloatingIPSubnets {
+		fpCreateOpts.SubnetID = subnet.ID
+
+		var fp *floatingips.FloatingIP
+		fp, err = s.client.CreateFloatingIP(fpCreateOpts)
+		// Neutron returns a conflict if the subnet has no free address
+		if !capoerrors.IsConflict(err) {
+			return fp, err
+		}
+	}
+	return nil, err
+}
+
+// createFloatingIPInRanges creates a floating IP with the first free address
+// of the IP ranges of the pool.
+func (s *Service) createFloatingIPInRanges(pool infrav1alpha2.FloatingIPPool, fpCreateOpts floatingips.CreateOpts) (*floatingips.FloatingIP, error) {
+	// Skip the addresses of the floating IPs which are known to exist.
+	// Floating IPs of other projects are not listed, so creating a floating
+	// IP can still fail because its address is in use.
+	fpList, err := s.client.ListFloatingIP(floatingips.ListOpts{FloatingNetworkID: fpCreateOpts.FloatingNetworkID})
+	if err != nil {
+		return nil, err
+	}
+	usedAddresses := make(map[netip.Addr]struct{}, len(fpList))
+	for _, fp := range fpList {
+		if addr, err := netip.ParseAddr(fp.FloatingIP); err == nil {
+			usedAddresses[addr] = struct{}{}
+		}
+	}
+
+	attempts := 0
+	for _, ipRange := range pool.GetFloatingIPPoolSpec().IPRanges {
+		start, end, subnetID, err := getFloatingIPRange(pool.GetFloatingIPPoolStatus().FloatingIPSubnets, ipRange)
+		if err != nil {
+			return nil, err
+		}
+		fpCreateOpts.SubnetID = subnetID
+
+		for addr := start; addr.IsValid() && addr.Compare(end) <= 0; addr = addr.Next() {
+			if _, ok := usedAddresses[addr]; ok {
+				continue
+			}
+
+			fpCreateOpts.FloatingIP = addr.String()
+			fp, err := s.client.CreateFloatingIP(fpCreateOpts)
+			if capoerrors.IsForbidden(err) {
+				return nil, fmt.Errorf("%w: %w", ErrFloatingIPAddressForbidden, err)
+			}
+			if !capoerrors.IsConflict(err) {
+				return fp, err
+			}
+
+			attempts++
+			if attempts >= maxFloatingIPAddressAttempts {
+				return nil, fmt.Errorf("failed to create floating IP after %d attempts: %w", attempts, err)
+			}
+		}
+	}
+	return nil, fmt.Errorf("no free address in the IP ranges of the pool")
+}
+
+// getFloatingIPRange returns the first and the last address of the IP range,
+// and the ID of the subnet of the pool containing it. The subnet ID is empty if
+// the pool is not restricted to specific subnets.
+func getFloatingIPRange(poolSubnets []infrav1.Subnet, ipRange infrav1alpha2.IPRange) (netip.Addr, netip.Addr, string, error) {
+	start, err := netip.ParseAddr(ipRange.Start)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, "", fmt.Errorf("invalid IP range start %q: %w", ipRange.Start, err)
+	}
+	end, err := netip.ParseAddr(ipRange.End)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, "", fmt.Errorf("invalid IP range end %q: %w", ipRange.End, err)
+	}
+
+	if len(poolSubnets) == 0 {
+		return start, end, "", nil
+	}
+	for _, subnet := range poolSubnets {
+		prefix, err := netip.ParsePrefix(subnet.CIDR)
+		if err != nil {
+			continue
+		}
+		if prefix.Contains(start) && prefix.Contains(end) {
+			return start, end, subnet.ID, nil
+		}
+	}
+	return netip.Addr{}, netip.Addr{}, "", fmt.Errorf("IP range %s-%s is not within a subnet of the pool", ipRange.Start, ipRange.End)
+}
+
 func (s *Service) TagFloatingIP(ip string, tag string) error {
 	fip, err := s.GetFloatingIP(ip)
 	if err != nil {
@@ -129,6 +255,23 @@ func (s *Service) GetFloatingIP(ip string) (*floatingips.FloatingIP, error) {
 	return &fpList[0], nil
 }
 
+// GetFloatingIPQuotaRemaining returns the number of floating IPs which can
+// still be created in the project, or nil if the quota is unlimited.
+func (s *Service) GetFloatingIPQuotaRemaining() (*int, error) {
+	quota, err := s.client.GetQuotaDetail(s.scope.ProjectID())
+	if err != nil {
+		return nil, err
+	}
+	if quota.FloatingIP.Limit < 0 {
+		return nil, nil
+	}
+	remaining := quota.FloatingIP.Limit - quota.FloatingIP.Used - quota.FloatingIP.Reserved
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &remaining, nil
+}
+
 func (s *Service) GetFloatingIPByPortID(portID string) (*floatingips.FloatingIP, error) {
 	fpList, err := s.client.ListFloatingIP(floatingips.ListOpts{PortID: portID})
 	if err != nil {
@@ -21,10 +21,14 @@ import (
 
 	"github.com/go-logr/logr/testr"
 	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
@@ -92,3 +96,171 @@ func Test_GetOrCreateFloatingIP(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetFloatingIPQuotaRemaining(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name  string
+		quota quotas.QuotaDetail
+		want  *int
+	}{
+		{
+			name:  "returns the remaining floating IPs",
+			quota: quotas.QuotaDetail{Limit: 10, Used: 4, Reserved: 1},
+			want:  pointer.Int(5),
+		},
+		{
+			name:  "returns zero when the quota is exceeded",
+			quota: quotas.QuotaDetail{Limit: 2, Used: 3},
+			want:  pointer.Int(0),
+		},
+		{
+			name:  "returns nil when the quota is unlimited",
+			quota: quotas.QuotaDetail{Limit: -1, Used: 3},
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "project-id")
+			mockClient.EXPECT().GetQuotaDetail("project-id").Return(&quotas.QuotaDetailSet{FloatingIP: tt.quota}, nil)
+
+			s := Service{
+				scope:  scope.NewWithLogger(mockScopeFactory, log),
+				client: mockClient,
+			}
+			got, err := s.GetFloatingIPQuotaRemaining()
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_CreateFloatingIPForPool(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	const (
+		networkID   = "external-network-id"
+		description = "Created by cluster-api-provider-openstack OpenStackFloatingIPPool test-pool"
+	)
+	subnets := []infrav1.Subnet{
+		{ID: "public-subnet-id", CIDR: "203.0.113.0/26"},
+		{ID: "partner-subnet-id", CIDR: "198.51.100.0/24"},
+	}
+	conflict := gophercloud.ErrDefault409{}
+	forbidden := gophercloud.ErrDefault403{}
+
+	tests := []struct {
+		name      string
+		ipRanges  []infrav1alpha2.IPRange
+		subnets   []infrav1.Subnet
+		expect    func(m *mock.MockNetworkClientMockRecorder)
+		want      *floatingips.FloatingIP
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name: "creates floating IP on the network",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.CreateFloatingIP(floatingips.CreateOpts{FloatingNetworkID: networkID, Description: description}).
+					Return(&floatingips.FloatingIP{FloatingIP: "192.0.2.10"}, nil)
+			},
+			want: &floatingips.FloatingIP{FloatingIP: "192.0.2.10"},
+		},
+		{
+			name:    "creates floating IP in the next subnet when a subnet is full",
+			subnets: subnets,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.CreateFloatingIP(floatingips.CreateOpts{FloatingNetworkID: networkID, Description: description, SubnetID: "public-subnet-id"}).
+					Return(nil, conflict)
+				m.CreateFloatingIP(floatingips.CreateOpts{FloatingNetworkID: networkID, Description: description, SubnetID: "partner-subnet-id"}).
+					Return(&floatingips.FloatingIP{FloatingIP: "198.51.100.10"}, nil)
+			},
+			want: &floatingips.FloatingIP{FloatingIP: "198.51.100.10"},
+		},
+		{
+			name:     "creates floating IP with the first free address of the IP ranges",
+			ipRanges: []infrav1alpha2.IPRange{{Start: "203.0.113.10", End: "203.0.113.12"}},
+			subnets:  subnets,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListFloatingIP(floatingips.ListOpts{FloatingNetworkID: networkID}).
+					Return([]floatingips.FloatingIP{{FloatingIP: "203.0.113.10"}}, nil)
+				m.CreateFloatingIP(floatingips.CreateOpts{FloatingNetworkID: networkID, Description: description, SubnetID: "public-subnet-id", FloatingIP: "203.0.113.11"}).
+					Return(nil, conflict)
+				m.CreateFloatingIP(floatingips.CreateOpts{FloatingNetworkID: networkID, Description: description, SubnetID: "public-subnet-id", FloatingIP: "203.0.113.12"}).
+					Return(&floatingips.FloatingIP{FloatingIP: "203.0.113.12"}, nil)
+			},
+			want: &floatingips.FloatingIP{FloatingIP: "203.0.113.12"},
+		},
+		{
+			name:     "fails when the IP ranges have no free address",
+			ipRanges: []infrav1alpha2.IPRange{{Start: "203.0.113.10", End: "203.0.113.10"}},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListFloatingIP(floatingips.ListOpts{FloatingNetworkID: networkID}).
+					Return([]floatingips.FloatingIP{{FloatingIP: "203.0.113.10"}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:     "fails when the address of a floating IP cannot be chosen",
+			ipRanges: []infrav1alpha2.IPRange{{Start: "203.0.113.10", End: "203.0.113.12"}},
+			subnets:  subnets,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListFloatingIP(floatingips.ListOpts{FloatingNetworkID: networkID}).
+					Return([]floatingips.FloatingIP{}, nil)
+				m.CreateFloatingIP(floatingips.CreateOpts{FloatingNetworkID: networkID, Description: description, SubnetID: "public-subnet-id", FloatingIP: "203.0.113.10"}).
+					Return(nil, forbidden)
+			},
+			wantErr:   true,
+			wantErrIs: ErrFloatingIPAddressForbidden,
+		},
+		{
+			name:     "fails when an IP range is not within a subnet of the pool",
+			ipRanges: []infrav1alpha2.IPRange{{Start: "203.0.113.60", End: "203.0.113.70"}},
+			subnets:  subnets,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListFloatingIP(floatingips.ListOpts{FloatingNetworkID: networkID}).
+					Return([]floatingips.FloatingIP{}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := testr.New(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			tt.expect(mockClient.EXPECT())
+
+			s := Service{
+				scope:  scope.NewWithLogger(mockScopeFactory, log),
+				client: mockClient,
+			}
+			pool := &infrav1alpha2.OpenStackFloatingIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pool"},
+				Spec:       infrav1alpha2.OpenStackFloatingIPPoolSpec{IPRanges: tt.ipRanges},
+				Status: infrav1alpha2.OpenStackFloatingIPPoolStatus{
+					FloatingIPNetwork: &infrav1.NetworkStatus{ID: networkID},
+					FloatingIPSubnets: tt.subnets,
+				},
+			}
+			got, err := s.CreateFloatingIPForPool(pool)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				if tt.wantErrIs != nil {
+					g.Expect(err).To(MatchError(tt.wantErrIs))
+				}
+			} else {
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(got).To(Equal(tt.want))
+			}
+		})
+	}
+}
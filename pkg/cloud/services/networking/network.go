@@ -18,10 +18,15 @@ package networking
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/mtu"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 	"k8s.io/utils/pointer"
@@ -62,10 +67,15 @@ func (e noMatchesError) Is(err error) bool {
 }
 
 type createOpts struct {
-	AdminStateUp        *bool  `json:"admin_state_up,omitempty"`
-	Name                string `json:"name,omitempty"`
-	PortSecurityEnabled *bool  `json:"port_security_enabled,omitempty"`
-	MTU                 *int   `json:"mtu,omitempty"`
+	AdminStateUp          *bool    `json:"admin_state_up,omitempty"`
+	Name                  string   `json:"name,omitempty"`
+	PortSecurityEnabled   *bool    `json:"port_security_enabled,omitempty"`
+	MTU                   *int     `json:"mtu,omitempty"`
+	NetworkType           string   `json:"provider:network_type,omitempty"`
+	PhysicalNetwork       string   `json:"provider:physical_network,omitempty"`
+	SegmentationID        *int     `json:"provider:segmentation_id,omitempty"`
+	AvailabilityZoneHints []string `json:"availability_zone_hints,omitempty"`
+	DNSDomain             string   `json:"dns_domain,omitempty"`
 }
 
 func (c createOpts) ToNetworkCreateMap() (map[string]interface{}, error) {
@@ -117,10 +127,13 @@ func (s *Service) ReconcileExternalNetwork(openStackCluster *infrav1.OpenStackCl
 }
 
 func (s *Service) ReconcileNetwork(openStackCluster *infrav1.OpenStackCluster, clusterName string) error {
-	networkName := getNetworkName(clusterName)
+	networkName, err := getClusterNetworkName(openStackCluster, clusterName)
+	if err != nil {
+		return err
+	}
 	s.scope.Logger().Info("Reconciling network", "name", networkName)
 
-	res, err := s.getNetworkByName(networkName)
+	res, err := s.getClusterNetwork(openStackCluster, networkName)
 	if err != nil {
 		return err
 	}
@@ -132,7 +145,7 @@ func (s *Service) ReconcileNetwork(openStackCluster *infrav1.OpenStackCluster, c
 		openStackCluster.Status.Network.Name = res.Name
 		openStackCluster.Status.Network.Tags = res.Tags
 		s.scope.Logger().V(6).Info("Reusing existing network", "name", res.Name, "id", res.ID)
-		return nil
+		return s.reconcileNetworkMTU(openStackCluster, &res)
 	}
 
 	opts := createOpts{
@@ -148,6 +161,21 @@ func (s *Service) ReconcileNetwork(openStackCluster *infrav1.OpenStackCluster, c
 		opts.MTU = openStackCluster.Spec.NetworkMTU
 	}
 
+	if managedNetwork := openStackCluster.Spec.ManagedNetwork; managedNetwork != nil {
+		if err := s.validateManagedNetworkExtensions(managedNetwork); err != nil {
+			record.Warnf(openStackCluster, "FailedCreateNetwork", "Failed to create network %s: %v", networkName, err)
+			return err
+		}
+
+		if managedNetwork.Provider != nil {
+			opts.NetworkType = managedNetwork.Provider.NetworkType
+			opts.PhysicalNetwork = managedNetwork.Provider.PhysicalNetwork
+			opts.SegmentationID = managedNetwork.Provider.SegmentationID
+		}
+		opts.AvailabilityZoneHints = managedNetwork.AvailabilityZoneHints
+		opts.DNSDomain = managedNetwork.DNSDomain
+	}
+
 	network, err := s.client.CreateNetwork(opts)
 	if err != nil {
 		record.Warnf(openStackCluster, "FailedCreateNetwork", "Failed to create network %s: %v", networkName, err)
@@ -155,9 +183,9 @@ func (s *Service) ReconcileNetwork(openStackCluster *infrav1.OpenStackCluster, c
 	}
 	record.Eventf(openStackCluster, "SuccessfulCreateNetwork", "Created network %s with id %s", networkName, network.ID)
 
-	if len(openStackCluster.Spec.Tags) > 0 {
+	if tags := getClusterTags(openStackCluster); len(tags) > 0 {
 		_, err = s.client.ReplaceAllAttributesTags("networks", network.ID, attributestags.ReplaceAllOpts{
-			Tags: openStackCluster.Spec.Tags,
+			Tags: tags,
 		})
 		if err != nil {
 			return err
@@ -171,9 +199,84 @@ func (s *Service) ReconcileNetwork(openStackCluster *infrav1.OpenStackCluster, c
 	return nil
 }
 
+// validateManagedNetworkExtensions returns an error if the managed network
+// options require a Neutron API extension which is not available.
+func (s *Service) validateManagedNetworkExtensions(managedNetwork *infrav1.ManagedNetworkOptions) error {
+	var required []string
+	if managedNetwork.Provider != nil {
+		required = append(required, "provider")
+	}
+	if len(managedNetwork.AvailabilityZoneHints) > 0 {
+		required = append(required, "network_availability_zone")
+	}
+	if managedNetwork.DNSDomain != "" {
+		required = append(required, "dns-integration")
+	}
+
+	missing, err := s.getUnsupportedExtensions(required)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("managedNetwork requires the unsupported neutron API extensions: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// getUnsupportedExtensions returns the aliases of the required Neutron API
+// extensions which are not available. It does not query Neutron if no
+// extension is required.
+func (s *Service) getUnsupportedExtensions(required []string) ([]string, error) {
+	if len(required) == 0 {
+		return nil, nil
+	}
+
+	allExts, err := s.client.ListExtensions()
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, alias := range required {
+		if !slices.ContainsFunc(allExts, func(ext extensions.Extension) bool { return ext.Alias == alias }) {
+			missing = append(missing, alias)
+		}
+	}
+	return missing, nil
+}
+
+// reconcileNetworkMTU updates the MTU of an existing network if it differs from the spec.
+func (s *Service) reconcileNetworkMTU(openStackCluster *infrav1.OpenStackCluster, network *networks.Network) error {
+	if openStackCluster.Spec.NetworkMTU == nil {
+		return nil
+	}
+
+	networkExt, err := s.client.GetNetworkExt(network.ID)
+	if err != nil {
+		return err
+	}
+	if networkExt.MTU == *openStackCluster.Spec.NetworkMTU {
+		return nil
+	}
+
+	_, err = s.client.UpdateNetwork(network.ID, mtu.UpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{},
+		MTU:               *openStackCluster.Spec.NetworkMTU,
+	})
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateNetwork", "Failed to update MTU of network %s with id %s from %d to %d: %v", network.Name, network.ID, networkExt.MTU, *openStackCluster.Spec.NetworkMTU, err)
+		return err
+	}
+	record.Eventf(openStackCluster, "SuccessfulUpdateNetwork", "Updated MTU of network %s with id %s from %d to %d", network.Name, network.ID, networkExt.MTU, *openStackCluster.Spec.NetworkMTU)
+	return nil
+}
+
 func (s *Service) DeleteNetwork(openStackCluster *infrav1.OpenStackCluster, clusterName string) error {
-	networkName := getNetworkName(clusterName)
-	network, err := s.getNetworkByName(networkName)
+	networkName, err := getClusterNetworkName(openStackCluster, clusterName)
+	if err != nil {
+		return err
+	}
+	network, err := s.getClusterNetwork(openStackCluster, networkName)
 	if err != nil {
 		return err
 	}
@@ -197,33 +300,31 @@ func (s *Service) ReconcileSubnet(openStackCluster *infrav1.OpenStackCluster, cl
 		return nil
 	}
 
-	subnetName := getSubnetName(clusterName)
-	s.scope.Logger().Info("Reconciling subnet", "name", subnetName)
-
-	subnetList, err := s.client.ListSubnet(subnets.ListOpts{
-		NetworkID: openStackCluster.Status.Network.ID,
-		// Currently we only support 1 SubnetSpec.
-		CIDR: openStackCluster.Spec.ManagedSubnets[0].CIDR,
-	})
+	subnetName, err := getClusterSubnetName(openStackCluster, clusterName)
 	if err != nil {
 		return err
 	}
+	s.scope.Logger().Info("Reconciling subnet", "name", subnetName)
 
-	if len(subnetList) > 1 {
-		return fmt.Errorf("found %d subnets with the CIDR %s and network %s, which should not happen",
-			len(subnetList), openStackCluster.Spec.ManagedSubnets[0], openStackCluster.Status.Network.ID)
+	// The subnet is not looked up by CIDR as it may have been allocated from a subnet pool
+	existingSubnet, err := s.getClusterSubnet(openStackCluster, openStackCluster.Status.Network.ID, subnetName)
+	if err != nil {
+		return err
 	}
 
 	var subnet *subnets.Subnet
-	if len(subnetList) == 0 {
-		var err error
+	if existingSubnet.ID == "" {
 		subnet, err = s.createSubnet(openStackCluster, clusterName, subnetName)
 		if err != nil {
 			return err
 		}
-	} else if len(subnetList) == 1 {
-		subnet = &subnetList[0]
-		s.scope.Logger().V(6).Info("Reusing existing subnet", "name", subnet.Name, "id", subnet.ID)
+	} else {
+		s.scope.Logger().V(6).Info("Reusing existing subnet", "name", existingSubnet.Name, "id", existingSubnet.ID)
+
+		subnet, err = s.reconcileSubnetSpec(openStackCluster, &existingSubnet, &openStackCluster.Spec.ManagedSubnets[0])
+		if err != nil {
+			return err
+		}
 	}
 
 	openStackCluster.Status.Network.Subnets = []infrav1.Subnet{
@@ -238,16 +339,29 @@ func (s *Service) ReconcileSubnet(openStackCluster *infrav1.OpenStackCluster, cl
 }
 
 func (s *Service) createSubnet(openStackCluster *infrav1.OpenStackCluster, clusterName string, name string) (*subnets.Subnet, error) {
+	// Currently we only support 1 SubnetSpec.
+	subnetSpec := &openStackCluster.Spec.ManagedSubnets[0]
+
 	opts := subnets.CreateOpts{
 		NetworkID:      openStackCluster.Status.Network.ID,
 		Name:           name,
 		IPVersion:      4,
-		CIDR:           openStackCluster.Spec.ManagedSubnets[0].CIDR,
-		DNSNameservers: openStackCluster.Spec.ManagedSubnets[0].DNSNameservers,
+		CIDR:           subnetSpec.CIDR,
+		DNSNameservers: subnetSpec.DNSNameservers,
 		Description:    names.GetDescription(clusterName),
 	}
 
-	for _, pool := range openStackCluster.Spec.ManagedSubnets[0].AllocationPools {
+	if subnetSpec.SubnetPool != nil {
+		subnetPool, err := s.GetSubnetPoolByFilter(subnetSpec.SubnetPool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get subnet pool: %w", err)
+		}
+		opts.SubnetPoolID = subnetPool.ID
+		opts.IPVersion = gophercloud.IPVersion(subnetPool.IPversion)
+		opts.Prefixlen = pointer.IntDeref(subnetSpec.PrefixLength, 0)
+	}
+
+	for _, pool := range subnetSpec.AllocationPools {
 		opts.AllocationPools = append(opts.AllocationPools, subnets.AllocationPool{Start: pool.Start, End: pool.End})
 	}
 
@@ -258,10 +372,10 @@ func (s *Service) createSubnet(openStackCluster *infrav1.OpenStackCluster, clust
 	}
 	record.Eventf(openStackCluster, "SuccessfulCreateSubnet", "Created subnet %s with id %s", name, subnet.ID)
 
-	if len(openStackCluster.Spec.Tags) > 0 {
+	if tags := getClusterTags(openStackCluster); len(tags) > 0 {
 		mc := metrics.NewMetricPrometheusContext("subnet", "update")
 		_, err = s.client.ReplaceAllAttributesTags("subnets", subnet.ID, attributestags.ReplaceAllOpts{
-			Tags: openStackCluster.Spec.Tags,
+			Tags: tags,
 		})
 		if mc.ObserveRequest(err) != nil {
 			return nil, err
@@ -271,23 +385,96 @@ func (s *Service) createSubnet(openStackCluster *infrav1.OpenStackCluster, clust
 	return subnet, nil
 }
 
-func (s *Service) getNetworkByName(networkName string) (networks.Network, error) {
-	opts := networks.ListOpts{
-		Name: networkName,
+// reconcileSubnetSpec updates the DNS nameservers and allocation pools of an existing subnet if they
+// differ from the spec. Allocation pools are left unchanged if none are specified.
+func (s *Service) reconcileSubnetSpec(openStackCluster *infrav1.OpenStackCluster, subnet *subnets.Subnet, subnetSpec *infrav1.SubnetSpec) (*subnets.Subnet, error) {
+	var opts subnets.UpdateOpts
+	var drift []string
+
+	dnsNameservers := subnetSpec.DNSNameservers
+	if dnsNameservers == nil {
+		dnsNameservers = []string{}
+	}
+	if !slices.Equal(subnet.DNSNameservers, dnsNameservers) {
+		opts.DNSNameservers = &dnsNameservers
+		drift = append(drift, fmt.Sprintf("dnsNameservers %v to %v", subnet.DNSNameservers, dnsNameservers))
 	}
 
-	networkList, err := s.client.ListNetwork(opts)
+	if len(subnetSpec.AllocationPools) > 0 {
+		allocationPools := make([]subnets.AllocationPool, len(subnetSpec.AllocationPools))
+		for i, pool := range subnetSpec.AllocationPools {
+			allocationPools[i] = subnets.AllocationPool{Start: pool.Start, End: pool.End}
+		}
+		if !equalAllocationPools(subnet.AllocationPools, allocationPools) {
+			opts.AllocationPools = allocationPools
+			drift = append(drift, fmt.Sprintf("allocationPools %v to %v", subnet.AllocationPools, allocationPools))
+		}
+	}
+
+	if len(drift) == 0 {
+		return subnet, nil
+	}
+
+	updated, err := s.client.UpdateSubnet(subnet.ID, opts)
 	if err != nil {
-		return networks.Network{}, err
+		record.Warnf(openStackCluster, "FailedUpdateSubnet", "Failed to update subnet %s with id %s: %s: %v", subnet.Name, subnet.ID, strings.Join(drift, ", "), err)
+		return nil, err
 	}
+	record.Eventf(openStackCluster, "SuccessfulUpdateSubnet", "Updated subnet %s with id %s: %s", subnet.Name, subnet.ID, strings.Join(drift, ", "))
+	return updated, nil
+}
 
-	switch len(networkList) {
-	case 0:
-		return networks.Network{}, nil
-	case 1:
-		return networkList[0], nil
+// equalAllocationPools returns true if both lists contain the same allocation pools, in any order.
+func equalAllocationPools(a, b []subnets.AllocationPool) bool {
+	compare := func(x, y subnets.AllocationPool) int {
+		return strings.Compare(x.Start+"-"+x.End, y.Start+"-"+y.End)
+	}
+	a = slices.Clone(a)
+	b = slices.Clone(b)
+	slices.SortFunc(a, compare)
+	slices.SortFunc(b, compare)
+	return slices.Equal(a, b)
+}
+
+// GetSubnetPoolByFilter gets a single subnet pool specified by the given SubnetPoolFilter.
+// It returns an ErrFilterMatch if no or multiple subnet pools are found.
+func (s *Service) GetSubnetPoolByFilter(filter *infrav1.SubnetPoolFilter) (*subnetpools.SubnetPool, error) {
+	subnetPools, err := s.client.ListSubnetPool(filterconvert.SubnetPoolFilterToListOpts(filter))
+	if err != nil {
+		return nil, err
+	}
+	if len(subnetPools) == 0 {
+		return nil, ErrNoMatches
+	}
+	if len(subnetPools) > 1 {
+		return nil, ErrMultipleMatches
 	}
-	return networks.Network{}, fmt.Errorf("found %d networks with the name %s, which should not happen", len(networkList), networkName)
+	return &subnetPools[0], nil
+}
+
+// getClusterNetwork returns the network created for the cluster, or an empty network if it does not exist.
+func (s *Service) getClusterNetwork(openStackCluster *infrav1.OpenStackCluster, networkName string) (networks.Network, error) {
+	return getClusterResource(s, openStackCluster, "networks", networkName, nil,
+		func(tags, name string) ([]networks.Network, error) {
+			return s.client.ListNetwork(networks.ListOpts{Name: name, Tags: tags})
+		},
+		func(network networks.Network) (string, []string) {
+			return network.ID, network.Tags
+		},
+	)
+}
+
+// getClusterSubnet returns the subnet created for the cluster, or an empty subnet if it does not exist.
+// If networkID is set, only subnets of the network are considered.
+func (s *Service) getClusterSubnet(openStackCluster *infrav1.OpenStackCluster, networkID, subnetName string) (subnets.Subnet, error) {
+	return getClusterResource(s, openStackCluster, "subnets", subnetName, nil,
+		func(tags, name string) ([]subnets.Subnet, error) {
+			return s.client.ListSubnet(subnets.ListOpts{NetworkID: networkID, Name: name, Tags: tags})
+		},
+		func(subnet subnets.Subnet) (string, []string) {
+			return subnet.ID, subnet.Tags
+		},
+	)
 }
 
 // GetNetworksByFilter retrieves networks by querying openstack with filters.
@@ -382,6 +569,16 @@ func getNetworkName(clusterName string) string {
 	return fmt.Sprintf("%s-cluster-%s", networkPrefix, clusterName)
 }
 
+// getClusterNetworkName returns the name of the network created for the cluster.
+func getClusterNetworkName(openStackCluster *infrav1.OpenStackCluster, clusterName string) (string, error) {
+	return names.GetResourceName(names.GetResourceNaming(openStackCluster).Network, names.GetClusterResourceNameData(openStackCluster), getNetworkName(clusterName))
+}
+
+// getClusterSubnetName returns the name of the subnet created for the cluster.
+func getClusterSubnetName(openStackCluster *infrav1.OpenStackCluster, clusterName string) (string, error) {
+	return names.GetResourceName(names.GetResourceNaming(openStackCluster).Subnet, names.GetClusterResourceNameData(openStackCluster), getSubnetName(clusterName))
+}
+
 // GetNetworkByID retrieves network by the ID.
 func (s *Service) GetNetworkByID(networkID string) (*networks.Network, error) {
 	network, err := s.client.GetNetwork(networkID)
@@ -22,13 +22,18 @@ import (
 	"github.com/go-logr/logr/testr"
 	"github.com/golang/mock/gomock"
 	"github.com/gophercloud/gophercloud"
+	common "github.com/gophercloud/gophercloud/openstack/common/extensions"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/mtu"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 	. "github.com/onsi/gomega"
 	"k8s.io/utils/pointer"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
@@ -47,6 +52,7 @@ func Test_ReconcileNetwork(t *testing.T) {
 		openStackCluster *infrav1.OpenStackCluster
 		expect           func(m *mock.MockNetworkClientMockRecorder)
 		want             *infrav1.OpenStackCluster
+		wantErr          bool
 	}{
 		{
 			name: "ensures status set when reconciling an existing network",
@@ -76,6 +82,91 @@ func Test_ReconcileNetwork(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "updates the MTU of an existing network",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					NetworkMTU: pointer.Int(1500),
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListNetwork(networks.ListOpts{Name: expectedNetworkName}).
+					Return([]networks.Network{
+						{
+							ID:   fakeNetworkID,
+							Name: expectedNetworkName,
+						},
+					}, nil)
+
+				m.
+					GetNetworkExt(fakeNetworkID).
+					Return(&clients.NetworkExt{
+						Network:       networks.Network{ID: fakeNetworkID, Name: expectedNetworkName},
+						NetworkMTUExt: mtu.NetworkMTUExt{MTU: 1450},
+					}, nil)
+
+				m.
+					UpdateNetwork(fakeNetworkID, mtu.UpdateOptsExt{
+						UpdateOptsBuilder: networks.UpdateOpts{},
+						MTU:               1500,
+					}).
+					Return(&networks.Network{ID: fakeNetworkID, Name: expectedNetworkName}, nil)
+			},
+			want: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					NetworkMTU: pointer.Int(1500),
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID:   fakeNetworkID,
+							Name: expectedNetworkName,
+							Tags: []string{},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "does not update an existing network with the same MTU",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					NetworkMTU: pointer.Int(1500),
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListNetwork(networks.ListOpts{Name: expectedNetworkName}).
+					Return([]networks.Network{
+						{
+							ID:   fakeNetworkID,
+							Name: expectedNetworkName,
+						},
+					}, nil)
+
+				m.
+					GetNetworkExt(fakeNetworkID).
+					Return(&clients.NetworkExt{
+						Network:       networks.Network{ID: fakeNetworkID, Name: expectedNetworkName},
+						NetworkMTUExt: mtu.NetworkMTUExt{MTU: 1500},
+					}, nil)
+			},
+			want: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					NetworkMTU: pointer.Int(1500),
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID:   fakeNetworkID,
+							Name: expectedNetworkName,
+							Tags: []string{},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "creation without any parameter",
 			openStackCluster: &infrav1.OpenStackCluster{
@@ -181,6 +272,75 @@ func Test_ReconcileNetwork(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "creation with managed network options",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedNetwork: &infrav1.ManagedNetworkOptions{
+						Provider: &infrav1.ProviderNetwork{
+							NetworkType:     "vlan",
+							PhysicalNetwork: "physnet1",
+							SegmentationID:  pointer.Int(100),
+						},
+						AvailabilityZoneHints: []string{"az1"},
+						DNSDomain:             "cluster.example.com.",
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListNetwork(networks.ListOpts{Name: expectedNetworkName}).
+					Return([]networks.Network{}, nil)
+
+				m.
+					ListExtensions().
+					Return([]extensions.Extension{
+						{Extension: common.Extension{Alias: "provider"}},
+						{Extension: common.Extension{Alias: "network_availability_zone"}},
+						{Extension: common.Extension{Alias: "dns-integration"}},
+					}, nil)
+
+				m.
+					CreateNetwork(createOpts{
+						AdminStateUp:          gophercloud.Enabled,
+						Name:                  expectedNetworkName,
+						NetworkType:           "vlan",
+						PhysicalNetwork:       "physnet1",
+						SegmentationID:        pointer.Int(100),
+						AvailabilityZoneHints: []string{"az1"},
+						DNSDomain:             "cluster.example.com.",
+					}).
+					Return(&networks.Network{
+						ID:   fakeNetworkID,
+						Name: expectedNetworkName,
+					}, nil)
+			},
+		},
+		{
+			name: "creation with managed network options fails if an extension is not supported",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedNetwork: &infrav1.ManagedNetworkOptions{
+						Provider: &infrav1.ProviderNetwork{
+							NetworkType: "vxlan",
+						},
+						DNSDomain: "cluster.example.com.",
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListNetwork(networks.ListOpts{Name: expectedNetworkName}).
+					Return([]networks.Network{}, nil)
+
+				m.
+					ListExtensions().
+					Return([]extensions.Extension{
+						{Extension: common.Extension{Alias: "provider"}},
+					}, nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -196,7 +356,11 @@ func Test_ReconcileNetwork(t *testing.T) {
 				scope:  scope.NewWithLogger(scopeFactory, log),
 			}
 			err := s.ReconcileNetwork(tt.openStackCluster, clusterName)
-			g.Expect(err).ShouldNot(HaveOccurred())
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ShouldNot(HaveOccurred())
+			}
 		})
 	}
 }
@@ -442,6 +606,7 @@ func Test_ReconcileSubnet(t *testing.T) {
 	fakeCIDR := "10.0.0.0/24"
 	fakeNetworkID := "d08803fc-2fa5-4279-b9f7-8c45d0ff2fe6"
 	fakeDNS := "10.0.10.200"
+	fakeSubnetPoolID := "0f1b4fbc-d4c6-4f0d-9c43-3ab2a1f3a8e1"
 
 	tests := []struct {
 		name             string
@@ -469,7 +634,7 @@ func Test_ReconcileSubnet(t *testing.T) {
 			},
 			expect: func(m *mock.MockNetworkClientMockRecorder) {
 				m.
-					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, CIDR: fakeCIDR}).
+					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, Name: expectedSubnetName}).
 					Return([]subnets.Subnet{
 						{
 							ID:   fakeSubnetID,
@@ -493,6 +658,123 @@ func Test_ReconcileSubnet(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "updates DNSNameservers and AllocationPools of an existing subnet",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR:           fakeCIDR,
+							DNSNameservers: []string{fakeDNS},
+							AllocationPools: []infrav1.AllocationPool{
+								{Start: "10.0.0.10", End: "10.0.0.100"},
+							},
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID: fakeNetworkID,
+						},
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, Name: expectedSubnetName}).
+					Return([]subnets.Subnet{
+						{
+							ID:              fakeSubnetID,
+							Name:            expectedSubnetName,
+							CIDR:            fakeCIDR,
+							DNSNameservers:  []string{"8.8.8.8"},
+							AllocationPools: []subnets.AllocationPool{{Start: "10.0.0.2", End: "10.0.0.254"}},
+						},
+					}, nil)
+
+				m.
+					UpdateSubnet(fakeSubnetID, subnets.UpdateOpts{
+						DNSNameservers:  &[]string{fakeDNS},
+						AllocationPools: []subnets.AllocationPool{{Start: "10.0.0.10", End: "10.0.0.100"}},
+					}).
+					Return(&subnets.Subnet{
+						ID:              fakeSubnetID,
+						Name:            expectedSubnetName,
+						CIDR:            fakeCIDR,
+						DNSNameservers:  []string{fakeDNS},
+						AllocationPools: []subnets.AllocationPool{{Start: "10.0.0.10", End: "10.0.0.100"}},
+					}, nil)
+			},
+			want: &infrav1.OpenStackClusterStatus{
+				Network: &infrav1.NetworkStatusWithSubnets{
+					NetworkStatus: infrav1.NetworkStatus{
+						ID: fakeNetworkID,
+					},
+					Subnets: []infrav1.Subnet{
+						{
+							Name: expectedSubnetName,
+							ID:   fakeSubnetID,
+							CIDR: fakeCIDR,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "does not update an existing subnet matching the spec",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR:           fakeCIDR,
+							DNSNameservers: []string{fakeDNS},
+							AllocationPools: []infrav1.AllocationPool{
+								{Start: "10.0.0.10", End: "10.0.0.19"},
+								{Start: "10.0.0.1", End: "10.0.0.9"},
+							},
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID: fakeNetworkID,
+						},
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, Name: expectedSubnetName}).
+					Return([]subnets.Subnet{
+						{
+							ID:             fakeSubnetID,
+							Name:           expectedSubnetName,
+							CIDR:           fakeCIDR,
+							DNSNameservers: []string{fakeDNS},
+							AllocationPools: []subnets.AllocationPool{
+								{Start: "10.0.0.1", End: "10.0.0.9"},
+								{Start: "10.0.0.10", End: "10.0.0.19"},
+							},
+						},
+					}, nil)
+			},
+			want: &infrav1.OpenStackClusterStatus{
+				Network: &infrav1.NetworkStatusWithSubnets{
+					NetworkStatus: infrav1.NetworkStatus{
+						ID: fakeNetworkID,
+					},
+					Subnets: []infrav1.Subnet{
+						{
+							Name: expectedSubnetName,
+							ID:   fakeSubnetID,
+							CIDR: fakeCIDR,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "creation without any parameter",
 			openStackCluster: &infrav1.OpenStackCluster{
@@ -513,7 +795,7 @@ func Test_ReconcileSubnet(t *testing.T) {
 			},
 			expect: func(m *mock.MockNetworkClientMockRecorder) {
 				m.
-					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, CIDR: fakeCIDR}).
+					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, Name: expectedSubnetName}).
 					Return([]subnets.Subnet{}, nil)
 
 				m.
@@ -545,6 +827,64 @@ func Test_ReconcileSubnet(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "creation from a subnet pool",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							SubnetPool:   &infrav1.SubnetPoolFilter{Name: "pool"},
+							PrefixLength: pointer.Int(26),
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID: fakeNetworkID,
+						},
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, Name: expectedSubnetName}).
+					Return([]subnets.Subnet{}, nil)
+
+				m.
+					ListSubnetPool(subnetpools.ListOpts{Name: "pool"}).
+					Return([]subnetpools.SubnetPool{{ID: fakeSubnetPoolID, Name: "pool", IPversion: 4}}, nil)
+
+				m.
+					CreateSubnet(subnets.CreateOpts{
+						NetworkID:    fakeNetworkID,
+						Name:         expectedSubnetName,
+						IPVersion:    4,
+						SubnetPoolID: fakeSubnetPoolID,
+						Prefixlen:    26,
+						Description:  expectedSubnetDesc,
+					}).
+					Return(&subnets.Subnet{
+						ID:   fakeSubnetID,
+						Name: expectedSubnetName,
+						CIDR: "10.10.0.64/26",
+					}, nil)
+			},
+			want: &infrav1.OpenStackClusterStatus{
+				Network: &infrav1.NetworkStatusWithSubnets{
+					NetworkStatus: infrav1.NetworkStatus{
+						ID: fakeNetworkID,
+					},
+					Subnets: []infrav1.Subnet{
+						{
+							Name: expectedSubnetName,
+							ID:   fakeSubnetID,
+							CIDR: "10.10.0.64/26",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "creation with DNSNameservers",
 			openStackCluster: &infrav1.OpenStackCluster{
@@ -566,7 +906,7 @@ func Test_ReconcileSubnet(t *testing.T) {
 			},
 			expect: func(m *mock.MockNetworkClientMockRecorder) {
 				m.
-					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, CIDR: fakeCIDR}).
+					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, Name: expectedSubnetName}).
 					Return([]subnets.Subnet{}, nil)
 
 				m.
@@ -629,7 +969,7 @@ func Test_ReconcileSubnet(t *testing.T) {
 			},
 			expect: func(m *mock.MockNetworkClientMockRecorder) {
 				m.
-					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, CIDR: fakeCIDR}).
+					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, Name: expectedSubnetName}).
 					Return([]subnets.Subnet{}, nil)
 
 				m.
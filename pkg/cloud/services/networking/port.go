@@ -20,11 +20,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsbinding"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -142,6 +146,7 @@ func (s *Service) CreatePort(eventObject runtime.Object, clusterName string, por
 	}
 
 	var fixedIPs interface{}
+	var pooledIPs []string
 	if len(portOpts.FixedIPs) > 0 {
 		fips := make([]ports.IP, 0, len(portOpts.FixedIPs)+1)
 		for _, fixedIP := range portOpts.FixedIPs {
@@ -149,6 +154,9 @@ func (s *Service) CreatePort(eventObject runtime.Object, clusterName string, por
 			if err != nil {
 				return nil, err
 			}
+			if fixedIP.IPAddressPoolRef != nil && fixedIP.IPAddress != nil {
+				pooledIPs = append(pooledIPs, *fixedIP.IPAddress)
+			}
 			fips = append(fips, ports.IP{
 				SubnetID:  subnetID,
 				IPAddress: pointer.StringDeref(fixedIP.IPAddress, ""),
@@ -202,9 +210,38 @@ func (s *Service) CreatePort(eventObject runtime.Object, clusterName string, por
 		Profile:           getPortProfile(portOpts.Profile),
 	}
 
+	if portOpts.QoSPolicy != nil {
+		createOpts = policies.PortCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			QoSPolicyID:       portOpts.QoSPolicy.ID,
+		}
+	}
+
+	if portOpts.DNSName != nil || portOpts.DNSDomain != nil {
+		createOpts = portDNSCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			DNSName:           pointer.StringDeref(portOpts.DNSName, ""),
+			DNSDomain:         pointer.StringDeref(portOpts.DNSDomain, ""),
+		}
+	}
+
+	// An address claimed from an OpenStackFixedIPPool is reserved by a port of the pool until it is used.
+	// The reservation is restored if the port cannot be created, so that the address is not lost.
+	var reservations []ports.Port
+	for _, ip := range pooledIPs {
+		released, err := s.releaseFixedIPReservation(eventObject, networkID, ip)
+		reservations = append(reservations, released...)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("release reservation of fixed IP %q: %w", ip, err), s.restoreFixedIPReservations(eventObject, reservations))
+		}
+	}
+
 	port, err := s.client.CreatePort(createOpts)
 	if err != nil {
 		record.Warnf(eventObject, "FailedCreatePort", "Failed to create port %s: %v", portName, err)
+		if len(reservations) > 0 {
+			err = errors.Join(err, s.restoreFixedIPReservations(eventObject, reservations))
+		}
 		return nil, err
 	}
 
@@ -233,6 +270,29 @@ func (s *Service) CreatePort(eventObject runtime.Object, clusterName string, por
 	return port, nil
 }
 
+// portDNSCreateOptsExt adds the DNS attributes to the options of a port.
+// gophercloud's dns extension does not support the dns_domain attribute.
+type portDNSCreateOptsExt struct {
+	ports.CreateOptsBuilder
+	DNSName   string
+	DNSDomain string
+}
+
+func (opts portDNSCreateOptsExt) ToPortCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToPortCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	port := base["port"].(map[string]interface{})
+	if opts.DNSName != "" {
+		port["dns_name"] = opts.DNSName
+	}
+	if opts.DNSDomain != "" {
+		port["dns_domain"] = opts.DNSDomain
+	}
+	return base, nil
+}
+
 func (s *Service) getSubnetIDForFixedIP(subnet *infrav1.SubnetFilter, networkID string) (string, error) {
 	if subnet == nil {
 		return "", nil
@@ -357,15 +417,23 @@ func (s *Service) DeleteClusterPorts(openStackCluster *infrav1.OpenStackCluster)
 	return nil
 }
 
-// GetPortName appends a suffix to an instance name in order to try and get a unique name per port.
-func GetPortName(instanceName string, opts *infrav1.PortOpts, netIndex int) string {
+// GetPortName returns the name of a port of an instance. It is rendered from the port name
+// template of the cluster if set. Otherwise a suffix is appended to the instance name in
+// order to try and get a unique name per port.
+func GetPortName(openStackCluster *infrav1.OpenStackCluster, instanceName string, opts *infrav1.PortOpts, netIndex int) (string, error) {
+	data := names.GetClusterResourceNameData(openStackCluster)
+	data.MachineName = instanceName
+	data.Index = netIndex
+
+	defaultName := fmt.Sprintf("%s-%d", instanceName, netIndex)
 	if opts != nil && opts.NameSuffix != nil {
-		return fmt.Sprintf("%s-%s", instanceName, *opts.NameSuffix)
+		data.Role = *opts.NameSuffix
+		defaultName = fmt.Sprintf("%s-%s", instanceName, *opts.NameSuffix)
 	}
-	return fmt.Sprintf("%s-%d", instanceName, netIndex)
+	return names.GetResourceName(names.GetResourceNaming(openStackCluster).Port, data, defaultName)
 }
 
-func (s *Service) CreatePorts(eventObject runtime.Object, clusterName, baseName string, securityGroups []infrav1.SecurityGroupFilter, baseTags []string, desiredPorts []infrav1.PortOpts, dependentResources *infrav1.DependentMachineResources) error {
+func (s *Service) CreatePorts(eventObject runtime.Object, openStackCluster *infrav1.OpenStackCluster, clusterName, baseName string, securityGroups []infrav1.SecurityGroupFilter, baseTags []string, desiredPorts []infrav1.PortOpts, dependentResources *infrav1.DependentMachineResources) error {
 	defaultSecurityGroups, err := s.GetSecurityGroups(securityGroups)
 	if err != nil {
 		return fmt.Errorf("error getting security groups: %v", err)
@@ -378,7 +446,10 @@ func (s *Service) CreatePorts(eventObject runtime.Object, clusterName, baseName
 		}
 
 		portOpts := &desiredPorts[i]
-		portName := GetPortName(baseName, portOpts, i)
+		portName, err := GetPortName(openStackCluster, baseName, portOpts, i)
+		if err != nil {
+			return err
+		}
 		// Events are recorded in CreatePort
 		port, err := s.CreatePort(eventObject, clusterName, portName, portOpts, defaultSecurityGroups, baseTags)
 		if err != nil {
@@ -401,6 +472,10 @@ func (s *Service) ConstructPorts(openStackCluster *infrav1.OpenStackCluster, por
 		return nil, nil
 	}
 
+	if err := s.validatePortExtensions(ports); err != nil {
+		return nil, err
+	}
+
 	// Ensure user-specified ports have all required fields
 	ports, err := s.normalizePorts(ports, openStackCluster, trunkEnabled)
 	if err != nil {
@@ -443,9 +518,37 @@ func (s *Service) ConstructPorts(openStackCluster *infrav1.OpenStackCluster, por
 	return ports, nil
 }
 
+// validatePortExtensions returns an error if a port requires a Neutron API
+// extension which is not available.
+func (s *Service) validatePortExtensions(ports []infrav1.PortOpts) error {
+	var required []string
+	for i := range ports {
+		port := &ports[i]
+		if port.QoSPolicy != nil && !slices.Contains(required, "qos") {
+			required = append(required, "qos")
+		}
+		if port.DNSName != nil && !slices.Contains(required, "dns-integration") {
+			required = append(required, "dns-integration")
+		}
+		if port.DNSDomain != nil && !slices.Contains(required, "dns-domain-ports") {
+			required = append(required, "dns-domain-ports")
+		}
+	}
+
+	missing, err := s.getUnsupportedExtensions(required)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("ports require the unsupported neutron API extensions: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
 // normalizePorts ensures that a user-specified PortOpts has all required fields set. Specifically it:
 // - sets the Trunk field to the instance spec default if not specified
-// - sets the Network ID field if not specified.
+// - sets the Network ID field if not specified
+// - sets the QoS policy ID field if a QoS policy is specified without ID.
 func (s *Service) normalizePorts(ports []infrav1.PortOpts, openStackCluster *infrav1.OpenStackCluster, trunkEnabled bool) ([]infrav1.PortOpts, error) {
 	normalizedPorts := make([]infrav1.PortOpts, 0, len(ports))
 	for i := range ports {
@@ -461,6 +564,14 @@ func (s *Service) normalizePorts(ports []infrav1.PortOpts, openStackCluster *inf
 			return nil, err
 		}
 
+		if port.QoSPolicy != nil && port.QoSPolicy.ID == "" {
+			qosPolicyID, err := s.getQoSPolicyID(port.QoSPolicy, i)
+			if err != nil {
+				return nil, err
+			}
+			port.QoSPolicy.ID = qosPolicyID
+		}
+
 		normalizedPorts = append(normalizedPorts, *port)
 	}
 	return normalizedPorts, nil
@@ -557,6 +668,23 @@ func (s *Service) normalizePortTarget(port *infrav1.PortOpts, openStackCluster *
 	return nil
 }
 
+// getQoSPolicyID returns the ID of the QoS policy matching the filter of the port.
+func (s *Service) getQoSPolicyID(filter *infrav1.QoSPolicyFilter, portIdx int) (string, error) {
+	qosPolicies, err := s.client.ListQoSPolicy(filterconvert.QoSPolicyFilterToListOpts(filter))
+	if err != nil {
+		return "", err
+	}
+
+	// TODO: These are spec errors: they should set the machine to failed
+	switch len(qosPolicies) {
+	case 0:
+		return "", fmt.Errorf("QoS policy filter for port %d returns no policies", portIdx)
+	case 1:
+		return qosPolicies[0].ID, nil
+	}
+	return "", fmt.Errorf("QoS policy filter for port %d returns more than one result", portIdx)
+}
+
 // IsTrunkExtSupported verifies trunk setup on the OpenStack deployment.
 func (s *Service) IsTrunkExtSupported() (trunknSupported bool, err error) {
 	trunkSupport, err := s.GetTrunkSupport()
@@ -573,7 +701,7 @@ func (s *Service) IsTrunkExtSupported() (trunknSupported bool, err error) {
 // by checking if they exist and if they do, it'll add them to the OpenStackMachine status.
 // A port is searched by name and network ID and has to be unique.
 // If the port is not found, it'll be ignored because it'll be created after the adoption.
-func (s *Service) AdoptMachinePorts(scope *scope.WithLogger, openStackMachine *infrav1.OpenStackMachine, desiredPorts []infrav1.PortOpts) (changed bool, err error) {
+func (s *Service) AdoptMachinePorts(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, desiredPorts []infrav1.PortOpts) (changed bool, err error) {
 	changed = false
 
 	// We can skip adoption if the instance is ready because OpenStackMachine is immutable once ready
@@ -596,7 +724,10 @@ func (s *Service) AdoptMachinePorts(scope *scope.WithLogger, openStackMachine *i
 		}
 
 		portOpts := &desiredPorts[i]
-		portName := GetPortName(openStackMachine.Name, portOpts, i)
+		portName, err := GetPortName(openStackCluster, openStackMachine.Name, portOpts, i)
+		if err != nil {
+			return changed, err
+		}
 		ports, err := s.client.ListPort(ports.ListOpts{
 			Name:      portName,
 			NetworkID: port.Network.ID,
@@ -660,7 +791,10 @@ func (s *Service) AdoptBastionPorts(scope *scope.WithLogger, openStackCluster *i
 		}
 
 		portOpts := &desiredPorts[i]
-		portName := GetPortName(bastionName, portOpts, i)
+		portName, err := GetPortName(openStackCluster, bastionName, portOpts, i)
+		if err != nil {
+			return changed, err
+		}
 		ports, err := s.client.ListPort(ports.ListOpts{
 			Name:      portName,
 			NetworkID: port.Network.ID,
@@ -686,3 +820,69 @@ func (s *Service) AdoptBastionPorts(scope *scope.WithLogger, openStackCluster *i
 
 	return changed, nil
 }
+
+// AdoptInstancePorts discovers the ports and trunks attached to an existing server which was not
+// created by cluster-api-provider-openstack. The given tags are added to the ports.
+func (s *Service) AdoptInstancePorts(eventObject runtime.Object, instanceID string, tags []string) ([]infrav1.PortStatus, []infrav1.TrunkStatus, error) {
+	portList, err := s.client.ListPort(ports.ListOpts{
+		DeviceID: instanceID,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("searching for ports of server %s: %v", instanceID, err)
+	}
+
+	trunkSupported, err := s.IsTrunkExtSupported()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	portStatuses := make([]infrav1.PortStatus, 0, len(portList))
+	var trunkStatuses []infrav1.TrunkStatus
+	for i := range portList {
+		port := &portList[i]
+
+		if missing := missingTags(port.Tags, tags); len(missing) > 0 {
+			_, err = s.client.ReplaceAllAttributesTags("ports", port.ID, attributestags.ReplaceAllOpts{
+				Tags: append(port.Tags, missing...),
+			})
+			if err != nil {
+				record.Warnf(eventObject, "FailedAdoptPort", "Failed to tag port %s with id %s: %v", port.Name, port.ID, err)
+				return nil, nil, err
+			}
+		}
+		portStatuses = append(portStatuses, infrav1.PortStatus{ID: port.ID})
+
+		if !trunkSupported {
+			continue
+		}
+		trunkList, err := s.client.ListTrunk(trunks.ListOpts{
+			PortID: port.ID,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("searching for trunk of port %s: %v", port.ID, err)
+		}
+		for j := range trunkList {
+			trunkStatuses = append(trunkStatuses, infrav1.TrunkStatus{ID: trunkList[j].ID, PortID: port.ID})
+		}
+	}
+
+	return portStatuses, trunkStatuses, nil
+}
+
+// missingTags returns the desired tags which are not in the current tags.
+func missingTags(current, desired []string) []string {
+	var missing []string
+	for _, tag := range desired {
+		found := false
+		for _, c := range current {
+			if c == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, tag)
+		}
+	}
+	return missing
+}
@@ -17,19 +17,25 @@ limitations under the License.
 package networking
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	common "github.com/gophercloud/gophercloud/openstack/common/extensions"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsbinding"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
@@ -49,6 +55,7 @@ func Test_CreatePort(t *testing.T) {
 	hostID := "825c1b11-3dca-4bfe-a2d8-a3cc1964c8d5"
 	trunkID := "eb7541fa-5e2a-4cca-b2c3-dfa409b917ce"
 	portSecurityGroupID := "f51d1206-fc5a-4f7a-a5c0-2e03e44e4dc0"
+	qosPolicyID := "0bd6c6dc-a4b8-4ba5-8a3e-f0c4e2e1c0a7"
 
 	// Other arbitrary variables passed in to the tests
 	instanceSecurityGroups := []string{"instance-secgroup"}
@@ -406,6 +413,98 @@ func Test_CreatePort(t *testing.T) {
 			&ports.Port{ID: portID1, PropagateUplinkStatus: true},
 			false,
 		},
+		{
+			"creates port with QoS policy and DNS attributes",
+			"foo-port-1",
+			infrav1.PortOpts{
+				Network: &infrav1.NetworkFilter{
+					ID: netID,
+				},
+				QoSPolicy: &infrav1.QoSPolicyFilter{ID: qosPolicyID, Name: "gold"},
+				DNSName:   pointer.String("foo"),
+				DNSDomain: pointer.String("example.com."),
+			},
+			nil,
+			nil,
+			func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					CreatePort(portDNSCreateOptsExt{
+						CreateOptsBuilder: policies.PortCreateOptsExt{
+							CreateOptsBuilder: portsbinding.CreateOptsExt{
+								CreateOptsBuilder: ports.CreateOpts{
+									Name:                "foo-port-1",
+									Description:         "Created by cluster-api-provider-openstack cluster test-cluster",
+									NetworkID:           netID,
+									AllowedAddressPairs: []ports.AddressPair{},
+								},
+							},
+							QoSPolicyID: qosPolicyID,
+						},
+						DNSName:   "foo",
+						DNSDomain: "example.com.",
+					}).Return(&ports.Port{ID: portID1}, nil)
+			},
+			&ports.Port{ID: portID1},
+			false,
+		},
+		{
+			"restores the reservation of a fixed IP from a pool if the port cannot be created",
+			"foo-port-1",
+			infrav1.PortOpts{
+				Network: &infrav1.NetworkFilter{
+					ID: netID,
+				},
+				FixedIPs: []infrav1.FixedIP{{
+					Subnet:    &infrav1.SubnetFilter{ID: subnetID1},
+					IPAddress: pointer.String("192.168.0.50"),
+					IPAddressPoolRef: &corev1.TypedLocalObjectReference{
+						APIGroup: pointer.String("infrastructure.cluster.x-k8s.io"),
+						Kind:     "OpenStackFixedIPPool",
+						Name:     "pool",
+					},
+				}},
+			},
+			nil,
+			nil,
+			func(m *mock.MockNetworkClientMockRecorder) {
+				reservation := ports.Port{
+					ID:          "reservation-id",
+					Name:        "default-claim",
+					NetworkID:   netID,
+					Description: "Created by cluster-api-provider-openstack OpenStackFixedIPPool pool",
+					DeviceOwner: FixedIPReservationDeviceOwner,
+					FixedIPs:    []ports.IP{{SubnetID: subnetID1, IPAddress: "192.168.0.50"}},
+					Tags:        []string{"pool-tag"},
+				}
+				m.ListPort(ports.ListOpts{
+					NetworkID:   netID,
+					DeviceOwner: FixedIPReservationDeviceOwner,
+					FixedIPs:    []ports.FixedIPOpts{{IPAddress: "192.168.0.50"}},
+				}).Return([]ports.Port{reservation}, nil)
+				m.DeletePort("reservation-id").Return(nil)
+				m.
+					CreatePort(portsbinding.CreateOptsExt{
+						CreateOptsBuilder: ports.CreateOpts{
+							Name:                "foo-port-1",
+							Description:         "Created by cluster-api-provider-openstack cluster test-cluster",
+							NetworkID:           netID,
+							AllowedAddressPairs: []ports.AddressPair{},
+							FixedIPs:            []ports.IP{{SubnetID: subnetID1, IPAddress: "192.168.0.50"}},
+						},
+					}).Return(nil, fmt.Errorf("quota exceeded"))
+				m.
+					CreatePort(ports.CreateOpts{
+						Name:        "default-claim",
+						NetworkID:   netID,
+						Description: "Created by cluster-api-provider-openstack OpenStackFixedIPPool pool",
+						DeviceOwner: FixedIPReservationDeviceOwner,
+						FixedIPs:    []ports.IP{{SubnetID: subnetID1, IPAddress: "192.168.0.50"}},
+					}).Return(&ports.Port{ID: "restored-id", FixedIPs: reservation.FixedIPs}, nil)
+				m.ReplaceAllAttributesTags("ports", "restored-id", attributestags.ReplaceAllOpts{Tags: []string{"pool-tag"}}).Return([]string{"pool-tag"}, nil)
+			},
+			nil,
+			true,
+		},
 	}
 
 	eventObject := &infrav1.OpenStackMachine{}
@@ -444,8 +543,9 @@ func TestService_normalizePorts(t *testing.T) {
 		defaultNetworkID = "3c66f3ca-2d26-4d9d-ae3b-568f54129773"
 		defaultSubnetID  = "d8dbba89-8c39-4192-a571-e702fca35bac"
 
-		networkID = "afa54944-1443-4132-9ef5-ce37eb4d6ab6"
-		subnetID  = "d786e715-c299-4a97-911d-640c10fc0392"
+		networkID   = "afa54944-1443-4132-9ef5-ce37eb4d6ab6"
+		subnetID    = "d786e715-c299-4a97-911d-640c10fc0392"
+		qosPolicyID = "0bd6c6dc-a4b8-4ba5-8a3e-f0c4e2e1c0a7"
 	)
 
 	openStackCluster := &infrav1.OpenStackCluster{
@@ -614,6 +714,53 @@ func TestService_normalizePorts(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "QoS policy defined by filter: add ID from policy lookup",
+			ports: []infrav1.PortOpts{
+				{
+					Network: &infrav1.NetworkFilter{
+						ID: networkID,
+					},
+					QoSPolicy: &infrav1.QoSPolicyFilter{
+						Name: "gold",
+					},
+				},
+			},
+			expectNetwork: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListQoSPolicy(policies.ListOpts{Name: "gold"}).Return([]policies.Policy{
+					{ID: qosPolicyID},
+				}, nil)
+			},
+			want: []infrav1.PortOpts{
+				{
+					Network: &infrav1.NetworkFilter{
+						ID: networkID,
+					},
+					QoSPolicy: &infrav1.QoSPolicyFilter{
+						ID:   qosPolicyID,
+						Name: "gold",
+					},
+					Trunk: pointer.Bool(false),
+				},
+			},
+		},
+		{
+			name: "QoS policy filter returns no matches: error",
+			ports: []infrav1.PortOpts{
+				{
+					Network: &infrav1.NetworkFilter{
+						ID: networkID,
+					},
+					QoSPolicy: &infrav1.QoSPolicyFilter{
+						Name: "gold",
+					},
+				},
+			},
+			expectNetwork: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListQoSPolicy(policies.ListOpts{Name: "gold"}).Return([]policies.Policy{}, nil)
+			},
+			wantErr: true,
+		},
 		{
 			name: "No network, fixed IP has subnet by ID: add ID from subnet",
 			ports: []infrav1.PortOpts{
@@ -797,41 +944,134 @@ func TestService_normalizePorts(t *testing.T) {
 	}
 }
 
+func TestService_validatePortExtensions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name    string
+		ports   []infrav1.PortOpts
+		expect  func(m *mock.MockNetworkClientMockRecorder)
+		wantErr bool
+	}{
+		{
+			name:   "No extension required",
+			ports:  []infrav1.PortOpts{{}},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {},
+		},
+		{
+			name: "Required extensions are available",
+			ports: []infrav1.PortOpts{
+				{QoSPolicy: &infrav1.QoSPolicyFilter{Name: "gold"}, DNSName: pointer.String("foo")},
+				{QoSPolicy: &infrav1.QoSPolicyFilter{Name: "silver"}},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListExtensions().Return([]extensions.Extension{
+					{Extension: common.Extension{Alias: "qos"}},
+					{Extension: common.Extension{Alias: "dns-integration"}},
+				}, nil)
+			},
+		},
+		{
+			name: "Required extension is missing",
+			ports: []infrav1.PortOpts{
+				{DNSName: pointer.String("foo"), DNSDomain: pointer.String("example.com.")},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListExtensions().Return([]extensions.Extension{
+					{Extension: common.Extension{Alias: "dns-integration"}},
+				}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			tt.expect(mockClient.EXPECT())
+			s := Service{
+				client: mockClient,
+			}
+
+			err := s.validatePortExtensions(tt.ports)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
 func Test_getPortName(t *testing.T) {
 	type args struct {
-		instanceName string
-		opts         *infrav1.PortOpts
-		netIndex     int
+		openStackCluster *infrav1.OpenStackCluster
+		instanceName     string
+		opts             *infrav1.PortOpts
+		netIndex         int
+	}
+	openStackCluster := &infrav1.OpenStackCluster{}
+	openStackClusterWithNaming := &infrav1.OpenStackCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"},
+		Spec: infrav1.OpenStackClusterSpec{
+			ResourceNaming: &infrav1.ResourceNaming{
+				Port: pointer.String("{{ .ClusterNamespace }}-{{ .MachineName }}-{{ if .Role }}{{ .Role }}{{ else }}{{ .Index }}{{ end }}"),
+			},
+		},
 	}
 	tests := []struct {
-		name string
-		args args
-		want string
+		name    string
+		args    args
+		want    string
+		wantErr bool
 	}{
 		{
 			name: "with nil PortOpts",
-			args: args{"test-1-instance", nil, 2},
+			args: args{openStackCluster, "test-1-instance", nil, 2},
 			want: "test-1-instance-2",
 		},
 		{
 			name: "with PortOpts name suffix",
-			args: args{"test-1-instance", &infrav1.PortOpts{NameSuffix: pointer.String("foo")}, 4},
+			args: args{openStackCluster, "test-1-instance", &infrav1.PortOpts{NameSuffix: pointer.String("foo")}, 4},
 			want: "test-1-instance-foo",
 		},
 		{
 			name: "without PortOpts name suffix",
-			args: args{"test-1-instance", &infrav1.PortOpts{}, 4},
+			args: args{openStackCluster, "test-1-instance", &infrav1.PortOpts{}, 4},
 			want: "test-1-instance-4",
 		},
 		{
 			name: "with PortOpts name suffix",
-			args: args{"test-1-instance", &infrav1.PortOpts{NameSuffix: pointer.String("foo2"), Network: &infrav1.NetworkFilter{ID: "bar"}, DisablePortSecurity: pointer.Bool(true)}, 4},
+			args: args{openStackCluster, "test-1-instance", &infrav1.PortOpts{NameSuffix: pointer.String("foo2"), Network: &infrav1.NetworkFilter{ID: "bar"}, DisablePortSecurity: pointer.Bool(true)}, 4},
 			want: "test-1-instance-foo2",
 		},
+		{
+			name: "with naming template",
+			args: args{openStackClusterWithNaming, "test-1-instance", nil, 2},
+			want: "test-namespace-test-1-instance-2",
+		},
+		{
+			name: "with naming template and PortOpts name suffix",
+			args: args{openStackClusterWithNaming, "test-1-instance", &infrav1.PortOpts{NameSuffix: pointer.String("foo")}, 2},
+			want: "test-namespace-test-1-instance-foo",
+		},
+		{
+			name: "with invalid naming template",
+			args: args{
+				&infrav1.OpenStackCluster{Spec: infrav1.OpenStackClusterSpec{ResourceNaming: &infrav1.ResourceNaming{Port: pointer.String("{{ .Unknown }}")}}},
+				"test-1-instance", nil, 2,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := GetPortName(tt.args.instanceName, tt.args.opts, tt.args.netIndex); got != tt.want {
+			got, err := GetPortName(tt.args.openStackCluster, tt.args.instanceName, tt.args.opts, tt.args.netIndex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getPortName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
 				t.Errorf("getPortName() = %v, want %v", got, tt.want)
 			}
 		})
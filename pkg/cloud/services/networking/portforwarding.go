@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+)
+
+const (
+	portForwardingProtocol = "tcp"
+
+	// portForwardingExtension is the alias of the Neutron API extension required for port forwarding.
+	portForwardingExtension = "floating-ip-port-forwarding"
+)
+
+// GetOrCreatePortForwarding ensures that the external port of the floating IP
+// is forwarded to the internal port of the first IPv4 address of the given port.
+// It fails if the external port is already forwarded to another port.
+func (s *Service) GetOrCreatePortForwarding(eventObject runtime.Object, fp *floatingips.FloatingIP, port *ports.Port, internalPort, externalPort int) (*portforwarding.PortForwarding, error) {
+	internalIPAddress := getPortIPv4Address(port)
+	if internalIPAddress == "" {
+		return nil, fmt.Errorf("port %s has no IPv4 address to forward floating IP %s to", port.ID, fp.FloatingIP)
+	}
+
+	missing, err := s.getUnsupportedExtensions([]string{portForwardingExtension})
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("port forwarding requires the unsupported neutron API extensions: %s", strings.Join(missing, ", "))
+	}
+
+	pfList, err := s.client.ListPortForwarding(fp.ID, portforwarding.ListOpts{
+		ExternalPort: strconv.Itoa(externalPort),
+		Protocol:     portForwardingProtocol,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range pfList {
+		pf := &pfList[i]
+		if pf.InternalPortID == port.ID && pf.InternalIPAddress == internalIPAddress && pf.InternalPort == internalPort {
+			return pf, nil
+		}
+		return nil, fmt.Errorf("port %d of floating IP %s is already forwarded to port %s", externalPort, fp.FloatingIP, pf.InternalPortID)
+	}
+
+	s.scope.Logger().Info("Creating port forwarding", "floatingIP", fp.FloatingIP, "externalPort", externalPort, "port", port.ID, "internalPort", internalPort)
+	pf, err := s.client.CreatePortForwarding(fp.ID, portforwarding.CreateOpts{
+		InternalPortID:    port.ID,
+		InternalIPAddress: internalIPAddress,
+		InternalPort:      internalPort,
+		ExternalPort:      externalPort,
+		Protocol:          portForwardingProtocol,
+	})
+	if err != nil {
+		record.Warnf(eventObject, "FailedCreatePortForwarding", "Failed to create port forwarding of port %d of floating IP %s to port %s: %v", externalPort, fp.FloatingIP, port.ID, err)
+		return nil, err
+	}
+
+	record.Eventf(eventObject, "SuccessfulCreatePortForwarding", "Created port forwarding %s of port %d of floating IP %s to port %s", pf.ID, externalPort, fp.FloatingIP, port.ID)
+	return pf, nil
+}
+
+// DeletePortForwarding deletes the port forwarding with the given ID of the
+// floating IP with the given ID. It does nothing if the port forwarding does not exist.
+func (s *Service) DeletePortForwarding(eventObject runtime.Object, floatingIPID, id string) error {
+	if err := s.client.DeletePortForwarding(floatingIPID, id); err != nil {
+		record.Warnf(eventObject, "FailedDeletePortForwarding", "Failed to delete port forwarding %s: %v", id, err)
+		return err
+	}
+
+	record.Eventf(eventObject, "SuccessfulDeletePortForwarding", "Deleted port forwarding %s", id)
+	return nil
+}
+
+// getPortIPv4Address returns the first IPv4 address of the port, or an empty string if it has none.
+func getPortIPv4Address(port *ports.Port) string {
+	for _, fixedIP := range port.FixedIPs {
+		if ip := net.ParseIP(fixedIP.IPAddress); ip != nil && ip.To4() != nil {
+			return fixedIP.IPAddress
+		}
+	}
+	return ""
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	common "github.com/gophercloud/gophercloud/openstack/common/extensions"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_GetOrCreatePortForwarding(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	fp := &floatingips.FloatingIP{ID: "fip", FloatingIP: "203.0.113.10"}
+	port := &ports.Port{
+		ID: "bastion-port",
+		FixedIPs: []ports.IP{
+			{IPAddress: "2001:db8::10"},
+			{IPAddress: "10.0.0.10"},
+		},
+	}
+	listOpts := portforwarding.ListOpts{ExternalPort: "2222", Protocol: "tcp"}
+	expectExtensions := func(m *mock.MockNetworkClientMockRecorder, aliases ...string) {
+		exts := make([]extensions.Extension, len(aliases))
+		for i := range aliases {
+			exts[i] = extensions.Extension{Extension: common.Extension{Alias: aliases[i]}}
+		}
+		m.ListExtensions().Return(exts, nil)
+	}
+
+	tests := []struct {
+		name    string
+		port    *ports.Port
+		expect  func(m *mock.MockNetworkClientMockRecorder)
+		wantID  string
+		wantErr bool
+	}{
+		{
+			name: "creates the port forwarding",
+			port: port,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				expectExtensions(m, "floating-ip-port-forwarding")
+				m.ListPortForwarding("fip", listOpts).Return([]portforwarding.PortForwarding{}, nil)
+				m.CreatePortForwarding("fip", portforwarding.CreateOpts{
+					InternalPortID:    "bastion-port",
+					InternalIPAddress: "10.0.0.10",
+					InternalPort:      22,
+					ExternalPort:      2222,
+					Protocol:          "tcp",
+				}).Return(&portforwarding.PortForwarding{ID: "pf"}, nil)
+			},
+			wantID: "pf",
+		},
+		{
+			name: "returns the existing port forwarding",
+			port: port,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				expectExtensions(m, "floating-ip-port-forwarding")
+				m.ListPortForwarding("fip", listOpts).Return([]portforwarding.PortForwarding{{
+					ID:                "pf",
+					InternalPortID:    "bastion-port",
+					InternalIPAddress: "10.0.0.10",
+					InternalPort:      22,
+					ExternalPort:      2222,
+					Protocol:          "tcp",
+				}}, nil)
+			},
+			wantID: "pf",
+		},
+		{
+			name: "fails if the external port is forwarded to another port",
+			port: port,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				expectExtensions(m, "floating-ip-port-forwarding")
+				m.ListPortForwarding("fip", listOpts).Return([]portforwarding.PortForwarding{{
+					ID:                "other",
+					InternalPortID:    "other-port",
+					InternalIPAddress: "10.0.0.20",
+					InternalPort:      22,
+					ExternalPort:      2222,
+					Protocol:          "tcp",
+				}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails if port forwarding is not supported",
+			port: port,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				expectExtensions(m)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "fails if the port has no IPv4 address",
+			port:    &ports.Port{ID: "bastion-port", FixedIPs: []ports.IP{{IPAddress: "2001:db8::10"}}},
+			expect:  func(m *mock.MockNetworkClientMockRecorder) {},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			tt.expect(mockClient.EXPECT())
+
+			scopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			s := Service{
+				client: mockClient,
+				scope:  scope.NewWithLogger(scopeFactory, testr.New(t)),
+			}
+			pf, err := s.GetOrCreatePortForwarding(&infrav1.OpenStackCluster{}, fp, tt.port, 22, 2222)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(pf.ID).To(Equal(tt.wantID))
+		})
+	}
+}
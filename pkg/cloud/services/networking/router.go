@@ -18,11 +18,11 @@ package networking
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
-	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
@@ -46,15 +46,12 @@ func (s *Service) ReconcileRouter(openStackCluster *infrav1.OpenStackCluster, cl
 	}
 
 	s.scope.Logger().Info("Reconciling router", "cluster", clusterName)
-	routerName := getRouterName(clusterName)
-	routerListOpts := routers.ListOpts{Name: routerName}
-	existingRouter := false
-	if openStackCluster.Spec.Router != nil {
-		routerListOpts = filterconvert.RouterFilterToListOpts(openStackCluster.Spec.Router)
-		existingRouter = true
+	routerName, err := getClusterRouterName(openStackCluster, clusterName)
+	if err != nil {
+		return err
 	}
-
-	router, err := s.getRouterByFilter(routerListOpts)
+	existingRouter := openStackCluster.Spec.Router != nil
+	router, err := s.getClusterRouter(openStackCluster, routerName)
 	if err != nil {
 		return err
 	}
@@ -122,13 +119,153 @@ func (s *Service) ReconcileRouter(openStackCluster *infrav1.OpenStackCluster, cl
 			s.scope.Logger().V(4).Info("Created RouterInterface", "id", routerInterface.ID)
 		}
 	}
+
+	if existingRouter {
+		return nil
+	}
+	return s.reconcileRouterSpec(openStackCluster, &router)
+}
+
+// reconcileRouterSpec updates the SNAT setting and the extra routes of a router
+// created for the cluster to match the spec. Extra routes are only reconciled
+// after the router interfaces exist, as Neutron requires the next hop of a
+// route to be reachable through one of them. Nothing is reconciled without
+// managedRouter, so that routes added out of band to the routers of existing
+// clusters are kept.
+func (s *Service) reconcileRouterSpec(openStackCluster *infrav1.OpenStackCluster, router *routers.Router) error {
+	managedRouter := openStackCluster.Spec.ManagedRouter
+	if managedRouter == nil {
+		return nil
+	}
+
+	var drift []string
+	updateOpts := routers.UpdateOpts{}
+
+	// External IPs are set by setRouterExternalIPs together with SNAT. The
+	// current value is unknown if Neutron does not return it.
+	if len(openStackCluster.Spec.ExternalRouterIPs) == 0 && managedRouter.EnableSNAT != nil &&
+		router.GatewayInfo.EnableSNAT != nil && *router.GatewayInfo.EnableSNAT != *managedRouter.EnableSNAT {
+		updateOpts.GatewayInfo = &routers.GatewayInfo{
+			NetworkID:  openStackCluster.Status.ExternalNetwork.ID,
+			EnableSNAT: managedRouter.EnableSNAT,
+		}
+		drift = append(drift, fmt.Sprintf("enableSNAT %t", *managedRouter.EnableSNAT))
+	}
+
+	routes := make([]routers.Route, len(managedRouter.ExtraRoutes))
+	for i := range managedRouter.ExtraRoutes {
+		routes[i] = routers.Route{
+			DestinationCIDR: managedRouter.ExtraRoutes[i].Destination,
+			NextHop:         managedRouter.ExtraRoutes[i].NextHop,
+		}
+	}
+	if !equalRoutes(router.Routes, routes) {
+		updateOpts.Routes = &routes
+		drift = append(drift, fmt.Sprintf("extra routes %v", routes))
+	}
+
+	if len(drift) == 0 {
+		return nil
+	}
+
+	if err := s.validateManagedRouterExtensions(managedRouter); err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateRouter", "Failed to update router %s with id %s: %v", router.Name, router.ID, err)
+		return err
+	}
+
+	updatedRouter, err := s.client.UpdateRouter(router.ID, updateOpts)
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateRouter", "Failed to update router %s with id %s: %v", router.Name, router.ID, err)
+		return err
+	}
+	*router = *updatedRouter
+
+	record.Eventf(openStackCluster, "SuccessfulUpdateRouter", "Updated router %s with id %s: %s", router.Name, router.ID, strings.Join(drift, ", "))
 	return nil
 }
 
+// equalRoutes returns true if both lists contain the same routes, regardless of their order.
+func equalRoutes(a, b []routers.Route) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[routers.Route]int, len(a))
+	for _, route := range a {
+		seen[route]++
+	}
+	for _, route := range b {
+		if seen[route] == 0 {
+			return false
+		}
+		seen[route]--
+	}
+	return true
+}
+
+// validateManagedRouterExtensions returns an error if the managed router
+// options require a Neutron API extension which is not available.
+func (s *Service) validateManagedRouterExtensions(managedRouter *infrav1.ManagedRouterOptions) error {
+	var required []string
+	if managedRouter.EnableSNAT != nil {
+		required = append(required, "ext-gw-mode")
+	}
+	if len(managedRouter.ExtraRoutes) > 0 {
+		required = append(required, "extraroute")
+	}
+	if managedRouter.HA != nil {
+		required = append(required, "l3-ha")
+	}
+	if managedRouter.Distributed != nil {
+		required = append(required, "dvr")
+	}
+	if len(managedRouter.AvailabilityZoneHints) > 0 {
+		required = append(required, "router_availability_zone")
+	}
+
+	missing, err := s.getUnsupportedExtensions(required)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("managedRouter requires the unsupported neutron API extensions: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// routerCreateOpts extends routers.CreateOpts with the ha attribute of the
+// l3-ha extension, which is not supported by gophercloud.
+type routerCreateOpts struct {
+	routers.CreateOpts
+	HA *bool
+}
+
+func (opts routerCreateOpts) ToRouterCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToRouterCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.HA != nil {
+		b["router"].(map[string]interface{})["ha"] = *opts.HA
+	}
+	return b, nil
+}
+
 func (s *Service) createRouter(openStackCluster *infrav1.OpenStackCluster, clusterName, name string) (*routers.Router, error) {
-	opts := routers.CreateOpts{
-		Description: names.GetDescription(clusterName),
-		Name:        name,
+	opts := routerCreateOpts{
+		CreateOpts: routers.CreateOpts{
+			Description: names.GetDescription(clusterName),
+			Name:        name,
+		},
+	}
+	managedRouter := openStackCluster.Spec.ManagedRouter
+	if managedRouter != nil {
+		if err := s.validateManagedRouterExtensions(managedRouter); err != nil {
+			record.Warnf(openStackCluster, "FailedCreateRouter", "Failed to create router %s: %v", name, err)
+			return nil, err
+		}
+		opts.HA = managedRouter.HA
+		opts.Distributed = managedRouter.Distributed
+		opts.AvailabilityZoneHints = managedRouter.AvailabilityZoneHints
 	}
 	// only set the GatewayInfo right now when no externalIPs
 	// should be configured because at least in our environment
@@ -138,6 +275,9 @@ func (s *Service) createRouter(openStackCluster *infrav1.OpenStackCluster, clust
 		opts.GatewayInfo = &routers.GatewayInfo{
 			NetworkID: openStackCluster.Status.ExternalNetwork.ID,
 		}
+		if managedRouter != nil {
+			opts.GatewayInfo.EnableSNAT = managedRouter.EnableSNAT
+		}
 	}
 
 	router, err := s.client.CreateRouter(opts)
@@ -147,9 +287,9 @@ func (s *Service) createRouter(openStackCluster *infrav1.OpenStackCluster, clust
 	}
 	record.Eventf(openStackCluster, "SuccessfulCreateRouter", "Created router %s with id %s", name, router.ID)
 
-	if len(openStackCluster.Spec.Tags) > 0 {
+	if tags := getClusterTags(openStackCluster); len(tags) > 0 {
 		_, err = s.client.ReplaceAllAttributesTags("routers", router.ID, attributestags.ReplaceAllOpts{
-			Tags: openStackCluster.Spec.Tags,
+			Tags: tags,
 		})
 		if err != nil {
 			return nil, err
@@ -165,6 +305,9 @@ func (s *Service) setRouterExternalIPs(openStackCluster *infrav1.OpenStackCluste
 			NetworkID: openStackCluster.Status.ExternalNetwork.ID,
 		},
 	}
+	if openStackCluster.Spec.ManagedRouter != nil {
+		updateOpts.GatewayInfo.EnableSNAT = openStackCluster.Spec.ManagedRouter.EnableSNAT
+	}
 
 	for i := range openStackCluster.Spec.ExternalRouterIPs {
 		externalRouterIP := openStackCluster.Spec.ExternalRouterIPs[i]
@@ -193,21 +336,21 @@ func (s *Service) setRouterExternalIPs(openStackCluster *infrav1.OpenStackCluste
 }
 
 func (s *Service) DeleteRouter(openStackCluster *infrav1.OpenStackCluster, clusterName string) error {
-	routerName := getRouterName(clusterName)
-	listOpts := routers.ListOpts{Name: routerName}
-	existingRouter := false
-	if openStackCluster.Spec.Router != nil {
-		listOpts = filterconvert.RouterFilterToListOpts(openStackCluster.Spec.Router)
-		existingRouter = true
+	routerName, err := getClusterRouterName(openStackCluster, clusterName)
+	if err != nil {
+		return err
 	}
-
-	router, err := s.getRouterByFilter(listOpts)
+	existingRouter := openStackCluster.Spec.Router != nil
+	router, err := s.getClusterRouter(openStackCluster, routerName)
 	if err != nil {
 		return err
 	}
 
-	subnetName := getSubnetName(clusterName)
-	subnet, err := s.getSubnetByName(subnetName)
+	subnetName, err := getClusterSubnetName(openStackCluster, clusterName)
+	if err != nil {
+		return err
+	}
+	subnet, err := s.getClusterSubnet(openStackCluster, "", subnetName)
 	if err != nil {
 		return err
 	}
@@ -251,6 +394,22 @@ func (s *Service) getRouterInterfaces(routerID string) ([]ports.Port, error) {
 	})
 }
 
+// getClusterRouter returns the router of the cluster, or an empty router if it does not exist.
+// This is either the existing router specified in the spec, or the router created for the cluster.
+func (s *Service) getClusterRouter(openStackCluster *infrav1.OpenStackCluster, routerName string) (routers.Router, error) {
+	if openStackCluster.Spec.Router != nil {
+		return s.getRouterByFilter(filterconvert.RouterFilterToListOpts(openStackCluster.Spec.Router))
+	}
+	return getClusterResource(s, openStackCluster, "routers", routerName, nil,
+		func(tags, name string) ([]routers.Router, error) {
+			return s.client.ListRouter(routers.ListOpts{Name: name, Tags: tags})
+		},
+		func(router routers.Router) (string, []string) {
+			return router.ID, router.Tags
+		},
+	)
+}
+
 func (s *Service) getRouterByFilter(opts routers.ListOpts) (routers.Router, error) {
 	routerList, err := s.client.ListRouter(opts)
 	if err != nil {
@@ -266,25 +425,11 @@ func (s *Service) getRouterByFilter(opts routers.ListOpts) (routers.Router, erro
 	return routers.Router{}, fmt.Errorf("found %d routers, which should not happen", len(routerList))
 }
 
-func (s *Service) getSubnetByName(subnetName string) (subnets.Subnet, error) {
-	opts := subnets.ListOpts{
-		Name: subnetName,
-	}
-
-	subnetList, err := s.client.ListSubnet(opts)
-	if err != nil {
-		return subnets.Subnet{}, err
-	}
-
-	switch len(subnetList) {
-	case 0:
-		return subnets.Subnet{}, nil
-	case 1:
-		return subnetList[0], nil
-	}
-	return subnets.Subnet{}, fmt.Errorf("found %d subnets with the name %s, which should not happen", len(subnetList), subnetName)
-}
-
 func getRouterName(clusterName string) string {
 	return fmt.Sprintf("%s-cluster-%s", networkPrefix, clusterName)
 }
+
+// getClusterRouterName returns the name of the router created for the cluster.
+func getClusterRouterName(openStackCluster *infrav1.OpenStackCluster, clusterName string) (string, error) {
+	return names.GetResourceName(names.GetResourceNaming(openStackCluster).Router, names.GetClusterResourceNameData(openStackCluster), getRouterName(clusterName))
+}
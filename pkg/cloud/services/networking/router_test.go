@@ -0,0 +1,243 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	common "github.com/gophercloud/gophercloud/openstack/common/extensions"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
+)
+
+func Test_ReconcileRouter(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	clusterName := "test-cluster"
+	expectedRouterName := getRouterName(clusterName)
+	fakeRouterID := "6a3e0a9c-e6b7-4c4b-a3de-0c1c7d0cc0d5"
+	fakeNetworkID := "d08803fc-2fa5-4179-b9f7-8c43d0af2fe6"
+	fakeSubnetID := "d08803fc-2fa5-4179-b9f7-8c43d0af2fe7"
+	fakeExternalNetworkID := "d08803fc-2fa5-4179-b9f7-8c43d0af2fe8"
+
+	status := infrav1.OpenStackClusterStatus{
+		Network: &infrav1.NetworkStatusWithSubnets{
+			NetworkStatus: infrav1.NetworkStatus{ID: fakeNetworkID},
+			Subnets:       []infrav1.Subnet{{ID: fakeSubnetID}},
+		},
+		ExternalNetwork: &infrav1.NetworkStatus{ID: fakeExternalNetworkID},
+	}
+	routerInterface := ports.Port{
+		DeviceID: fakeRouterID,
+		FixedIPs: []ports.IP{{SubnetID: fakeSubnetID}},
+	}
+	extraRoute := routers.Route{DestinationCIDR: "192.168.100.0/24", NextHop: "10.0.0.254"}
+	expectExtensions := func(m *mock.MockNetworkClientMockRecorder, aliases ...string) {
+		exts := make([]extensions.Extension, len(aliases))
+		for i := range aliases {
+			exts[i] = extensions.Extension{Extension: common.Extension{Alias: aliases[i]}}
+		}
+		m.ListExtensions().Return(exts, nil)
+	}
+	allExtensions := []string{"ext-gw-mode", "extraroute", "l3-ha", "dvr", "router_availability_zone"}
+
+	tests := []struct {
+		name             string
+		openStackCluster *infrav1.OpenStackCluster
+		expect           func(m *mock.MockNetworkClientMockRecorder)
+		wantErr          bool
+	}{
+		{
+			name: "creates a router with the managed router options",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedRouter: &infrav1.ManagedRouterOptions{
+						EnableSNAT:            pointer.Bool(false),
+						ExtraRoutes:           []infrav1.RouterRoute{{Destination: extraRoute.DestinationCIDR, NextHop: extraRoute.NextHop}},
+						HA:                    pointer.Bool(true),
+						Distributed:           pointer.Bool(false),
+						AvailabilityZoneHints: []string{"az1"},
+					},
+				},
+				Status: status,
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListRouter(routers.ListOpts{Name: expectedRouterName}).Return([]routers.Router{}, nil)
+				expectExtensions(m, allExtensions...)
+				m.CreateRouter(routerCreateOpts{
+					CreateOpts: routers.CreateOpts{
+						Name:        expectedRouterName,
+						Description: names.GetDescription(clusterName),
+						Distributed: pointer.Bool(false),
+						GatewayInfo: &routers.GatewayInfo{
+							NetworkID:  fakeExternalNetworkID,
+							EnableSNAT: pointer.Bool(false),
+						},
+						AvailabilityZoneHints: []string{"az1"},
+					},
+					HA: pointer.Bool(true),
+				}).Return(&routers.Router{
+					ID:   fakeRouterID,
+					Name: expectedRouterName,
+					GatewayInfo: routers.GatewayInfo{
+						NetworkID:  fakeExternalNetworkID,
+						EnableSNAT: pointer.Bool(false),
+					},
+				}, nil)
+				m.ListPort(ports.ListOpts{DeviceID: fakeRouterID}).Return([]ports.Port{}, nil)
+				m.AddRouterInterface(fakeRouterID, routers.AddInterfaceOpts{SubnetID: fakeSubnetID}).Return(&routers.InterfaceInfo{}, nil)
+				expectExtensions(m, allExtensions...)
+				m.UpdateRouter(fakeRouterID, routers.UpdateOpts{
+					Routes: &[]routers.Route{extraRoute},
+				}).Return(&routers.Router{ID: fakeRouterID, Name: expectedRouterName, Routes: []routers.Route{extraRoute}}, nil)
+			},
+		},
+		{
+			name: "does not update an up to date router",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedRouter: &infrav1.ManagedRouterOptions{
+						EnableSNAT:  pointer.Bool(false),
+						ExtraRoutes: []infrav1.RouterRoute{{Destination: extraRoute.DestinationCIDR, NextHop: extraRoute.NextHop}},
+					},
+				},
+				Status: status,
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListRouter(routers.ListOpts{Name: expectedRouterName}).Return([]routers.Router{{
+					ID:   fakeRouterID,
+					Name: expectedRouterName,
+					GatewayInfo: routers.GatewayInfo{
+						NetworkID:  fakeExternalNetworkID,
+						EnableSNAT: pointer.Bool(false),
+					},
+					Routes: []routers.Route{extraRoute},
+				}}, nil)
+				m.ListPort(ports.ListOpts{DeviceID: fakeRouterID}).Return([]ports.Port{routerInterface}, nil)
+			},
+		},
+		{
+			name: "updates SNAT and removes extra routes of an existing router",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedRouter: &infrav1.ManagedRouterOptions{
+						EnableSNAT: pointer.Bool(true),
+					},
+				},
+				Status: status,
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListRouter(routers.ListOpts{Name: expectedRouterName}).Return([]routers.Router{{
+					ID:   fakeRouterID,
+					Name: expectedRouterName,
+					GatewayInfo: routers.GatewayInfo{
+						NetworkID:  fakeExternalNetworkID,
+						EnableSNAT: pointer.Bool(false),
+					},
+					Routes: []routers.Route{extraRoute},
+				}}, nil)
+				m.ListPort(ports.ListOpts{DeviceID: fakeRouterID}).Return([]ports.Port{routerInterface}, nil)
+				expectExtensions(m, allExtensions...)
+				m.UpdateRouter(fakeRouterID, routers.UpdateOpts{
+					GatewayInfo: &routers.GatewayInfo{
+						NetworkID:  fakeExternalNetworkID,
+						EnableSNAT: pointer.Bool(true),
+					},
+					Routes: &[]routers.Route{},
+				}).Return(&routers.Router{ID: fakeRouterID, Name: expectedRouterName}, nil)
+			},
+		},
+		{
+			name: "does not remove extra routes without managed router options",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec:   infrav1.OpenStackClusterSpec{},
+				Status: status,
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListRouter(routers.ListOpts{Name: expectedRouterName}).Return([]routers.Router{{
+					ID:     fakeRouterID,
+					Name:   expectedRouterName,
+					Routes: []routers.Route{extraRoute},
+				}}, nil)
+				m.ListPort(ports.ListOpts{DeviceID: fakeRouterID}).Return([]ports.Port{routerInterface}, nil)
+			},
+		},
+		{
+			name: "fails to create a router requiring an unsupported extension",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedRouter: &infrav1.ManagedRouterOptions{
+						HA: pointer.Bool(true),
+					},
+				},
+				Status: status,
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListRouter(routers.ListOpts{Name: expectedRouterName}).Return([]routers.Router{}, nil)
+				expectExtensions(m, "ext-gw-mode")
+			},
+			wantErr: true,
+		},
+		{
+			name: "does not update a pre-existing router",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					Router: &infrav1.RouterFilter{ID: fakeRouterID},
+				},
+				Status: status,
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListRouter(routers.ListOpts{ID: fakeRouterID}).Return([]routers.Router{{
+					ID:     fakeRouterID,
+					Routes: []routers.Route{extraRoute},
+				}}, nil)
+				m.ListPort(ports.ListOpts{DeviceID: fakeRouterID}).Return([]ports.Port{routerInterface}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			tt.expect(mockClient.EXPECT())
+
+			scopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			log := testr.New(t)
+			s := Service{
+				client: mockClient,
+				scope:  scope.NewWithLogger(scopeFactory, log),
+			}
+			err := s.ReconcileRouter(tt.openStackCluster, clusterName)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+	}
+}
@@ -18,14 +18,19 @@ package networking
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"k8s.io/utils/pointer"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
 
 const (
@@ -45,21 +50,18 @@ func (s *Service) ReconcileSecurityGroups(openStackCluster *infrav1.OpenStackClu
 		return nil
 	}
 
-	secControlPlaneGroupName := getSecControlPlaneGroupName(clusterName)
-	secWorkerGroupName := getSecWorkerGroupName(clusterName)
-	secGroupNames := map[string]string{
-		controlPlaneSuffix: secControlPlaneGroupName,
-		workerSuffix:       secWorkerGroupName,
+	if err := s.validateManagedSecurityGroupsExtensions(openStackCluster.Spec.ManagedSecurityGroups); err != nil {
+		return err
 	}
 
-	if openStackCluster.Spec.Bastion != nil && openStackCluster.Spec.Bastion.Enabled {
-		secBastionGroupName := getSecBastionGroupName(clusterName)
-		secGroupNames[bastionSuffix] = secBastionGroupName
+	secGroupNames, err := getClusterSecGroupNames(openStackCluster, clusterName)
+	if err != nil {
+		return err
 	}
 
 	// create security groups first, because desired rules use group ids.
-	for _, v := range secGroupNames {
-		if err := s.createSecurityGroupIfNotExists(openStackCluster, v); err != nil {
+	for k, v := range secGroupNames {
+		if err := s.createSecurityGroupIfNotExists(openStackCluster, k, v); err != nil {
 			return err
 		}
 	}
@@ -72,7 +74,7 @@ func (s *Service) ReconcileSecurityGroups(openStackCluster *infrav1.OpenStackClu
 	observedSecGroups := make(map[string]*infrav1.SecurityGroupStatus)
 	for k, desiredSecGroup := range desiredSecGroups {
 		var err error
-		observedSecGroups[k], err = s.getSecurityGroupByName(desiredSecGroup.Name)
+		observedSecGroups[k], err = s.getClusterSecurityGroup(openStackCluster, k, desiredSecGroup.Name)
 
 		if err != nil {
 			return err
@@ -95,12 +97,44 @@ func (s *Service) ReconcileSecurityGroups(openStackCluster *infrav1.OpenStackClu
 	return nil
 }
 
+// validateManagedSecurityGroupsExtensions returns an error if the managed
+// security groups use a feature requiring a Neutron API extension which is
+// not available.
+func (s *Service) validateManagedSecurityGroupsExtensions(managedSecurityGroups *infrav1.ManagedSecurityGroups) error {
+	var required []string
+	if managedSecurityGroups.Stateful != nil {
+		required = append(required, "stateful-security-group")
+	}
+	allRules := slices.Concat(
+		managedSecurityGroups.AllNodesSecurityGroupRules,
+		managedSecurityGroups.ControlPlaneNodesSecurityGroupRules,
+		managedSecurityGroups.WorkerNodesSecurityGroupRules,
+		managedSecurityGroups.BastionSecurityGroupRules,
+	)
+	if slices.ContainsFunc(allRules, func(rule infrav1.SecurityGroupRuleSpec) bool { return rule.RemoteAddressGroup != nil }) {
+		required = append(required, "address-group")
+	}
+
+	missing, err := s.getUnsupportedExtensions(required)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("managedSecurityGroups requires the unsupported neutron API extensions: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
 type securityGroupSpec struct {
-	Name  string
-	Rules []resolvedSecurityGroupRuleSpec
+	Name string
+	// Stateful is whether the security group should be stateful. It is not reconciled if nil.
+	Stateful *bool
+	Rules    []resolvedSecurityGroupRuleSpec
 }
 
 type resolvedSecurityGroupRuleSpec struct {
+	// Name identifies the rule so that it can be overridden. It is not sent to OpenStack.
+	Name           string `json:"name,omitempty"`
 	Description    string `json:"description,omitempty"`
 	Direction      string `json:"direction,omitempty"`
 	EtherType      string `json:"etherType,omitempty"`
@@ -109,6 +143,8 @@ type resolvedSecurityGroupRuleSpec struct {
 	Protocol       string `json:"protocol,omitempty"`
 	RemoteGroupID  string `json:"remoteGroupID,omitempty"`
 	RemoteIPPrefix string `json:"remoteIPPrefix,omitempty"`
+	// RemoteAddressGroupID is the ID of the address group resolved from the remoteAddressGroup filter.
+	RemoteAddressGroupID string `json:"remoteAddressGroupID,omitempty"`
 }
 
 func (r resolvedSecurityGroupRuleSpec) Matches(other infrav1.SecurityGroupRuleStatus) bool {
@@ -119,7 +155,8 @@ func (r resolvedSecurityGroupRuleSpec) Matches(other infrav1.SecurityGroupRuleSt
 		r.PortRangeMax == *other.PortRangeMax &&
 		r.Protocol == *other.Protocol &&
 		r.RemoteGroupID == *other.RemoteGroupID &&
-		r.RemoteIPPrefix == *other.RemoteIPPrefix
+		r.RemoteIPPrefix == *other.RemoteIPPrefix &&
+		r.RemoteAddressGroupID == pointer.StringDeref(other.RemoteAddressGroupID, "")
 }
 
 func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCluster, secGroupNames map[string]string) (map[string]securityGroupSpec, error) {
@@ -140,7 +177,7 @@ func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCl
 	remoteManagedGroups := make(map[string]string)
 
 	for i, v := range secGroupNames {
-		secGroup, err := s.getSecurityGroupByName(v)
+		secGroup, err := s.getClusterSecurityGroup(openStackCluster, i, v)
 		if err != nil {
 			return desiredSecGroups, err
 		}
@@ -178,57 +215,78 @@ func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCl
 		workerRules = append(workerRules, getSGWorkerGeneral(remoteGroupIDSelf, secControlPlaneGroupID)...)
 	}
 
+	bastionEnabled := openStackCluster.Spec.Bastion != nil && openStackCluster.Spec.Bastion.Enabled
+	if bastionEnabled {
+		controlPlaneRules = append(controlPlaneRules, getSGControlPlaneSSH(secBastionGroupID)...)
+		workerRules = append(workerRules, getSGWorkerSSH(secBastionGroupID)...)
+	}
+
+	managedSecurityGroups := openStackCluster.Spec.ManagedSecurityGroups
+
 	// For now, we do not create a separate security group for allNodes.
 	// Instead, we append the rules for allNodes to the control plane and worker security groups.
-	allNodesRules, err := getAllNodesRules(remoteManagedGroups, openStackCluster.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules)
+	allNodesRules, err := s.resolveSecurityGroupRules(remoteManagedGroups, managedSecurityGroups.AllNodesSecurityGroupRules)
+	if err != nil {
+		return desiredSecGroups, err
+	}
+	controlPlaneNodesRules, err := s.resolveSecurityGroupRules(remoteManagedGroups, managedSecurityGroups.ControlPlaneNodesSecurityGroupRules)
+	if err != nil {
+		return desiredSecGroups, err
+	}
+	workerNodesRules, err := s.resolveSecurityGroupRules(remoteManagedGroups, managedSecurityGroups.WorkerNodesSecurityGroupRules)
 	if err != nil {
 		return desiredSecGroups, err
 	}
-	controlPlaneRules = append(controlPlaneRules, allNodesRules...)
-	workerRules = append(workerRules, allNodesRules...)
 
-	if openStackCluster.Spec.Bastion != nil && openStackCluster.Spec.Bastion.Enabled {
-		controlPlaneRules = append(controlPlaneRules, getSGControlPlaneSSH(secBastionGroupID)...)
-		workerRules = append(workerRules, getSGWorkerSSH(secBastionGroupID)...)
+	// Rules for all nodes are added to the default rules, as they always were.
+	// Rules for a single role replace the default rules and the rules for all
+	// nodes with the same name.
+	controlPlaneRules = overrideRules(append(controlPlaneRules, allNodesRules...), controlPlaneNodesRules)
+	workerRules = overrideRules(append(workerRules, allNodesRules...), workerNodesRules)
+
+	if bastionEnabled {
+		bastionRules, err := s.resolveSecurityGroupRules(remoteManagedGroups, managedSecurityGroups.BastionSecurityGroupRules)
+		if err != nil {
+			return desiredSecGroups, err
+		}
 
 		desiredSecGroups[bastionSuffix] = securityGroupSpec{
-			Name: secGroupNames[bastionSuffix],
-			Rules: append(
-				[]resolvedSecurityGroupRuleSpec{
-					{
-						Description:  "SSH",
-						Direction:    "ingress",
-						EtherType:    "IPv4",
-						PortRangeMin: 22,
-						PortRangeMax: 22,
-						Protocol:     "tcp",
-					},
-				},
-				defaultRules...,
+			Name:     secGroupNames[bastionSuffix],
+			Stateful: managedSecurityGroups.Stateful,
+			Rules: overrideRules(
+				append(getSGBastionSSH(openStackCluster.Spec.Bastion.AllowedCIDRs), defaultRules...),
+				bastionRules,
 			),
 		}
 	}
 
 	desiredSecGroups[controlPlaneSuffix] = securityGroupSpec{
-		Name:  secGroupNames[controlPlaneSuffix],
-		Rules: controlPlaneRules,
+		Name:     secGroupNames[controlPlaneSuffix],
+		Stateful: managedSecurityGroups.Stateful,
+		Rules:    controlPlaneRules,
 	}
 
 	desiredSecGroups[workerSuffix] = securityGroupSpec{
-		Name:  secGroupNames[workerSuffix],
-		Rules: workerRules,
+		Name:     secGroupNames[workerSuffix],
+		Stateful: managedSecurityGroups.Stateful,
+		Rules:    workerRules,
 	}
 	return desiredSecGroups, nil
 }
 
-// getAllNodesRules returns the rules for the allNodes security group that should be created.
-func getAllNodesRules(remoteManagedGroups map[string]string, allNodesSecurityGroupRules []infrav1.SecurityGroupRuleSpec) ([]resolvedSecurityGroupRuleSpec, error) {
-	rules := make([]resolvedSecurityGroupRuleSpec, 0, len(allNodesSecurityGroupRules))
-	for _, rule := range allNodesSecurityGroupRules {
-		if err := validateRemoteManagedGroups(remoteManagedGroups, rule.RemoteManagedGroups); err != nil {
-			return nil, err
+// resolveSecurityGroupRules resolves the given security group rules, replacing
+// remote managed groups with the IDs of the managed security groups and remote
+// address groups with the IDs of the address groups they match.
+func (s *Service) resolveSecurityGroupRules(remoteManagedGroups map[string]string, securityGroupRules []infrav1.SecurityGroupRuleSpec) ([]resolvedSecurityGroupRuleSpec, error) {
+	rules := make([]resolvedSecurityGroupRuleSpec, 0, len(securityGroupRules))
+	for _, rule := range securityGroupRules {
+		if len(rule.RemoteManagedGroups) > 0 {
+			if err := validateRemoteManagedGroups(remoteManagedGroups, rule.RemoteManagedGroups); err != nil {
+				return nil, err
+			}
 		}
 		r := resolvedSecurityGroupRuleSpec{
+			Name:      rule.Name,
 			Direction: rule.Direction,
 		}
 		if rule.Description != nil {
@@ -252,6 +310,16 @@ func getAllNodesRules(remoteManagedGroups map[string]string, allNodesSecurityGro
 		if rule.RemoteIPPrefix != nil {
 			r.RemoteIPPrefix = *rule.RemoteIPPrefix
 		}
+		if rule.RemoteAddressGroup != nil {
+			if len(rule.RemoteManagedGroups) > 0 || rule.RemoteGroupID != nil || rule.RemoteIPPrefix != nil {
+				return nil, fmt.Errorf("remoteAddressGroup must not be set with remoteManagedGroups, remoteGroupID or remoteIPPrefix")
+			}
+			addressGroupID, err := s.getAddressGroupID(rule.RemoteAddressGroup)
+			if err != nil {
+				return nil, err
+			}
+			r.RemoteAddressGroupID = addressGroupID
+		}
 
 		if len(rule.RemoteManagedGroups) > 0 {
 			if rule.RemoteGroupID != nil {
@@ -270,6 +338,46 @@ func getAllNodesRules(remoteManagedGroups map[string]string, allNodesSecurityGro
 	return rules, nil
 }
 
+// getAddressGroupID returns the ID of the address group matching the filter.
+func (s *Service) getAddressGroupID(filter *infrav1.AddressGroupFilter) (string, error) {
+	if filter.ID != "" {
+		return filter.ID, nil
+	}
+
+	addressGroups, err := s.client.ListAddressGroup(clients.AddressGroupListOpts{
+		Name:        filter.Name,
+		Description: filter.Description,
+		ProjectID:   filter.ProjectID,
+	})
+	if err != nil {
+		return "", err
+	}
+	switch len(addressGroups) {
+	case 0:
+		return "", fmt.Errorf("no address group could be found with the filter provided")
+	case 1:
+		return addressGroups[0].ID, nil
+	}
+	return "", fmt.Errorf("found %d address groups with the filter provided, expected exactly one", len(addressGroups))
+}
+
+// overrideRules returns rules with the rules named like one of overrides
+// replaced by overrides.
+func overrideRules(rules, overrides []resolvedSecurityGroupRuleSpec) []resolvedSecurityGroupRuleSpec {
+	overridden := make(map[string]bool, len(overrides))
+	for _, rule := range overrides {
+		overridden[rule.Name] = true
+	}
+
+	result := make([]resolvedSecurityGroupRuleSpec, 0, len(rules)+len(overrides))
+	for _, rule := range rules {
+		if !overridden[rule.Name] {
+			result = append(result, rule)
+		}
+	}
+	return append(result, overrides...)
+}
+
 // validateRemoteManagedGroups validates that the remoteManagedGroups target existing managed security groups.
 func validateRemoteManagedGroups(remoteManagedGroups map[string]string, ruleRemoteManagedGroups []infrav1.ManagedSecurityGroupName) error {
 	if len(ruleRemoteManagedGroups) == 0 {
@@ -322,17 +430,13 @@ func (s *Service) GetSecurityGroups(securityGroupParams []infrav1.SecurityGroupF
 }
 
 func (s *Service) DeleteSecurityGroups(openStackCluster *infrav1.OpenStackCluster, clusterName string) error {
-	secGroupNames := []string{
-		getSecControlPlaneGroupName(clusterName),
-		getSecWorkerGroupName(clusterName),
-	}
-
-	if openStackCluster.Spec.Bastion != nil && openStackCluster.Spec.Bastion.Enabled {
-		secGroupNames = append(secGroupNames, getSecBastionGroupName(clusterName))
+	secGroupNames, err := getClusterSecGroupNames(openStackCluster, clusterName)
+	if err != nil {
+		return err
 	}
 
-	for _, secGroupName := range secGroupNames {
-		if err := s.deleteSecurityGroup(openStackCluster, secGroupName); err != nil {
+	for role, secGroupName := range secGroupNames {
+		if err := s.deleteSecurityGroup(openStackCluster, role, secGroupName); err != nil {
 			return err
 		}
 	}
@@ -340,8 +444,8 @@ func (s *Service) DeleteSecurityGroups(openStackCluster *infrav1.OpenStackCluste
 	return nil
 }
 
-func (s *Service) deleteSecurityGroup(openStackCluster *infrav1.OpenStackCluster, name string) error {
-	group, err := s.getSecurityGroupByName(name)
+func (s *Service) deleteSecurityGroup(openStackCluster *infrav1.OpenStackCluster, role, name string) error {
+	group, err := s.getClusterSecurityGroup(openStackCluster, role, name)
 	if err != nil {
 		return err
 	}
@@ -362,6 +466,19 @@ func (s *Service) deleteSecurityGroup(openStackCluster *infrav1.OpenStackCluster
 // reconcileGroupRules reconciles an already existing observed group by deleting rules not needed anymore and
 // creating rules that are missing.
 func (s *Service) reconcileGroupRules(desired securityGroupSpec, observed infrav1.SecurityGroupStatus) (infrav1.SecurityGroupStatus, error) {
+	// Neutron only reports the stateful attribute if it supports stateless security groups.
+	if desired.Stateful != nil && observed.Stateful != nil && *desired.Stateful != *observed.Stateful {
+		s.scope.Logger().V(4).Info("Updating stateful attribute of group", "name", observed.Name, "stateful", *desired.Stateful)
+		group, err := s.client.UpdateSecGroup(observed.ID, clients.SecGroupUpdateOptsExt{
+			UpdateOptsBuilder: groups.UpdateOpts{},
+			Stateful:          desired.Stateful,
+		})
+		if err != nil {
+			return infrav1.SecurityGroupStatus{}, err
+		}
+		observed.Stateful = group.Stateful
+	}
+
 	var rulesToDelete []string
 	// fills rulesToDelete by calculating observed - desired
 	for _, observedRule := range observed.Rules {
@@ -434,18 +551,24 @@ func (s *Service) reconcileGroupRules(desired securityGroupSpec, observed infrav
 	return observed, nil
 }
 
-func (s *Service) createSecurityGroupIfNotExists(openStackCluster *infrav1.OpenStackCluster, groupName string) error {
-	secGroup, err := s.getSecurityGroupByName(groupName)
+func (s *Service) createSecurityGroupIfNotExists(openStackCluster *infrav1.OpenStackCluster, role, groupName string) error {
+	secGroup, err := s.getClusterSecurityGroup(openStackCluster, role, groupName)
 	if err != nil {
 		return err
 	}
 	if secGroup == nil || secGroup.ID == "" {
 		s.scope.Logger().V(6).Info("Group doesn't exist, creating it", "name", groupName)
 
-		createOpts := groups.CreateOpts{
+		var createOpts groups.CreateOptsBuilder = groups.CreateOpts{
 			Name:        groupName,
 			Description: "Cluster API managed group",
 		}
+		if stateful := openStackCluster.Spec.ManagedSecurityGroups.Stateful; stateful != nil {
+			createOpts = clients.SecGroupCreateOptsExt{
+				CreateOptsBuilder: createOpts,
+				Stateful:          stateful,
+			}
+		}
 		s.scope.Logger().V(6).Info("Creating group", "name", groupName)
 
 		group, err := s.client.CreateSecGroup(createOpts)
@@ -454,9 +577,9 @@ func (s *Service) createSecurityGroupIfNotExists(openStackCluster *infrav1.OpenS
 			return err
 		}
 
-		if len(openStackCluster.Spec.Tags) > 0 {
+		if tags := getClusterTags(openStackCluster, names.GetSecurityGroupRoleTag(role)); len(tags) > 0 {
 			_, err = s.client.ReplaceAllAttributesTags("security-groups", group.ID, attributestags.ReplaceAllOpts{
-				Tags: openStackCluster.Spec.Tags,
+				Tags: tags,
 			})
 			if err != nil {
 				return err
@@ -473,25 +596,25 @@ func (s *Service) createSecurityGroupIfNotExists(openStackCluster *infrav1.OpenS
 	return nil
 }
 
-func (s *Service) getSecurityGroupByName(name string) (*infrav1.SecurityGroupStatus, error) {
-	opts := groups.ListOpts{
-		Name: name,
-	}
-
+// getClusterSecurityGroup returns the security group with the given role created for the cluster.
+// An empty security group status is returned if it does not exist.
+func (s *Service) getClusterSecurityGroup(openStackCluster *infrav1.OpenStackCluster, role, name string) (*infrav1.SecurityGroupStatus, error) {
 	s.scope.Logger().V(6).Info("Attempting to fetch security group with", "name", name)
-	allGroups, err := s.client.ListSecGroup(opts)
+	group, err := getClusterResource(s, openStackCluster, "security-groups", name, []string{names.GetSecurityGroupRoleTag(role)},
+		func(tags, name string) ([]clients.SecGroupExt, error) {
+			return s.client.ListSecGroup(groups.ListOpts{Name: name, Tags: tags})
+		},
+		func(group clients.SecGroupExt) (string, []string) {
+			return group.ID, group.Tags
+		},
+	)
 	if err != nil {
 		return &infrav1.SecurityGroupStatus{}, err
 	}
-
-	switch len(allGroups) {
-	case 0:
+	if group.ID == "" {
 		return &infrav1.SecurityGroupStatus{}, nil
-	case 1:
-		return convertOSSecGroupToConfigSecGroup(allGroups[0]), nil
 	}
-
-	return &infrav1.SecurityGroupStatus{}, fmt.Errorf("more than one security group found named: %s", name)
+	return convertOSSecGroupToConfigSecGroup(group), nil
 }
 
 func (s *Service) createRule(securityGroupID string, r resolvedSecurityGroupRuleSpec) (infrav1.SecurityGroupRuleStatus, error) {
@@ -499,7 +622,7 @@ func (s *Service) createRule(securityGroupID string, r resolvedSecurityGroupRule
 	proto := rules.RuleProtocol(r.Protocol)
 	etherType := rules.RuleEtherType(r.EtherType)
 
-	createOpts := rules.CreateOpts{
+	var createOpts rules.CreateOptsBuilder = rules.CreateOpts{
 		Description:    r.Description,
 		Direction:      dir,
 		PortRangeMin:   r.PortRangeMin,
@@ -510,7 +633,13 @@ func (s *Service) createRule(securityGroupID string, r resolvedSecurityGroupRule
 		RemoteIPPrefix: r.RemoteIPPrefix,
 		SecGroupID:     securityGroupID,
 	}
-	s.scope.Logger().V(6).Info("Creating rule", "description", r.Description, "direction", dir, "portRangeMin", r.PortRangeMin, "portRangeMax", r.PortRangeMax, "proto", proto, "etherType", etherType, "remoteGroupID", r.RemoteGroupID, "remoteIPPrefix", r.RemoteIPPrefix, "securityGroupID", securityGroupID)
+	if r.RemoteAddressGroupID != "" {
+		createOpts = clients.SecGroupRuleCreateOptsExt{
+			CreateOptsBuilder:    createOpts,
+			RemoteAddressGroupID: r.RemoteAddressGroupID,
+		}
+	}
+	s.scope.Logger().V(6).Info("Creating rule", "description", r.Description, "direction", dir, "portRangeMin", r.PortRangeMin, "portRangeMax", r.PortRangeMax, "proto", proto, "etherType", etherType, "remoteGroupID", r.RemoteGroupID, "remoteIPPrefix", r.RemoteIPPrefix, "remoteAddressGroupID", r.RemoteAddressGroupID, "securityGroupID", securityGroupID)
 	rule, err := s.client.CreateSecGroupRule(createOpts)
 	if err != nil {
 		return infrav1.SecurityGroupRuleStatus{}, err
@@ -518,6 +647,29 @@ func (s *Service) createRule(securityGroupID string, r resolvedSecurityGroupRule
 	return convertOSSecGroupRuleToConfigSecGroupRule(*rule), nil
 }
 
+// getClusterSecGroupNames returns the names of the managed security groups of the cluster by role.
+func getClusterSecGroupNames(openStackCluster *infrav1.OpenStackCluster, clusterName string) (map[string]string, error) {
+	defaultNames := map[string]string{
+		controlPlaneSuffix: getSecControlPlaneGroupName(clusterName),
+		workerSuffix:       getSecWorkerGroupName(clusterName),
+	}
+	if openStackCluster.Spec.Bastion != nil && openStackCluster.Spec.Bastion.Enabled {
+		defaultNames[bastionSuffix] = getSecBastionGroupName(clusterName)
+	}
+
+	secGroupNames := make(map[string]string, len(defaultNames))
+	for role, defaultName := range defaultNames {
+		data := names.GetClusterResourceNameData(openStackCluster)
+		data.Role = role
+		name, err := names.GetResourceName(names.GetResourceNaming(openStackCluster).SecurityGroup, data, defaultName)
+		if err != nil {
+			return nil, err
+		}
+		secGroupNames[role] = name
+	}
+	return secGroupNames, nil
+}
+
 func getSecControlPlaneGroupName(clusterName string) string {
 	return fmt.Sprintf("%s-cluster-%s-secgroup-%s", secGroupPrefix, clusterName, controlPlaneSuffix)
 }
@@ -530,20 +682,21 @@ func getSecBastionGroupName(clusterName string) string {
 	return fmt.Sprintf("%s-cluster-%s-secgroup-%s", secGroupPrefix, clusterName, bastionSuffix)
 }
 
-func convertOSSecGroupToConfigSecGroup(osSecGroup groups.SecGroup) *infrav1.SecurityGroupStatus {
+func convertOSSecGroupToConfigSecGroup(osSecGroup clients.SecGroupExt) *infrav1.SecurityGroupStatus {
 	securityGroupRules := make([]infrav1.SecurityGroupRuleStatus, len(osSecGroup.Rules))
 	for i, rule := range osSecGroup.Rules {
 		securityGroupRules[i] = convertOSSecGroupRuleToConfigSecGroupRule(rule)
 	}
 	return &infrav1.SecurityGroupStatus{
-		ID:    osSecGroup.ID,
-		Name:  osSecGroup.Name,
-		Rules: securityGroupRules,
+		ID:       osSecGroup.ID,
+		Name:     osSecGroup.Name,
+		Stateful: osSecGroup.Stateful,
+		Rules:    securityGroupRules,
 	}
 }
 
-func convertOSSecGroupRuleToConfigSecGroupRule(osSecGroupRule rules.SecGroupRule) infrav1.SecurityGroupRuleStatus {
-	return infrav1.SecurityGroupRuleStatus{
+func convertOSSecGroupRuleToConfigSecGroupRule(osSecGroupRule clients.SecGroupRuleExt) infrav1.SecurityGroupRuleStatus {
+	status := infrav1.SecurityGroupRuleStatus{
 		ID:             osSecGroupRule.ID,
 		Direction:      osSecGroupRule.Direction,
 		Description:    &osSecGroupRule.Description,
@@ -554,6 +707,10 @@ func convertOSSecGroupRuleToConfigSecGroupRule(osSecGroupRule rules.SecGroupRule
 		RemoteGroupID:  &osSecGroupRule.RemoteGroupID,
 		RemoteIPPrefix: &osSecGroupRule.RemoteIPPrefix,
 	}
+	if osSecGroupRule.RemoteAddressGroupID != "" {
+		status.RemoteAddressGroupID = &osSecGroupRule.RemoteAddressGroupID
+	}
+	return status
 }
 
 func isDuplicate(list []string, name string) bool {
@@ -16,8 +16,14 @@ limitations under the License.
 
 package networking
 
+import (
+	"fmt"
+	"net"
+)
+
 var defaultRules = []resolvedSecurityGroupRuleSpec{
 	{
+		Name:           "egress-ipv4",
 		Direction:      "egress",
 		Description:    "Full open",
 		EtherType:      "IPv4",
@@ -27,6 +33,7 @@ var defaultRules = []resolvedSecurityGroupRuleSpec{
 		RemoteIPPrefix: "",
 	},
 	{
+		Name:           "egress-ipv6",
 		Direction:      "egress",
 		Description:    "Full open",
 		EtherType:      "IPv6",
@@ -41,6 +48,7 @@ var defaultRules = []resolvedSecurityGroupRuleSpec{
 func getSGControlPlaneCommon(remoteGroupIDSelf, secWorkerGroupID string) []resolvedSecurityGroupRuleSpec {
 	return []resolvedSecurityGroupRuleSpec{
 		{
+			Name:          "etcd",
 			Description:   "Etcd",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -51,6 +59,7 @@ func getSGControlPlaneCommon(remoteGroupIDSelf, secWorkerGroupID string) []resol
 		},
 		{
 			// kubeadm says this is needed
+			Name:          "kubelet-api",
 			Description:   "Kubelet API",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -61,6 +70,7 @@ func getSGControlPlaneCommon(remoteGroupIDSelf, secWorkerGroupID string) []resol
 		},
 		{
 			// This is needed to support metrics-server deployments
+			Name:          "kubelet-api-worker",
 			Description:   "Kubelet API",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -77,6 +87,7 @@ func getSGWorkerCommon(remoteGroupIDSelf, secControlPlaneGroupID string) []resol
 	return []resolvedSecurityGroupRuleSpec{
 		{
 			// This is needed to support metrics-server deployments
+			Name:          "kubelet-api",
 			Description:   "Kubelet API",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -86,6 +97,7 @@ func getSGWorkerCommon(remoteGroupIDSelf, secControlPlaneGroupID string) []resol
 			RemoteGroupID: remoteGroupIDSelf,
 		},
 		{
+			Name:          "kubelet-api-controlplane",
 			Description:   "Kubelet API",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -101,6 +113,7 @@ func getSGWorkerCommon(remoteGroupIDSelf, secControlPlaneGroupID string) []resol
 func getSGControlPlaneSSH(secBastionGroupID string) []resolvedSecurityGroupRuleSpec {
 	return []resolvedSecurityGroupRuleSpec{
 		{
+			Name:          "ssh-bastion",
 			Description:   "SSH",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -116,6 +129,7 @@ func getSGControlPlaneSSH(secBastionGroupID string) []resolvedSecurityGroupRuleS
 func getSGWorkerSSH(secBastionGroupID string) []resolvedSecurityGroupRuleSpec {
 	return []resolvedSecurityGroupRuleSpec{
 		{
+			Name:          "ssh-bastion",
 			Description:   "SSH",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -127,10 +141,38 @@ func getSGWorkerSSH(secBastionGroupID string) []resolvedSecurityGroupRuleSpec {
 	}
 }
 
+// Permit ssh to the bastion from the allowed CIDRs, or from anywhere if none are given.
+func getSGBastionSSH(allowedCIDRs []string) []resolvedSecurityGroupRuleSpec {
+	rule := resolvedSecurityGroupRuleSpec{
+		Name:         "ssh",
+		Description:  "SSH",
+		Direction:    "ingress",
+		EtherType:    "IPv4",
+		PortRangeMin: 22,
+		PortRangeMax: 22,
+		Protocol:     "tcp",
+	}
+	if len(allowedCIDRs) == 0 {
+		return []resolvedSecurityGroupRuleSpec{rule}
+	}
+
+	bastionRules := make([]resolvedSecurityGroupRuleSpec, 0, len(allowedCIDRs))
+	for _, cidr := range allowedCIDRs {
+		r := rule
+		if ip, _, err := net.ParseCIDR(cidr); err == nil && ip.To4() == nil {
+			r.EtherType = "IPv6"
+		}
+		r.RemoteIPPrefix = cidr
+		bastionRules = append(bastionRules, r)
+	}
+	return bastionRules
+}
+
 // Allow all traffic, including from outside the cluster, to access the API.
 func getSGControlPlaneHTTPS() []resolvedSecurityGroupRuleSpec {
 	return []resolvedSecurityGroupRuleSpec{
 		{
+			Name:         "kubernetes-api",
 			Description:  "Kubernetes API",
 			Direction:    "ingress",
 			EtherType:    "IPv4",
@@ -145,6 +187,7 @@ func getSGControlPlaneHTTPS() []resolvedSecurityGroupRuleSpec {
 func getSGWorkerNodePort() []resolvedSecurityGroupRuleSpec {
 	return []resolvedSecurityGroupRuleSpec{
 		{
+			Name:         "node-port-tcp",
 			Description:  "Node Port Services",
 			Direction:    "ingress",
 			EtherType:    "IPv4",
@@ -153,6 +196,7 @@ func getSGWorkerNodePort() []resolvedSecurityGroupRuleSpec {
 			Protocol:     "tcp",
 		},
 		{
+			Name:         "node-port-udp",
 			Description:  "Node Port Services",
 			Direction:    "ingress",
 			EtherType:    "IPv4",
@@ -167,6 +211,7 @@ func getSGWorkerNodePort() []resolvedSecurityGroupRuleSpec {
 func getSGControlPlaneAllowAll(remoteGroupIDSelf, secWorkerGroupID string) []resolvedSecurityGroupRuleSpec {
 	return []resolvedSecurityGroupRuleSpec{
 		{
+			Name:          "in-cluster-ingress",
 			Description:   "In-cluster Ingress",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -176,6 +221,7 @@ func getSGControlPlaneAllowAll(remoteGroupIDSelf, secWorkerGroupID string) []res
 			RemoteGroupID: remoteGroupIDSelf,
 		},
 		{
+			Name:          "in-cluster-ingress-worker",
 			Description:   "In-cluster Ingress",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -191,6 +237,7 @@ func getSGControlPlaneAllowAll(remoteGroupIDSelf, secWorkerGroupID string) []res
 func getSGWorkerAllowAll(remoteGroupIDSelf, secControlPlaneGroupID string) []resolvedSecurityGroupRuleSpec {
 	return []resolvedSecurityGroupRuleSpec{
 		{
+			Name:          "in-cluster-ingress",
 			Description:   "In-cluster Ingress",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -200,6 +247,7 @@ func getSGWorkerAllowAll(remoteGroupIDSelf, secControlPlaneGroupID string) []res
 			RemoteGroupID: remoteGroupIDSelf,
 		},
 		{
+			Name:          "in-cluster-ingress-controlplane",
 			Description:   "In-cluster Ingress",
 			Direction:     "ingress",
 			EtherType:     "IPv4",
@@ -213,20 +261,17 @@ func getSGWorkerAllowAll(remoteGroupIDSelf, secControlPlaneGroupID string) []res
 
 // Permit ports that defined in openStackCluster.Spec.APIServerLoadBalancer.AdditionalPorts.
 func getSGControlPlaneAdditionalPorts(ports []int) []resolvedSecurityGroupRuleSpec {
-	controlPlaneRules := []resolvedSecurityGroupRuleSpec{}
-
-	r := []resolvedSecurityGroupRuleSpec{
-		{
-			Description: "Additional ports",
-			Direction:   "ingress",
-			EtherType:   "IPv4",
-			Protocol:    "tcp",
-		},
-	}
-	for i, p := range ports {
-		r[i].PortRangeMin = p
-		r[i].PortRangeMax = p
-		controlPlaneRules = append(controlPlaneRules, r...)
+	controlPlaneRules := make([]resolvedSecurityGroupRuleSpec, 0, len(ports))
+	for _, p := range ports {
+		controlPlaneRules = append(controlPlaneRules, resolvedSecurityGroupRuleSpec{
+			Name:         fmt.Sprintf("additional-port-%d", p),
+			Description:  "Additional ports",
+			Direction:    "ingress",
+			EtherType:    "IPv4",
+			PortRangeMin: p,
+			PortRangeMax: p,
+			Protocol:     "tcp",
+		})
 	}
 	return controlPlaneRules
 }
@@ -21,12 +21,15 @@ import (
 
 	"github.com/go-logr/logr/testr"
 	"github.com/golang/mock/gomock"
+	common "github.com/gophercloud/gophercloud/openstack/common/extensions"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 	. "github.com/onsi/gomega"
 	"k8s.io/utils/pointer"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
 )
@@ -97,11 +100,15 @@ func TestValidateRemoteManagedGroups(t *testing.T) {
 	}
 }
 
-func TestGetAllNodesRules(t *testing.T) {
+func TestResolveSecurityGroupRules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
 	tests := []struct {
 		name                       string
 		remoteManagedGroups        map[string]string
 		allNodesSecurityGroupRules []infrav1.SecurityGroupRuleSpec
+		expect                     func(m *mock.MockNetworkClientMockRecorder)
 		wantRules                  []resolvedSecurityGroupRuleSpec
 		wantErr                    bool
 	}{
@@ -168,6 +175,28 @@ func TestGetAllNodesRules(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                "Valid rule with remoteIPPrefix",
+			remoteManagedGroups: map[string]string{},
+			allNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+				{
+					Name:           "metrics",
+					Protocol:       pointer.String("tcp"),
+					PortRangeMin:   pointer.Int(9100),
+					PortRangeMax:   pointer.Int(9100),
+					RemoteIPPrefix: pointer.String("10.0.0.0/8"),
+				},
+			},
+			wantRules: []resolvedSecurityGroupRuleSpec{
+				{
+					Name:           "metrics",
+					Protocol:       "tcp",
+					PortRangeMin:   9100,
+					PortRangeMax:   9100,
+					RemoteIPPrefix: "10.0.0.0/8",
+				},
+			},
+		},
 		{
 			name: "Invalid allNodesSecurityGroupRules with wrong remoteManagedGroups",
 			remoteManagedGroups: map[string]string{
@@ -188,6 +217,58 @@ func TestGetAllNodesRules(t *testing.T) {
 			wantRules: nil,
 			wantErr:   true,
 		},
+		{
+			name: "Valid allNodesSecurityGroupRules with remoteAddressGroup",
+			remoteManagedGroups: map[string]string{
+				"controlplane": "1",
+				"worker":       "2",
+			},
+			allNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+				{
+					Protocol:           pointer.String("tcp"),
+					PortRangeMin:       pointer.Int(22),
+					PortRangeMax:       pointer.Int(22),
+					RemoteAddressGroup: &infrav1.AddressGroupFilter{Name: "corporate"},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListAddressGroup(clients.AddressGroupListOpts{Name: "corporate"}).Return([]clients.AddressGroup{{ID: "ag"}}, nil)
+			},
+			wantRules: []resolvedSecurityGroupRuleSpec{
+				{
+					Protocol:             "tcp",
+					PortRangeMin:         22,
+					PortRangeMax:         22,
+					RemoteAddressGroupID: "ag",
+				},
+			},
+		},
+		{
+			name:                "Invalid allNodesSecurityGroupRules with remoteAddressGroup matching no address group",
+			remoteManagedGroups: map[string]string{},
+			allNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+				{
+					RemoteAddressGroup: &infrav1.AddressGroupFilter{Name: "corporate"},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListAddressGroup(clients.AddressGroupListOpts{Name: "corporate"}).Return([]clients.AddressGroup{}, nil)
+			},
+			wantRules: nil,
+			wantErr:   true,
+		},
+		{
+			name:                "Invalid allNodesSecurityGroupRules with remoteAddressGroup and remoteIPPrefix",
+			remoteManagedGroups: map[string]string{},
+			allNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+				{
+					RemoteIPPrefix:     pointer.String("10.0.0.0/8"),
+					RemoteAddressGroup: &infrav1.AddressGroupFilter{ID: "ag"},
+				},
+			},
+			wantRules: nil,
+			wantErr:   true,
+		},
 		{
 			name: "Invalid allNodesSecurityGroupRules with bastion while remoteManagedGroups does not have bastion",
 			remoteManagedGroups: map[string]string{
@@ -211,13 +292,21 @@ func TestGetAllNodesRules(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRules, err := getAllNodesRules(tt.remoteManagedGroups, tt.allNodesSecurityGroupRules)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(mockClient.EXPECT())
+			}
+			s := Service{
+				client: mockClient,
+				scope:  scope.NewWithLogger(scope.NewMockScopeFactory(mockCtrl, ""), testr.New(t)),
+			}
+			gotRules, err := s.resolveSecurityGroupRules(tt.remoteManagedGroups, tt.allNodesSecurityGroupRules)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("getAllNodesRules() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("resolveSecurityGroupRules() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(gotRules, tt.wantRules) {
-				t.Errorf("getAllNodesRules() gotRules = %v, want %v", gotRules, tt.wantRules)
+				t.Errorf("resolveSecurityGroupRules() gotRules = %v, want %v", gotRules, tt.wantRules)
 			}
 		})
 	}
@@ -256,16 +345,20 @@ func TestGenerateDesiredSecGroups(t *testing.T) {
 				},
 			},
 			mockExpect: func(m *mock.MockNetworkClientMockRecorder) {
-				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-controlplane"}).Return([]groups.SecGroup{
+				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-controlplane"}).Return([]clients.SecGroupExt{
 					{
-						ID:   "0",
-						Name: "k8s-cluster-mycluster-secgroup-controlplane",
+						SecGroup: groups.SecGroup{
+							ID:   "0",
+							Name: "k8s-cluster-mycluster-secgroup-controlplane",
+						},
 					},
 				}, nil)
-				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-worker"}).Return([]groups.SecGroup{
+				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-worker"}).Return([]clients.SecGroupExt{
 					{
-						ID:   "1",
-						Name: "k8s-cluster-mycluster-secgroup-worker",
+						SecGroup: groups.SecGroup{
+							ID:   "1",
+							Name: "k8s-cluster-mycluster-secgroup-worker",
+						},
 					},
 				}, nil)
 			},
@@ -289,22 +382,122 @@ func TestGenerateDesiredSecGroups(t *testing.T) {
 				},
 			},
 			mockExpect: func(m *mock.MockNetworkClientMockRecorder) {
-				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-controlplane"}).Return([]groups.SecGroup{
+				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-controlplane"}).Return([]clients.SecGroupExt{
 					{
-						ID:   "0",
-						Name: "k8s-cluster-mycluster-secgroup-controlplane",
+						SecGroup: groups.SecGroup{
+							ID:   "0",
+							Name: "k8s-cluster-mycluster-secgroup-controlplane",
+						},
 					},
 				}, nil)
-				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-worker"}).Return([]groups.SecGroup{
+				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-worker"}).Return([]clients.SecGroupExt{
 					{
-						ID:   "1",
-						Name: "k8s-cluster-mycluster-secgroup-worker",
+						SecGroup: groups.SecGroup{
+							ID:   "1",
+							Name: "k8s-cluster-mycluster-secgroup-worker",
+						},
 					},
 				}, nil)
 			},
 			expectedNumberSecurityGroupRules: 16,
 			wantErr:                          false,
 		},
+		{
+			name: "Valid openStackCluster with securityGroups and allNodesSecurityGroupRules named like a default rule",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						AllNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:           "kubernetes-api",
+								Direction:      "ingress",
+								Protocol:       pointer.String("tcp"),
+								PortRangeMin:   pointer.Int(6443),
+								PortRangeMax:   pointer.Int(6443),
+								RemoteIPPrefix: pointer.String("10.0.0.0/8"),
+							},
+						},
+					},
+				},
+			},
+			mockExpect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-controlplane"}).Return([]clients.SecGroupExt{
+					{
+						SecGroup: groups.SecGroup{
+							ID:   "0",
+							Name: "k8s-cluster-mycluster-secgroup-controlplane",
+						},
+					},
+				}, nil)
+				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-worker"}).Return([]clients.SecGroupExt{
+					{
+						SecGroup: groups.SecGroup{
+							ID:   "1",
+							Name: "k8s-cluster-mycluster-secgroup-worker",
+						},
+					},
+				}, nil)
+			},
+			// The rule is added to both groups and does not replace the default kubernetes-api rule.
+			expectedNumberSecurityGroupRules: 14,
+			wantErr:                          false,
+		},
+		{
+			name: "Valid openStackCluster with securityGroups and per-role rules",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						ControlPlaneNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:           "kubernetes-api",
+								Direction:      "ingress",
+								Protocol:       pointer.String("tcp"),
+								PortRangeMin:   pointer.Int(6443),
+								PortRangeMax:   pointer.Int(6443),
+								RemoteIPPrefix: pointer.String("10.0.0.0/8"),
+							},
+							{
+								Name:         "metrics",
+								Direction:    "ingress",
+								Protocol:     pointer.String("tcp"),
+								PortRangeMin: pointer.Int(9100),
+								PortRangeMax: pointer.Int(9100),
+							},
+						},
+						WorkerNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:                "bgp",
+								Direction:           "ingress",
+								Protocol:            pointer.String("tcp"),
+								PortRangeMin:        pointer.Int(179),
+								PortRangeMax:        pointer.Int(179),
+								RemoteManagedGroups: []infrav1.ManagedSecurityGroupName{"worker"},
+							},
+						},
+					},
+				},
+			},
+			mockExpect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-controlplane"}).Return([]clients.SecGroupExt{
+					{
+						SecGroup: groups.SecGroup{
+							ID:   "0",
+							Name: "k8s-cluster-mycluster-secgroup-controlplane",
+						},
+					},
+				}, nil)
+				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-worker"}).Return([]clients.SecGroupExt{
+					{
+						SecGroup: groups.SecGroup{
+							ID:   "1",
+							Name: "k8s-cluster-mycluster-secgroup-worker",
+						},
+					},
+				}, nil)
+			},
+			expectedNumberSecurityGroupRules: 14,
+			wantErr:                          false,
+		},
 		{
 			name: "Valid openStackCluster with securityGroups with invalid allNodesSecurityGroupRules",
 			openStackCluster: &infrav1.OpenStackCluster{
@@ -322,16 +515,20 @@ func TestGenerateDesiredSecGroups(t *testing.T) {
 				},
 			},
 			mockExpect: func(m *mock.MockNetworkClientMockRecorder) {
-				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-controlplane"}).Return([]groups.SecGroup{
+				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-controlplane"}).Return([]clients.SecGroupExt{
 					{
-						ID:   "0",
-						Name: "k8s-cluster-mycluster-secgroup-controlplane",
+						SecGroup: groups.SecGroup{
+							ID:   "0",
+							Name: "k8s-cluster-mycluster-secgroup-controlplane",
+						},
 					},
 				}, nil)
-				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-worker"}).Return([]groups.SecGroup{
+				m.ListSecGroup(groups.ListOpts{Name: "k8s-cluster-mycluster-secgroup-worker"}).Return([]clients.SecGroupExt{
 					{
-						ID:   "1",
-						Name: "k8s-cluster-mycluster-secgroup-worker",
+						SecGroup: groups.SecGroup{
+							ID:   "1",
+							Name: "k8s-cluster-mycluster-secgroup-worker",
+						},
 					},
 				}, nil)
 			},
@@ -366,6 +563,116 @@ func TestGenerateDesiredSecGroups(t *testing.T) {
 	}
 }
 
+func TestValidateManagedSecurityGroupsExtensions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	expectExtensions := func(m *mock.MockNetworkClientMockRecorder, aliases ...string) {
+		exts := make([]extensions.Extension, len(aliases))
+		for i := range aliases {
+			exts[i] = extensions.Extension{Extension: common.Extension{Alias: aliases[i]}}
+		}
+		m.ListExtensions().Return(exts, nil)
+	}
+	addressGroupRule := infrav1.SecurityGroupRuleSpec{
+		Name:               "corporate",
+		RemoteAddressGroup: &infrav1.AddressGroupFilter{Name: "corporate"},
+	}
+
+	tests := []struct {
+		name                  string
+		managedSecurityGroups *infrav1.ManagedSecurityGroups
+		expect                func(m *mock.MockNetworkClientMockRecorder)
+		wantErr               bool
+	}{
+		{
+			name:                  "No extension required",
+			managedSecurityGroups: &infrav1.ManagedSecurityGroups{},
+			expect:                func(m *mock.MockNetworkClientMockRecorder) {},
+		},
+		{
+			name: "Supported extensions",
+			managedSecurityGroups: &infrav1.ManagedSecurityGroups{
+				Stateful:                            pointer.Bool(false),
+				ControlPlaneNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{addressGroupRule},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				expectExtensions(m, "stateful-security-group", "address-group")
+			},
+		},
+		{
+			name: "Unsupported stateful-security-group extension",
+			managedSecurityGroups: &infrav1.ManagedSecurityGroups{
+				Stateful: pointer.Bool(true),
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				expectExtensions(m, "address-group")
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unsupported address-group extension",
+			managedSecurityGroups: &infrav1.ManagedSecurityGroups{
+				BastionSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{addressGroupRule},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				expectExtensions(m, "stateful-security-group")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			tt.expect(mockClient.EXPECT())
+
+			scopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			s := Service{
+				client: mockClient,
+				scope:  scope.NewWithLogger(scopeFactory, testr.New(t)),
+			}
+			err := s.validateManagedSecurityGroupsExtensions(tt.managedSecurityGroups)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestOverrideRules(t *testing.T) {
+	g := NewWithT(t)
+
+	rules := []resolvedSecurityGroupRuleSpec{
+		{Name: "kubernetes-api", PortRangeMin: 6443, PortRangeMax: 6443},
+		{Name: "in-cluster", RemoteGroupID: "1"},
+		{Name: "in-cluster", RemoteGroupID: "2"},
+	}
+	overrides := []resolvedSecurityGroupRuleSpec{
+		{Name: "in-cluster", RemoteGroupID: "3"},
+		{Name: "metrics", PortRangeMin: 9100, PortRangeMax: 9100},
+	}
+	g.Expect(overrideRules(rules, overrides)).To(Equal([]resolvedSecurityGroupRuleSpec{
+		{Name: "kubernetes-api", PortRangeMin: 6443, PortRangeMax: 6443},
+		{Name: "in-cluster", RemoteGroupID: "3"},
+		{Name: "metrics", PortRangeMin: 9100, PortRangeMax: 9100},
+	}))
+}
+
+func TestGetSGBastionSSH(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(getSGBastionSSH(nil)).To(Equal([]resolvedSecurityGroupRuleSpec{
+		{Name: "ssh", Description: "SSH", Direction: "ingress", EtherType: "IPv4", PortRangeMin: 22, PortRangeMax: 22, Protocol: "tcp"},
+	}))
+	g.Expect(getSGBastionSSH([]string{"192.0.2.0/24", "2001:db8::/32"})).To(Equal([]resolvedSecurityGroupRuleSpec{
+		{Name: "ssh", Description: "SSH", Direction: "ingress", EtherType: "IPv4", PortRangeMin: 22, PortRangeMax: 22, Protocol: "tcp", RemoteIPPrefix: "192.0.2.0/24"},
+		{Name: "ssh", Description: "SSH", Direction: "ingress", EtherType: "IPv6", PortRangeMin: 22, PortRangeMax: 22, Protocol: "tcp", RemoteIPPrefix: "2001:db8::/32"},
+	}))
+}
+
 func TestReconcileGroupRules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -482,15 +789,17 @@ func TestReconcileGroupRules(t *testing.T) {
 					PortRangeMin:  22,
 					PortRangeMax:  22,
 					RemoteGroupID: "1",
-				}).Return(&rules.SecGroupRule{
-					ID:            "idSGRule",
-					Description:   "Allow SSH",
-					Direction:     "ingress",
-					EtherType:     "IPv4",
-					Protocol:      "tcp",
-					PortRangeMin:  22,
-					PortRangeMax:  22,
-					RemoteGroupID: "1",
+				}).Return(&clients.SecGroupRuleExt{
+					SecGroupRule: rules.SecGroupRule{
+						ID:            "idSGRule",
+						Description:   "Allow SSH",
+						Direction:     "ingress",
+						EtherType:     "IPv4",
+						Protocol:      "tcp",
+						PortRangeMin:  22,
+						PortRangeMax:  22,
+						RemoteGroupID: "1",
+					},
 				}, nil)
 			},
 			wantSGStatus: infrav1.SecurityGroupStatus{
@@ -511,6 +820,125 @@ func TestReconcileGroupRules(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Different stateful attribute updates the group",
+			desiredSGSpecs: securityGroupSpec{
+				Name:     "k8s-cluster-mycluster-secgroup-controlplane",
+				Stateful: pointer.Bool(false),
+				Rules: []resolvedSecurityGroupRuleSpec{
+					{
+						Direction: "ingress",
+						EtherType: "IPv4",
+					},
+				},
+			},
+			observedSGStatus: infrav1.SecurityGroupStatus{
+				ID:       "idSG",
+				Name:     "k8s-cluster-mycluster-secgroup-controlplane",
+				Stateful: pointer.Bool(true),
+				Rules: []infrav1.SecurityGroupRuleStatus{
+					{
+						Description:    pointer.String(""),
+						Direction:      "ingress",
+						EtherType:      pointer.String("IPv4"),
+						ID:             "idSGRule",
+						Protocol:       pointer.String(""),
+						PortRangeMin:   pointer.Int(0),
+						PortRangeMax:   pointer.Int(0),
+						RemoteGroupID:  pointer.String(""),
+						RemoteIPPrefix: pointer.String(""),
+					},
+				},
+			},
+			mockExpect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.UpdateSecGroup("idSG", clients.SecGroupUpdateOptsExt{
+					UpdateOptsBuilder: groups.UpdateOpts{},
+					Stateful:          pointer.Bool(false),
+				}).Return(&clients.SecGroupExt{
+					SecGroup: groups.SecGroup{ID: "idSG"},
+					Stateful: pointer.Bool(false),
+				}, nil)
+			},
+			wantSGStatus: infrav1.SecurityGroupStatus{
+				ID:       "idSG",
+				Name:     "k8s-cluster-mycluster-secgroup-controlplane",
+				Stateful: pointer.Bool(false),
+				Rules: []infrav1.SecurityGroupRuleStatus{
+					{
+						Description:    pointer.String(""),
+						Direction:      "ingress",
+						EtherType:      pointer.String("IPv4"),
+						ID:             "idSGRule",
+						Protocol:       pointer.String(""),
+						PortRangeMin:   pointer.Int(0),
+						PortRangeMax:   pointer.Int(0),
+						RemoteGroupID:  pointer.String(""),
+						RemoteIPPrefix: pointer.String(""),
+					},
+				},
+			},
+		},
+		{
+			name: "Rule with remote address group is created",
+			desiredSGSpecs: securityGroupSpec{
+				Name: "k8s-cluster-mycluster-secgroup-controlplane",
+				Rules: []resolvedSecurityGroupRuleSpec{
+					{
+						Direction:            "ingress",
+						EtherType:            "IPv4",
+						Protocol:             "tcp",
+						PortRangeMin:         22,
+						PortRangeMax:         22,
+						RemoteAddressGroupID: "ag",
+					},
+				},
+			},
+			observedSGStatus: infrav1.SecurityGroupStatus{
+				ID:   "idSG",
+				Name: "k8s-cluster-mycluster-secgroup-controlplane",
+			},
+			mockExpect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.CreateSecGroupRule(clients.SecGroupRuleCreateOptsExt{
+					CreateOptsBuilder: rules.CreateOpts{
+						SecGroupID:   "idSG",
+						Direction:    "ingress",
+						EtherType:    "IPv4",
+						Protocol:     "tcp",
+						PortRangeMin: 22,
+						PortRangeMax: 22,
+					},
+					RemoteAddressGroupID: "ag",
+				}).Return(&clients.SecGroupRuleExt{
+					SecGroupRule: rules.SecGroupRule{
+						ID:           "idSGRule",
+						Direction:    "ingress",
+						EtherType:    "IPv4",
+						Protocol:     "tcp",
+						PortRangeMin: 22,
+						PortRangeMax: 22,
+					},
+					RemoteAddressGroupID: "ag",
+				}, nil)
+			},
+			wantSGStatus: infrav1.SecurityGroupStatus{
+				ID:   "idSG",
+				Name: "k8s-cluster-mycluster-secgroup-controlplane",
+				Rules: []infrav1.SecurityGroupRuleStatus{
+					{
+						Description:          pointer.String(""),
+						Direction:            "ingress",
+						EtherType:            pointer.String("IPv4"),
+						ID:                   "idSGRule",
+						Protocol:             pointer.String("tcp"),
+						PortRangeMin:         pointer.Int(22),
+						PortRangeMax:         pointer.Int(22),
+						RemoteGroupID:        pointer.String(""),
+						RemoteIPPrefix:       pointer.String(""),
+						RemoteAddressGroupID: pointer.String("ag"),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,236 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
+)
+
+// apiServerVIPTag identifies the port reserving the API server VIP among the
+// ports created for a cluster.
+const apiServerVIPTag = "capo-api-server-vip"
+
+// apiServerVIPPortRole is the role passed to the port name template when
+// rendering the name of the API server VIP port.
+const apiServerVIPPortRole = "api-server-vip"
+
+// getAPIServerVIPPortName returns the name of the port reserving the API
+// server VIP. It is rendered from the port name template of the cluster if
+// set, with the cluster name as the machine name.
+func getAPIServerVIPPortName(openStackCluster *infrav1.OpenStackCluster, clusterName string) (string, error) {
+	data := names.GetClusterResourceNameData(openStackCluster)
+	data.MachineName = data.ClusterName
+	data.Role = apiServerVIPPortRole
+
+	defaultName := fmt.Sprintf("%s-cluster-%s-%s", networkPrefix, clusterName, apiServerVIPPortRole)
+	return names.GetResourceName(names.GetResourceNaming(openStackCluster).Port, data, defaultName)
+}
+
+// ReconcileAPIServerVIP ensures that the port reserving the API server VIP
+// exists on the cluster network and, unless the API server floating IP is
+// disabled, that a floating IP is associated with it.
+func (s *Service) ReconcileAPIServerVIP(openStackCluster *infrav1.OpenStackCluster, clusterName string) error {
+	if openStackCluster.Status.Network == nil || openStackCluster.Status.Network.ID == "" {
+		return fmt.Errorf("cluster network is not available")
+	}
+	networkID := openStackCluster.Status.Network.ID
+	portName, err := getAPIServerVIPPortName(openStackCluster, clusterName)
+	if err != nil {
+		return err
+	}
+	s.scope.Logger().Info("Reconciling API server VIP", "name", portName)
+
+	port, err := s.getAPIServerVIPPort(openStackCluster, networkID, portName)
+	if err != nil {
+		return err
+	}
+
+	if port.ID == "" {
+		createOpts := ports.CreateOpts{
+			Name:        portName,
+			Description: names.GetDescription(clusterName),
+			NetworkID:   networkID,
+		}
+		if openStackCluster.Spec.APIServerFixedIP != nil {
+			createOpts.FixedIPs = []ports.IP{{IPAddress: *openStackCluster.Spec.APIServerFixedIP}}
+		}
+
+		newPort, err := s.client.CreatePort(createOpts)
+		if err != nil {
+			record.Warnf(openStackCluster, "FailedCreatePort", "Failed to create API server VIP port %s: %v", portName, err)
+			return err
+		}
+		record.Eventf(openStackCluster, "SuccessfulCreatePort", "Created API server VIP port %s with id %s", portName, newPort.ID)
+
+		if tags := getClusterTags(openStackCluster, apiServerVIPTag); len(tags) > 0 {
+			if _, err := s.client.ReplaceAllAttributesTags("ports", newPort.ID, attributestags.ReplaceAllOpts{
+				Tags: tags,
+			}); err != nil {
+				return err
+			}
+		}
+		port = *newPort
+	}
+
+	if len(port.FixedIPs) == 0 {
+		return fmt.Errorf("API server VIP port %s has no fixed IP", port.ID)
+	}
+	vipStatus := &infrav1.APIServerVIPStatus{
+		PortID:    port.ID,
+		NetworkID: networkID,
+		IP:        port.FixedIPs[0].IPAddress,
+	}
+	if fixedIP := openStackCluster.Spec.APIServerFixedIP; fixedIP != nil && *fixedIP != vipStatus.IP {
+		return fmt.Errorf("API server VIP port %s has fixed IP %s instead of %s", port.ID, vipStatus.IP, *fixedIP)
+	}
+	if previous := openStackCluster.Status.APIServerVIP; previous != nil {
+		vipStatus.FloatingIP = previous.FloatingIP
+	}
+	openStackCluster.Status.APIServerVIP = vipStatus
+
+	if pointer.BoolDeref(openStackCluster.Spec.DisableAPIServerFloatingIP, false) {
+		return nil
+	}
+
+	floatingIP := openStackCluster.Spec.APIServerFloatingIP
+	if vipStatus.FloatingIP != "" {
+		floatingIP = &vipStatus.FloatingIP
+	}
+	fp, err := s.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterName, floatingIP)
+	if err != nil {
+		return err
+	}
+	// Record the floating IP before associating it, so that it is not leaked
+	// if the association fails.
+	vipStatus.FloatingIP = fp.FloatingIP
+
+	return s.AssociateFloatingIP(openStackCluster, fp, port.ID)
+}
+
+// DeleteAPIServerVIP deletes the port reserving the API server VIP. The
+// floating IP associated with it is deleted, unless it was given explicitly
+// as the API server floating IP, in which case it is only disassociated.
+func (s *Service) DeleteAPIServerVIP(openStackCluster *infrav1.OpenStackCluster, clusterName string) error {
+	var portID string
+	if vipStatus := openStackCluster.Status.APIServerVIP; vipStatus != nil {
+		portID = vipStatus.PortID
+	} else if openStackCluster.Status.Network != nil && openStackCluster.Status.Network.ID != "" {
+		portName, err := getAPIServerVIPPortName(openStackCluster, clusterName)
+		if err != nil {
+			return err
+		}
+		port, err := s.getAPIServerVIPPort(openStackCluster, openStackCluster.Status.Network.ID, portName)
+		if err != nil {
+			return err
+		}
+		portID = port.ID
+	}
+	if portID == "" {
+		return nil
+	}
+
+	fp, err := s.GetFloatingIPByPortID(portID)
+	if err != nil {
+		return err
+	}
+	if fp != nil {
+		if pointer.StringDeref(openStackCluster.Spec.APIServerFloatingIP, "") == fp.FloatingIP {
+			err = s.DisassociateFloatingIP(openStackCluster, fp.FloatingIP)
+		} else {
+			err = s.DeleteFloatingIP(openStackCluster, fp.FloatingIP)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.DeletePort(openStackCluster, portID); err != nil {
+		return err
+	}
+	openStackCluster.Status.APIServerVIP = nil
+	return nil
+}
+
+// getAPIServerVIPPort returns the port reserving the API server VIP, or an empty port if it does not exist.
+func (s *Service) getAPIServerVIPPort(openStackCluster *infrav1.OpenStackCluster, networkID, portName string) (ports.Port, error) {
+	return getClusterResource(s, openStackCluster, "ports", portName, []string{apiServerVIPTag},
+		func(tags, name string) ([]ports.Port, error) {
+			return s.client.ListPort(ports.ListOpts{NetworkID: networkID, Name: name, Tags: tags})
+		},
+		func(port ports.Port) (string, []string) {
+			return port.ID, port.Tags
+		},
+	)
+}
+
+// AddAllowedAddressPair adds the IP to the allowed address pairs of the port
+// if the port is on the given network.
+func (s *Service) AddAllowedAddressPair(eventObject runtime.Object, portID, networkID, ip string) error {
+	port, err := s.client.GetPort(portID)
+	if err != nil {
+		return err
+	}
+	if port.NetworkID != networkID || slices.ContainsFunc(port.AllowedAddressPairs, func(pair ports.AddressPair) bool {
+		return pair.IPAddress == ip
+	}) {
+		return nil
+	}
+
+	addressPairs := append(slices.Clone(port.AllowedAddressPairs), ports.AddressPair{IPAddress: ip})
+	if _, err := s.client.UpdatePort(portID, ports.UpdateOpts{AllowedAddressPairs: &addressPairs}); err != nil {
+		record.Warnf(eventObject, "FailedUpdatePort", "Failed to add allowed address pair %s to port %s: %v", ip, portID, err)
+		return err
+	}
+	record.Eventf(eventObject, "SuccessfulUpdatePort", "Added allowed address pair %s to port %s", ip, portID)
+	return nil
+}
+
+// RemoveAllowedAddressPair removes the IP from the allowed address pairs of the port.
+func (s *Service) RemoveAllowedAddressPair(eventObject runtime.Object, portID, ip string) error {
+	port, err := s.client.GetPort(portID)
+	if err != nil {
+		if capoerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	addressPairs := slices.DeleteFunc(slices.Clone(port.AllowedAddressPairs), func(pair ports.AddressPair) bool {
+		return pair.IPAddress == ip
+	})
+	if len(addressPairs) == len(port.AllowedAddressPairs) {
+		return nil
+	}
+
+	if _, err := s.client.UpdatePort(portID, ports.UpdateOpts{AllowedAddressPairs: &addressPairs}); err != nil {
+		record.Warnf(eventObject, "FailedUpdatePort", "Failed to remove allowed address pair %s from port %s: %v", ip, portID, err)
+		return err
+	}
+	record.Eventf(eventObject, "SuccessfulUpdatePort", "Removed allowed address pair %s from port %s", ip, portID)
+	return nil
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
+)
+
+func Test_ReconcileAPIServerVIP(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	clusterName := "test-cluster"
+	expectedPortName := "k8s-clusterapi-cluster-test-cluster-api-server-vip"
+	fakePortID := "a9f4e0ac-2ba6-4e58-8c8e-6f0f3b5f5e8e"
+	fakeNetworkID := "d08803fc-2fa5-4179-b9f7-8c43d0af2fe6"
+	fakeExternalNetworkID := "d08803fc-2fa5-4179-b9f7-8c43d0af2fe8"
+	fakeFloatingIPID := "6c1e5d4b-0a55-4d6c-a2b9-5c2d6a7b3f21"
+	fixedIP := "10.6.0.10"
+	floatingIP := "203.0.113.10"
+
+	status := infrav1.OpenStackClusterStatus{
+		Network: &infrav1.NetworkStatusWithSubnets{
+			NetworkStatus: infrav1.NetworkStatus{ID: fakeNetworkID},
+		},
+		ExternalNetwork: &infrav1.NetworkStatus{ID: fakeExternalNetworkID},
+	}
+	vipPort := ports.Port{
+		ID:        fakePortID,
+		Name:      expectedPortName,
+		NetworkID: fakeNetworkID,
+		FixedIPs:  []ports.IP{{IPAddress: fixedIP}},
+	}
+
+	tests := []struct {
+		name             string
+		openStackCluster *infrav1.OpenStackCluster
+		expect           func(m *mock.MockNetworkClientMockRecorder)
+		want             *infrav1.APIServerVIPStatus
+	}{
+		{
+			name: "creates a VIP port with a floating IP",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerVIP:     &infrav1.APIServerVIP{Enabled: pointer.Bool(true)},
+					APIServerFixedIP: pointer.String(fixedIP),
+				},
+				Status: status,
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(ports.ListOpts{NetworkID: fakeNetworkID, Name: expectedPortName}).Return([]ports.Port{}, nil)
+				m.CreatePort(ports.CreateOpts{
+					Name:        expectedPortName,
+					Description: names.GetDescription(clusterName),
+					NetworkID:   fakeNetworkID,
+					FixedIPs:    []ports.IP{{IPAddress: fixedIP}},
+				}).Return(&vipPort, nil)
+				m.CreateFloatingIP(floatingips.CreateOpts{
+					FloatingNetworkID: fakeExternalNetworkID,
+					Description:       names.GetDescription(clusterName),
+				}).Return(&floatingips.FloatingIP{ID: fakeFloatingIPID, FloatingIP: floatingIP}, nil)
+				m.UpdateFloatingIP(fakeFloatingIPID, &floatingips.UpdateOpts{PortID: pointer.String(fakePortID)}).Return(&floatingips.FloatingIP{}, nil)
+				m.GetFloatingIP(fakeFloatingIPID).Return(&floatingips.FloatingIP{Status: "ACTIVE"}, nil)
+			},
+			want: &infrav1.APIServerVIPStatus{
+				PortID:     fakePortID,
+				NetworkID:  fakeNetworkID,
+				IP:         fixedIP,
+				FloatingIP: floatingIP,
+			},
+		},
+		{
+			name: "tags a created VIP port",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerVIP:               &infrav1.APIServerVIP{Enabled: pointer.Bool(true)},
+					DisableAPIServerFloatingIP: pointer.Bool(true),
+					Tags:                       []string{"tag"},
+				},
+				Status: status,
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(ports.ListOpts{NetworkID: fakeNetworkID, Name: expectedPortName}).Return([]ports.Port{}, nil)
+				m.CreatePort(ports.CreateOpts{
+					Name:        expectedPortName,
+					Description: names.GetDescription(clusterName),
+					NetworkID:   fakeNetworkID,
+				}).Return(&vipPort, nil)
+				m.ReplaceAllAttributesTags("ports", fakePortID, attributestags.ReplaceAllOpts{Tags: []string{"tag"}}).Return([]string{"tag"}, nil)
+			},
+			want: &infrav1.APIServerVIPStatus{
+				PortID:    fakePortID,
+				NetworkID: fakeNetworkID,
+				IP:        fixedIP,
+			},
+		},
+		{
+			name: "names the VIP port with the port name template",
+			openStackCluster: &infrav1.OpenStackCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "osc"},
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerVIP:               &infrav1.APIServerVIP{Enabled: pointer.Bool(true)},
+					DisableAPIServerFloatingIP: pointer.Bool(true),
+					ResourceNaming: &infrav1.ResourceNaming{
+						Port: pointer.String("{{ .MachineName }}-{{ .Role }}"),
+					},
+				},
+				Status: status,
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(ports.ListOpts{NetworkID: fakeNetworkID, Name: "osc-api-server-vip"}).Return([]ports.Port{}, nil)
+				m.CreatePort(ports.CreateOpts{
+					Name:        "osc-api-server-vip",
+					Description: names.GetDescription(clusterName),
+					NetworkID:   fakeNetworkID,
+				}).Return(&vipPort, nil)
+			},
+			want: &infrav1.APIServerVIPStatus{
+				PortID:    fakePortID,
+				NetworkID: fakeNetworkID,
+				IP:        fixedIP,
+			},
+		},
+		{
+			name: "reuses the VIP port and floating IP of the cluster",
+			openStackCluster: &infrav1.OpenStackCluster{
+				ObjectMeta: metav1.ObjectMeta{UID: types.UID("uid")},
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerVIP: &infrav1.APIServerVIP{Enabled: pointer.Bool(true)},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network:         status.Network,
+					ExternalNetwork: status.ExternalNetwork,
+					APIServerVIP: &infrav1.APIServerVIPStatus{
+						PortID:     fakePortID,
+						NetworkID:  fakeNetworkID,
+						IP:         fixedIP,
+						FloatingIP: floatingIP,
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListPort(ports.ListOpts{NetworkID: fakeNetworkID, Tags: names.GetClusterUIDTag("uid") + "," + apiServerVIPTag}).Return([]ports.Port{vipPort}, nil)
+				m.ListFloatingIP(floatingips.ListOpts{FloatingIP: floatingIP}).Return([]floatingips.FloatingIP{{ID: fakeFloatingIPID, FloatingIP: floatingIP, PortID: fakePortID}}, nil)
+			},
+			want: &infrav1.APIServerVIPStatus{
+				PortID:     fakePortID,
+				NetworkID:  fakeNetworkID,
+				IP:         fixedIP,
+				FloatingIP: floatingIP,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			tt.expect(mockClient.EXPECT())
+
+			scopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			log := testr.New(t)
+			s := Service{
+				client: mockClient,
+				scope:  scope.NewWithLogger(scopeFactory, log),
+			}
+			err := s.ReconcileAPIServerVIP(tt.openStackCluster, clusterName)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(tt.openStackCluster.Status.APIServerVIP).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_AllowedAddressPairs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	fakePortID := "a9f4e0ac-2ba6-4e58-8c8e-6f0f3b5f5e8e"
+	fakeNetworkID := "d08803fc-2fa5-4179-b9f7-8c43d0af2fe6"
+	vip := "10.6.0.10"
+	otherPair := ports.AddressPair{IPAddress: "10.6.0.20"}
+
+	tests := []struct {
+		name   string
+		remove bool
+		expect func(m *mock.MockNetworkClientMockRecorder)
+	}{
+		{
+			name: "adds the VIP to a port on the VIP network",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.GetPort(fakePortID).Return(&ports.Port{ID: fakePortID, NetworkID: fakeNetworkID, AllowedAddressPairs: []ports.AddressPair{otherPair}}, nil)
+				m.UpdatePort(fakePortID, ports.UpdateOpts{
+					AllowedAddressPairs: &[]ports.AddressPair{otherPair, {IPAddress: vip}},
+				}).Return(&ports.Port{}, nil)
+			},
+		},
+		{
+			name: "does not add the VIP to a port which has it",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.GetPort(fakePortID).Return(&ports.Port{ID: fakePortID, NetworkID: fakeNetworkID, AllowedAddressPairs: []ports.AddressPair{{IPAddress: vip}}}, nil)
+			},
+		},
+		{
+			name: "does not add the VIP to a port on another network",
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.GetPort(fakePortID).Return(&ports.Port{ID: fakePortID, NetworkID: "other-network"}, nil)
+			},
+		},
+		{
+			name:   "removes the VIP from a port",
+			remove: true,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.GetPort(fakePortID).Return(&ports.Port{ID: fakePortID, NetworkID: fakeNetworkID, AllowedAddressPairs: []ports.AddressPair{{IPAddress: vip}, otherPair}}, nil)
+				m.UpdatePort(fakePortID, ports.UpdateOpts{
+					AllowedAddressPairs: &[]ports.AddressPair{otherPair},
+				}).Return(&ports.Port{}, nil)
+			},
+		},
+		{
+			name:   "does not update a port without the VIP",
+			remove: true,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.GetPort(fakePortID).Return(&ports.Port{ID: fakePortID, NetworkID: fakeNetworkID, AllowedAddressPairs: []ports.AddressPair{otherPair}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockClient := mock.NewMockNetworkClient(mockCtrl)
+			tt.expect(mockClient.EXPECT())
+
+			scopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			log := testr.New(t)
+			s := Service{
+				client: mockClient,
+				scope:  scope.NewWithLogger(scopeFactory, log),
+			}
+			eventObject := &infrav1.OpenStackMachine{}
+			var err error
+			if tt.remove {
+				err = s.RemoveAllowedAddressPair(eventObject, fakePortID, vip)
+			} else {
+				err = s.AddAllowedAddressPair(eventObject, fakePortID, fakeNetworkID, vip)
+			}
+			g.Expect(err).ShouldNot(HaveOccurred())
+		})
+	}
+}
@@ -18,6 +18,7 @@ package scope
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -26,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
@@ -39,6 +41,7 @@ type MockScopeFactory struct {
 	VolumeClient  *mock.MockVolumeClient
 	ImageClient   *mock.MockImageClient
 	LbClient      *mock.MockLbClient
+	DNSClient     *mock.MockDNSClient
 
 	projectID              string
 	clientScopeCreateError error
@@ -50,6 +53,7 @@ func NewMockScopeFactory(mockCtrl *gomock.Controller, projectID string) *MockSco
 	imageClient := mock.NewMockImageClient(mockCtrl)
 	networkClient := mock.NewMockNetworkClient(mockCtrl)
 	lbClient := mock.NewMockLbClient(mockCtrl)
+	dnsClient := mock.NewMockDNSClient(mockCtrl)
 
 	return &MockScopeFactory{
 		ComputeClient: computeClient,
@@ -57,6 +61,7 @@ func NewMockScopeFactory(mockCtrl *gomock.Controller, projectID string) *MockSco
 		ImageClient:   imageClient,
 		NetworkClient: networkClient,
 		LbClient:      lbClient,
+		DNSClient:     dnsClient,
 		projectID:     projectID,
 	}
 }
@@ -72,6 +77,20 @@ func (f *MockScopeFactory) NewClientScopeFromMachine(_ context.Context, _ client
 	return f, nil
 }
 
+func (f *MockScopeFactory) NewClientScopeFromMachineTemplate(_ context.Context, _ client.Client, _ *infrav1.OpenStackMachineTemplate, _ *infrav1.OpenStackCluster, _ []byte, _ logr.Logger) (Scope, error) {
+	if f.clientScopeCreateError != nil {
+		return nil, f.clientScopeCreateError
+	}
+	return f, nil
+}
+
+func (f *MockScopeFactory) NewClientScopeFromMachinePool(_ context.Context, _ client.Client, _ *infrav1.OpenStackMachinePool, _ *infrav1.OpenStackCluster, _ []byte, _ logr.Logger) (Scope, error) {
+	if f.clientScopeCreateError != nil {
+		return nil, f.clientScopeCreateError
+	}
+	return f, nil
+}
+
 func (f *MockScopeFactory) NewClientScopeFromCluster(_ context.Context, _ client.Client, _ *infrav1.OpenStackCluster, _ []byte, _ logr.Logger) (Scope, error) {
 	if f.clientScopeCreateError != nil {
 		return nil, f.clientScopeCreateError
@@ -79,7 +98,21 @@ func (f *MockScopeFactory) NewClientScopeFromCluster(_ context.Context, _ client
 	return f, nil
 }
 
-func (f *MockScopeFactory) NewClientScopeFromFloatingIPPool(_ context.Context, _ client.Client, _ *v1alpha1.OpenStackFloatingIPPool, _ []byte, _ logr.Logger) (Scope, error) {
+func (f *MockScopeFactory) NewClientScopeFromFloatingIPPool(_ context.Context, _ client.Client, _ infrav1alpha2.FloatingIPPool, _ []byte, _ logr.Logger) (Scope, error) {
+	if f.clientScopeCreateError != nil {
+		return nil, f.clientScopeCreateError
+	}
+	return f, nil
+}
+
+func (f *MockScopeFactory) NewClientScopeFromFixedIPPool(_ context.Context, _ client.Client, _ *v1alpha1.OpenStackFixedIPPool, _ []byte, _ logr.Logger) (Scope, error) {
+	if f.clientScopeCreateError != nil {
+		return nil, f.clientScopeCreateError
+	}
+	return f, nil
+}
+
+func (f *MockScopeFactory) NewClientScopeFromImage(_ context.Context, _ client.Client, _ *v1alpha1.OpenStackImage, _ []byte, _ logr.Logger) (Scope, error) {
 	if f.clientScopeCreateError != nil {
 		return nil, f.clientScopeCreateError
 	}
@@ -102,10 +135,18 @@ func (f *MockScopeFactory) NewNetworkClient() (clients.NetworkClient, error) {
 	return f.NetworkClient, nil
 }
 
+func (f *MockScopeFactory) NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout}
+}
+
 func (f *MockScopeFactory) NewLbClient() (clients.LbClient, error) {
 	return f.LbClient, nil
 }
 
+func (f *MockScopeFactory) NewDNSClient() (clients.DNSClient, error) {
+	return f.DNSClient, nil
+}
+
 func (f *MockScopeFactory) ProjectID() string {
 	return f.projectID
 }
@@ -38,6 +38,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/hash"
@@ -84,6 +85,71 @@ func (f *providerScopeFactory) NewClientScopeFromMachine(ctx context.Context, ct
 	return NewCachedProviderScope(f.clientCache, cloud, caCert, logger)
 }
 
+func (f *providerScopeFactory) NewClientScopeFromMachineTemplate(ctx context.Context, ctrlClient client.Client, openStackMachineTemplate *infrav1.OpenStackMachineTemplate, openStackCluster *infrav1.OpenStackCluster, defaultCACert []byte, logger logr.Logger) (Scope, error) {
+	var cloud clientconfig.Cloud
+	var caCert []byte
+
+	var identityRef *infrav1.OpenStackIdentityReference
+	var namespace string
+	switch {
+	case openStackMachineTemplate.Spec.Template.Spec.IdentityRef != nil:
+		identityRef = openStackMachineTemplate.Spec.Template.Spec.IdentityRef
+		namespace = openStackMachineTemplate.Namespace
+	case openStackCluster != nil:
+		identityRef = &openStackCluster.Spec.IdentityRef
+		namespace = openStackCluster.Namespace
+	default:
+		return nil, fmt.Errorf("no identity found for OpenStackMachineTemplate %s/%s", openStackMachineTemplate.Namespace, openStackMachineTemplate.Name)
+	}
+
+	var err error
+	cloud, caCert, err = getCloudFromSecret(ctx, ctrlClient, namespace, identityRef.Name, identityRef.CloudName)
+	if err != nil {
+		return nil, err
+	}
+
+	if caCert == nil {
+		caCert = defaultCACert
+	}
+
+	if f.clientCache == nil {
+		return NewProviderScope(cloud, caCert, logger)
+	}
+
+	return NewCachedProviderScope(f.clientCache, cloud, caCert, logger)
+}
+
+func (f *providerScopeFactory) NewClientScopeFromMachinePool(ctx context.Context, ctrlClient client.Client, openStackMachinePool *infrav1.OpenStackMachinePool, openStackCluster *infrav1.OpenStackCluster, defaultCACert []byte, logger logr.Logger) (Scope, error) {
+	var cloud clientconfig.Cloud
+	var caCert []byte
+
+	var identityRef *infrav1.OpenStackIdentityReference
+	var namespace string
+	if openStackMachinePool.Spec.Template.IdentityRef != nil {
+		identityRef = openStackMachinePool.Spec.Template.IdentityRef
+		namespace = openStackMachinePool.Namespace
+	} else {
+		identityRef = &openStackCluster.Spec.IdentityRef
+		namespace = openStackCluster.Namespace
+	}
+
+	var err error
+	cloud, caCert, err = getCloudFromSecret(ctx, ctrlClient, namespace, identityRef.Name, identityRef.CloudName)
+	if err != nil {
+		return nil, err
+	}
+
+	if caCert == nil {
+		caCert = defaultCACert
+	}
+
+	if f.clientCache == nil {
+		return NewProviderScope(cloud, caCert, logger)
+	}
+
+	return NewCachedProviderScope(f.clientCache, cloud, caCert, logger)
+}
+
 func (f *providerScopeFactory) NewClientScopeFromCluster(ctx context.Context, ctrlClient client.Client, openStackCluster *infrav1.OpenStackCluster, defaultCACert []byte, logger logr.Logger) (Scope, error) {
 	var cloud clientconfig.Cloud
 	var caCert []byte
@@ -105,13 +171,59 @@ func (f *providerScopeFactory) NewClientScopeFromCluster(ctx context.Context, ct
 	return NewCachedProviderScope(f.clientCache, cloud, caCert, logger)
 }
 
-func (f *providerScopeFactory) NewClientScopeFromFloatingIPPool(ctx context.Context, ctrlClient client.Client, openstackFloatingIPPool *v1alpha1.OpenStackFloatingIPPool, defaultCACert []byte, logger logr.Logger) (Scope, error) {
+func (f *providerScopeFactory) NewClientScopeFromFloatingIPPool(ctx context.Context, ctrlClient client.Client, openStackFloatingIPPool infrav1alpha2.FloatingIPPool, defaultCACert []byte, logger logr.Logger) (Scope, error) {
+	var cloud clientconfig.Cloud
+	var caCert []byte
+
+	if identityRef := openStackFloatingIPPool.GetFloatingIPPoolSpec().IdentityRef; identityRef != nil {
+		var err error
+		cloud, caCert, err = getCloudFromSecret(ctx, ctrlClient, openStackFloatingIPPool.GetIdentityRefNamespace(), identityRef.Name, identityRef.CloudName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if caCert == nil {
+		caCert = defaultCACert
+	}
+
+	if f.clientCache == nil {
+		return NewProviderScope(cloud, caCert, logger)
+	}
+
+	return NewCachedProviderScope(f.clientCache, cloud, caCert, logger)
+}
+
+func (f *providerScopeFactory) NewClientScopeFromFixedIPPool(ctx context.Context, ctrlClient client.Client, openStackFixedIPPool *v1alpha1.OpenStackFixedIPPool, defaultCACert []byte, logger logr.Logger) (Scope, error) {
 	var cloud clientconfig.Cloud
 	var caCert []byte
 
-	if openstackFloatingIPPool.Spec.IdentityRef != nil {
+	if openStackFixedIPPool.Spec.IdentityRef != nil {
 		var err error
-		cloud, caCert, err = getCloudFromSecret(ctx, ctrlClient, openstackFloatingIPPool.Namespace, openstackFloatingIPPool.Spec.IdentityRef.Name, openstackFloatingIPPool.Spec.CloudName)
+		cloud, caCert, err = getCloudFromSecret(ctx, ctrlClient, openStackFixedIPPool.Namespace, openStackFixedIPPool.Spec.IdentityRef.Name, openStackFixedIPPool.Spec.CloudName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if caCert == nil {
+		caCert = defaultCACert
+	}
+
+	if f.clientCache == nil {
+		return NewProviderScope(cloud, caCert, logger)
+	}
+
+	return NewCachedProviderScope(f.clientCache, cloud, caCert, logger)
+}
+
+func (f *providerScopeFactory) NewClientScopeFromImage(ctx context.Context, ctrlClient client.Client, openStackImage *v1alpha1.OpenStackImage, defaultCACert []byte, logger logr.Logger) (Scope, error) {
+	var cloud clientconfig.Cloud
+	var caCert []byte
+
+	if openStackImage.Spec.IdentityRef != nil {
+		var err error
+		cloud, caCert, err = getCloudFromSecret(ctx, ctrlClient, openStackImage.Namespace, openStackImage.Spec.IdentityRef.Name, openStackImage.Spec.CloudName)
 		if err != nil {
 			return nil, err
 		}
@@ -141,6 +253,7 @@ type providerScope struct {
 	providerClient     *gophercloud.ProviderClient
 	providerClientOpts *clientconfig.ClientOpts
 	projectID          string
+	tlsConfig          *tls.Config
 }
 
 func NewProviderScope(cloud clientconfig.Cloud, caCert []byte, logger logr.Logger) (Scope, error) {
@@ -153,6 +266,7 @@ func NewProviderScope(cloud clientconfig.Cloud, caCert []byte, logger logr.Logge
 		providerClient:     providerClient,
 		providerClientOpts: clientOpts,
 		projectID:          projectID,
+		tlsConfig:          newTLSConfig(cloud, caCert),
 	}, nil
 }
 
@@ -204,10 +318,24 @@ func (s *providerScope) NewImageClient() (clients.ImageClient, error) {
 	return clients.NewImageClient(s.providerClient, s.providerClientOpts)
 }
 
+// NewHTTPClient returns a plain HTTP client which uses the same TLS
+// configuration as the OpenStack clients, including any CA certificates of the
+// identity.
+func (s *providerScope) NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: s.tlsConfig.Clone()},
+		Timeout:   timeout,
+	}
+}
+
 func (s *providerScope) NewLbClient() (clients.LbClient, error) {
 	return clients.NewLbClient(s.providerClient, s.providerClientOpts)
 }
 
+func (s *providerScope) NewDNSClient() (clients.DNSClient, error) {
+	return clients.NewDNSClient(s.providerClient, s.providerClientOpts)
+}
+
 func (s *providerScope) ExtractToken() (*tokens.Token, error) {
 	client, err := openstack.NewIdentityV3(s.providerClient, gophercloud.EndpointOpts{})
 	if err != nil {
@@ -246,22 +374,7 @@ func NewProviderClient(cloud clientconfig.Cloud, caCert []byte, logger logr.Logg
 	ua.Prepend(fmt.Sprintf("cluster-api-provider-openstack/%s", version.Get().String()))
 	provider.UserAgent = ua
 
-	config := &tls.Config{
-		MinVersion: tls.VersionTLS12,
-	}
-	if cloud.Verify != nil {
-		config.InsecureSkipVerify = !*cloud.Verify
-	}
-	if caCert != nil {
-		config.RootCAs = x509.NewCertPool()
-		ok := config.RootCAs.AppendCertsFromPEM(caCert)
-		if !ok {
-			// If no certificates were successfully parsed, set RootCAs to nil to use the host's root CA
-			config.RootCAs = nil
-		}
-	}
-
-	provider.HTTPClient.Transport = &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config}
+	provider.HTTPClient.Transport = &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: newTLSConfig(cloud, caCert)}
 	if klog.V(6).Enabled() {
 		provider.HTTPClient.Transport = &osclient.RoundTripper{
 			Rt:     provider.HTTPClient.Transport,
@@ -281,6 +394,25 @@ func NewProviderClient(cloud clientconfig.Cloud, caCert []byte, logger logr.Logg
 	return provider, clientOpts, projectID, nil
 }
 
+// newTLSConfig returns the TLS configuration used to connect to the given cloud.
+func newTLSConfig(cloud clientconfig.Cloud, caCert []byte) *tls.Config {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if cloud.Verify != nil {
+		config.InsecureSkipVerify = !*cloud.Verify
+	}
+	if caCert != nil {
+		config.RootCAs = x509.NewCertPool()
+		ok := config.RootCAs.AppendCertsFromPEM(caCert)
+		if !ok {
+			// If no certificates were successfully parsed, set RootCAs to nil to use the host's root CA
+			config.RootCAs = nil
+		}
+	}
+	return config
+}
+
 type gophercloudLogger struct {
 	logger logr.Logger
 }
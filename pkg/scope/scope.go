@@ -18,6 +18,8 @@ package scope
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
@@ -25,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 )
@@ -43,8 +46,12 @@ func NewFactory(maxCacheSize int) Factory {
 // Factory instantiates a new Scope using credentials from either a cluster or a machine.
 type Factory interface {
 	NewClientScopeFromMachine(ctx context.Context, ctrlClient client.Client, openStackMachine *infrav1.OpenStackMachine, openStackCluster *infrav1.OpenStackCluster, defaultCACert []byte, logger logr.Logger) (Scope, error)
+	NewClientScopeFromMachineTemplate(ctx context.Context, ctrlClient client.Client, openStackMachineTemplate *infrav1.OpenStackMachineTemplate, openStackCluster *infrav1.OpenStackCluster, defaultCACert []byte, logger logr.Logger) (Scope, error)
+	NewClientScopeFromMachinePool(ctx context.Context, ctrlClient client.Client, openStackMachinePool *infrav1.OpenStackMachinePool, openStackCluster *infrav1.OpenStackCluster, defaultCACert []byte, logger logr.Logger) (Scope, error)
 	NewClientScopeFromCluster(ctx context.Context, ctrlClient client.Client, openStackCluster *infrav1.OpenStackCluster, defaultCACert []byte, logger logr.Logger) (Scope, error)
-	NewClientScopeFromFloatingIPPool(ctx context.Context, ctrlClient client.Client, openStackCluster *v1alpha1.OpenStackFloatingIPPool, defaultCACert []byte, logger logr.Logger) (Scope, error)
+	NewClientScopeFromFloatingIPPool(ctx context.Context, ctrlClient client.Client, openStackFloatingIPPool infrav1alpha2.FloatingIPPool, defaultCACert []byte, logger logr.Logger) (Scope, error)
+	NewClientScopeFromFixedIPPool(ctx context.Context, ctrlClient client.Client, openStackFixedIPPool *v1alpha1.OpenStackFixedIPPool, defaultCACert []byte, logger logr.Logger) (Scope, error)
+	NewClientScopeFromImage(ctx context.Context, ctrlClient client.Client, openStackImage *v1alpha1.OpenStackImage, defaultCACert []byte, logger logr.Logger) (Scope, error)
 }
 
 // Scope contains arguments common to most operations.
@@ -54,6 +61,8 @@ type Scope interface {
 	NewImageClient() (clients.ImageClient, error)
 	NewNetworkClient() (clients.NetworkClient, error)
 	NewLbClient() (clients.LbClient, error)
+	NewDNSClient() (clients.DNSClient, error)
+	NewHTTPClient(timeout time.Duration) *http.Client
 	ProjectID() string
 	ExtractToken() (*tokens.Token, error)
 }
@@ -87,6 +87,22 @@ func IsConflict(err error) bool {
 	return false
 }
 
+func IsForbidden(err error) bool {
+	var errDefault403 gophercloud.ErrDefault403
+	if errors.As(err, &errDefault403) {
+		return true
+	}
+
+	var errUnexpectedResponseCode gophercloud.ErrUnexpectedResponseCode
+	if errors.As(err, &errUnexpectedResponseCode) {
+		if errUnexpectedResponseCode.Actual == http.StatusForbidden {
+			return true
+		}
+	}
+
+	return false
+}
+
 func IsNotImplementedError(err error) bool {
 	var errUnexpectedResponseCode gophercloud.ErrUnexpectedResponseCode
 	if errors.As(err, &errUnexpectedResponseCode) {
@@ -19,7 +19,9 @@ package filterconvert
 import (
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
 	securitygroups "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 
@@ -63,6 +65,23 @@ func SubnetFilterToListOpts(subnetFilter *infrav1.SubnetFilter) subnets.ListOpts
 	}
 }
 
+func SubnetPoolFilterToListOpts(subnetPoolFilter *infrav1.SubnetPoolFilter) subnetpools.ListOpts {
+	if subnetPoolFilter == nil {
+		return subnetpools.ListOpts{}
+	}
+	return subnetpools.ListOpts{
+		ID:             subnetPoolFilter.ID,
+		Name:           subnetPoolFilter.Name,
+		Description:    subnetPoolFilter.Description,
+		ProjectID:      subnetPoolFilter.ProjectID,
+		AddressScopeID: subnetPoolFilter.AddressScopeID,
+		Tags:           infrav1.JoinTags(subnetPoolFilter.Tags),
+		TagsAny:        infrav1.JoinTags(subnetPoolFilter.TagsAny),
+		NotTags:        infrav1.JoinTags(subnetPoolFilter.NotTags),
+		NotTagsAny:     infrav1.JoinTags(subnetPoolFilter.NotTagsAny),
+	}
+}
+
 func NetworkFilterToListOpts(networkFilter *infrav1.NetworkFilter) networks.ListOpts {
 	if networkFilter == nil {
 		return networks.ListOpts{}
@@ -79,6 +98,22 @@ func NetworkFilterToListOpts(networkFilter *infrav1.NetworkFilter) networks.List
 	}
 }
 
+func QoSPolicyFilterToListOpts(qosPolicyFilter *infrav1.QoSPolicyFilter) policies.ListOpts {
+	if qosPolicyFilter == nil {
+		return policies.ListOpts{}
+	}
+	return policies.ListOpts{
+		ID:          qosPolicyFilter.ID,
+		Name:        qosPolicyFilter.Name,
+		Description: qosPolicyFilter.Description,
+		ProjectID:   qosPolicyFilter.ProjectID,
+		Tags:        infrav1.JoinTags(qosPolicyFilter.Tags),
+		TagsAny:     infrav1.JoinTags(qosPolicyFilter.TagsAny),
+		NotTags:     infrav1.JoinTags(qosPolicyFilter.NotTags),
+		NotTagsAny:  infrav1.JoinTags(qosPolicyFilter.NotTagsAny),
+	}
+}
+
 func RouterFilterToListOpts(routerFilter *infrav1.RouterFilter) routers.ListOpts {
 	if routerFilter == nil {
 		return routers.ListOpts{}
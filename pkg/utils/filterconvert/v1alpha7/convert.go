@@ -18,6 +18,7 @@ package convert
 
 import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 
 	infrav1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
 )
@@ -36,3 +37,23 @@ func NetworkFilterToListOpt(networkFilter *infrav1alpha7.NetworkFilter) networks
 		NotTagsAny:  networkFilter.NotTagsAny,
 	}
 }
+
+// SubnetFilterToListOpts converts a v1alpha7.SubnetFilter to a subnets.ListOpts
+// Still used by the Floating IP IPAM controller until we bump it to v1beta1.
+func SubnetFilterToListOpts(subnetFilter *infrav1alpha7.SubnetFilter) subnets.ListOpts {
+	return subnets.ListOpts{
+		Name:            subnetFilter.Name,
+		Description:     subnetFilter.Description,
+		ProjectID:       subnetFilter.ProjectID,
+		IPVersion:       subnetFilter.IPVersion,
+		GatewayIP:       subnetFilter.GatewayIP,
+		CIDR:            subnetFilter.CIDR,
+		IPv6AddressMode: subnetFilter.IPv6AddressMode,
+		IPv6RAMode:      subnetFilter.IPv6RAMode,
+		ID:              subnetFilter.ID,
+		Tags:            subnetFilter.Tags,
+		TagsAny:         subnetFilter.TagsAny,
+		NotTags:         subnetFilter.NotTags,
+		NotTagsAny:      subnetFilter.NotTagsAny,
+	}
+}
@@ -23,6 +23,15 @@ import (
 
 const (
 	FloatingAddressIPClaimNameSuffix = "floating-ip-address"
+	FixedAddressIPClaimNameSuffix    = "fixed-ip-address"
+
+	// ClusterUIDTagPrefix is the prefix of the tag identifying the cluster
+	// a Neutron resource was created for.
+	ClusterUIDTagPrefix = "capo-cluster-uid="
+
+	// SecurityGroupRoleTagPrefix is the prefix of the tag identifying the role
+	// of a security group created for a cluster.
+	SecurityGroupRoleTagPrefix = "capo-security-group="
 )
 
 func GetDescription(clusterName string) string {
@@ -33,6 +42,21 @@ func GetFloatingAddressClaimName(openStackMachineName string) string {
 	return fmt.Sprintf("%s-%s", openStackMachineName, FloatingAddressIPClaimNameSuffix)
 }
 
+// GetFixedAddressClaimName returns the name of the IPAddressClaim of a fixed IP of a port of the machine.
+func GetFixedAddressClaimName(openStackMachineName string, portIndex, fixedIPIndex int) string {
+	return fmt.Sprintf("%s-%d-%d-%s", openStackMachineName, portIndex, fixedIPIndex, FixedAddressIPClaimNameSuffix)
+}
+
 func GetOpenStackMachineNameFromClaimName(claimName string) string {
 	return strings.TrimSuffix(claimName, fmt.Sprintf("-%s", FloatingAddressIPClaimNameSuffix))
 }
+
+// GetClusterUIDTag returns the tag identifying the cluster with the given UID.
+func GetClusterUIDTag(clusterUID string) string {
+	return ClusterUIDTagPrefix + clusterUID
+}
+
+// GetSecurityGroupRoleTag returns the tag identifying a security group with the given role.
+func GetSecurityGroupRoleTag(role string) string {
+	return SecurityGroupRoleTagPrefix + role
+}
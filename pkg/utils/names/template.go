@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package names
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+// ResourceNameData is the data available to the templates of infrav1.ResourceNaming.
+type ResourceNameData struct {
+	ClusterNamespace string
+	ClusterName      string
+	MachineName      string
+	Role             string
+	Index            int
+	// FailureDomain is the failure domain of the machine. It is only set for the
+	// name templates of existing volumes, as a key which is stable when the machine
+	// is replaced.
+	FailureDomain string
+}
+
+// GetResourceNaming returns the resource naming templates of the cluster. It never returns nil.
+func GetResourceNaming(openStackCluster *infrav1.OpenStackCluster) *infrav1.ResourceNaming {
+	if openStackCluster.Spec.ResourceNaming == nil {
+		return &infrav1.ResourceNaming{}
+	}
+	return openStackCluster.Spec.ResourceNaming
+}
+
+// GetClusterResourceNameData returns the resource name data of the cluster.
+// The cluster name is the name of the owning Cluster, which is also used for
+// the default resource names. Before the owner reference is set, it is taken
+// from the cluster name label, and falls back to the name of the OpenStackCluster.
+func GetClusterResourceNameData(openStackCluster *infrav1.OpenStackCluster) ResourceNameData {
+	clusterName := getOwnerClusterName(openStackCluster)
+	if clusterName == "" {
+		clusterName = openStackCluster.Labels[clusterv1.ClusterNameLabel]
+	}
+	if clusterName == "" {
+		clusterName = openStackCluster.Name
+	}
+	return ResourceNameData{
+		ClusterNamespace: openStackCluster.Namespace,
+		ClusterName:      clusterName,
+	}
+}
+
+// getOwnerClusterName returns the name of the Cluster owning the OpenStackCluster, or an empty string.
+func getOwnerClusterName(openStackCluster *infrav1.OpenStackCluster) string {
+	for _, ref := range openStackCluster.OwnerReferences {
+		if ref.Kind != "Cluster" {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			continue
+		}
+		if gv.Group == clusterv1.GroupVersion.Group {
+			return ref.Name
+		}
+	}
+	return ""
+}
+
+// GetResourceName renders the given name template, or returns defaultName if
+// nameTemplate is nil.
+func GetResourceName(nameTemplate *string, data ResourceNameData, defaultName string) (string, error) {
+	if nameTemplate == nil {
+		return defaultName, nil
+	}
+
+	tmpl, err := template.New("name").Parse(*nameTemplate)
+	if err != nil {
+		return "", fmt.Errorf("invalid name template %q: %w", *nameTemplate, err)
+	}
+
+	var name strings.Builder
+	if err := tmpl.Execute(&name, data); err != nil {
+		return "", fmt.Errorf("failed to render name template %q: %w", *nameTemplate, err)
+	}
+	if name.Len() == 0 {
+		return "", fmt.Errorf("name template %q rendered an empty name", *nameTemplate)
+	}
+	return name.String(), nil
+}
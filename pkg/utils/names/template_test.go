@@ -0,0 +1,162 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package names
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+func TestGetResourceName(t *testing.T) {
+	data := ResourceNameData{
+		ClusterNamespace: "ns",
+		ClusterName:      "cluster",
+		MachineName:      "machine",
+		Role:             "role",
+		Index:            1,
+	}
+
+	tests := []struct {
+		name         string
+		nameTemplate *string
+		data         ResourceNameData
+		want         string
+		wantErr      bool
+	}{
+		{
+			name: "Returns the default name without a template",
+			data: data,
+			want: "default",
+		},
+		{
+			name:         "Renders all fields",
+			nameTemplate: pointer.String("{{ .ClusterNamespace }}-{{ .ClusterName }}-{{ .MachineName }}-{{ .Role }}-{{ .Index }}"),
+			data:         data,
+			want:         "ns-cluster-machine-role-1",
+		},
+		{
+			name:         "Renders a template without fields",
+			nameTemplate: pointer.String("static"),
+			data:         data,
+			want:         "static",
+		},
+		{
+			name:         "Renders conditionals",
+			nameTemplate: pointer.String("{{ .MachineName }}-{{ if .Role }}{{ .Role }}{{ else }}{{ .Index }}{{ end }}"),
+			data:         ResourceNameData{MachineName: "machine", Index: 2},
+			want:         "machine-2",
+		},
+		{
+			name:         "Fails to parse an invalid template",
+			nameTemplate: pointer.String("{{ .ClusterName"),
+			data:         data,
+			wantErr:      true,
+		},
+		{
+			name:         "Fails to render an unknown field",
+			nameTemplate: pointer.String("{{ .Missing }}"),
+			data:         data,
+			wantErr:      true,
+		},
+		{
+			name:         "Fails to render an empty name",
+			nameTemplate: pointer.String("{{ .Role }}"),
+			data:         ResourceNameData{ClusterName: "cluster"},
+			wantErr:      true,
+		},
+		{
+			name:         "Fails to render an empty template",
+			nameTemplate: pointer.String(""),
+			data:         data,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := GetResourceName(tt.nameTemplate, tt.data, "default")
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetClusterResourceNameData(t *testing.T) {
+	tests := []struct {
+		name       string
+		objectMeta metav1.ObjectMeta
+		want       ResourceNameData
+	}{
+		{
+			name: "Uses the name of the owning Cluster",
+			objectMeta: metav1.ObjectMeta{
+				Name:      "osc",
+				Namespace: "ns",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "label"},
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "other.x-k8s.io/v1beta1", Kind: "Cluster", Name: "other"},
+					{APIVersion: clusterv1.GroupVersion.String(), Kind: "Cluster", Name: "cluster"},
+				},
+			},
+			want: ResourceNameData{ClusterNamespace: "ns", ClusterName: "cluster"},
+		},
+		{
+			name: "Uses the cluster name label without an owning Cluster",
+			objectMeta: metav1.ObjectMeta{
+				Name:      "osc",
+				Namespace: "ns",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "label"},
+			},
+			want: ResourceNameData{ClusterNamespace: "ns", ClusterName: "label"},
+		},
+		{
+			name: "Uses the name of the OpenStackCluster without a label",
+			objectMeta: metav1.ObjectMeta{
+				Name:      "osc",
+				Namespace: "ns",
+			},
+			want: ResourceNameData{ClusterNamespace: "ns", ClusterName: "osc"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			openStackCluster := &infrav1.OpenStackCluster{ObjectMeta: tt.objectMeta}
+			g.Expect(GetClusterResourceNameData(openStackCluster)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetResourceNaming(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(GetResourceNaming(&infrav1.OpenStackCluster{})).To(Equal(&infrav1.ResourceNaming{}))
+
+	resourceNaming := &infrav1.ResourceNaming{Network: pointer.String("network")}
+	openStackCluster := &infrav1.OpenStackCluster{Spec: infrav1.OpenStackClusterSpec{ResourceNaming: resourceNaming}}
+	g.Expect(GetResourceNaming(openStackCluster)).To(BeIdenticalTo(resourceNaming))
+}
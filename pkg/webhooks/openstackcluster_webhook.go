@@ -19,9 +19,12 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"reflect"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -30,8 +33,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
 
+// maxResourceNameLength is the maximum length of the name of an OpenStack resource.
+const maxResourceNameLength = 255
+
 // +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-openstackcluster,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=openstackclusters,versions=v1beta1,name=validation.openstackcluster.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
 
 func SetupOpenStackClusterWebhook(mgr manager.Manager) error {
@@ -55,21 +62,151 @@ func (*openStackClusterWebhook) ValidateCreate(_ context.Context, objRaw runtime
 		return nil, err
 	}
 
+	allErrs = append(allErrs, validateBastion(newObj.Spec.Bastion)...)
+
+	if newObj.Spec.ManagedRouter != nil && newObj.Spec.Router != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "managedRouter"), "managedRouter cannot be used with router"))
+	}
+
+	if newObj.Spec.APIServerVIP.IsEnabled() && newObj.Spec.APIServerLoadBalancer.IsEnabled() {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "apiServerVIP"), "apiServerVIP cannot be used with apiServerLoadBalancer"))
+	}
+
+	// The control plane endpoint cannot be replaced by the name of the DNS record once its host is set.
+	if newObj.Spec.DNSRecords != nil && newObj.Spec.ControlPlaneEndpoint != nil {
+		if _, err := netip.ParseAddr(newObj.Spec.ControlPlaneEndpoint.Host); err == nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "dnsRecords"), "dnsRecords cannot be used with a controlPlaneEndpoint host which is an IP address"))
+		}
+	}
+
 	if newObj.Spec.ManagedSecurityGroups != nil {
-		for _, rule := range newObj.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules {
-			if rule.RemoteManagedGroups != nil && (rule.RemoteGroupID != nil || rule.RemoteIPPrefix != nil) {
-				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "managedSecurityGroups", "allNodesSecurityGroupRules"), "remoteManagedGroups cannot be used with remoteGroupID or remoteIPPrefix"))
+		fldPath := field.NewPath("spec", "managedSecurityGroups")
+		allErrs = append(allErrs, validateSecurityGroupRules(fldPath.Child("allNodesSecurityGroupRules"), newObj.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules)...)
+		allErrs = append(allErrs, validateSecurityGroupRules(fldPath.Child("controlPlaneNodesSecurityGroupRules"), newObj.Spec.ManagedSecurityGroups.ControlPlaneNodesSecurityGroupRules)...)
+		allErrs = append(allErrs, validateSecurityGroupRules(fldPath.Child("workerNodesSecurityGroupRules"), newObj.Spec.ManagedSecurityGroups.WorkerNodesSecurityGroupRules)...)
+		allErrs = append(allErrs, validateSecurityGroupRules(fldPath.Child("bastionSecurityGroupRules"), newObj.Spec.ManagedSecurityGroups.BastionSecurityGroupRules)...)
+	}
+
+	allErrs = append(allErrs, validateResourceNaming(newObj)...)
+
+	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
+}
+
+// validateBastion checks that the bastion is exposed either by a floating IP or by a port forwarding.
+func validateBastion(bastion *infrav1.Bastion) field.ErrorList {
+	var allErrs field.ErrorList
+	if bastion != nil && bastion.PortForwarding != nil && bastion.FloatingIP != "" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "bastion", "portForwarding"), "portForwarding cannot be used with floatingIP"))
+	}
+	if bastion != nil {
+		allErrs = append(allErrs, validatePortFixedIPs(field.NewPath("spec", "bastion", "instance", "ports"), bastion.Instance.Ports, false)...)
+		for i, cidr := range bastion.AllowedCIDRs {
+			if _, err := netip.ParsePrefix(cidr); err != nil {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "bastion", "allowedCIDRs").Index(i), cidr, "must be a valid CIDR"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateSecurityGroupRules checks that the Remote* fields of the given rules are mutually exclusive.
+func validateSecurityGroupRules(fldPath *field.Path, rules []infrav1.SecurityGroupRuleSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, rule := range rules {
+		if rule.RemoteManagedGroups != nil && (rule.RemoteGroupID != nil || rule.RemoteIPPrefix != nil) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "remoteManagedGroups cannot be used with remoteGroupID or remoteIPPrefix"))
+		}
+		if rule.RemoteGroupID != nil && (rule.RemoteManagedGroups != nil || rule.RemoteIPPrefix != nil) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "remoteGroupID cannot be used with remoteManagedGroups or remoteIPPrefix"))
+		}
+		if rule.RemoteIPPrefix != nil && (rule.RemoteManagedGroups != nil || rule.RemoteGroupID != nil) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "remoteIPPrefix cannot be used with remoteManagedGroups or remoteGroupID"))
+		}
+		if rule.RemoteAddressGroup != nil && (rule.RemoteManagedGroups != nil || rule.RemoteGroupID != nil || rule.RemoteIPPrefix != nil) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "remoteAddressGroup cannot be used with remoteManagedGroups, remoteGroupID or remoteIPPrefix"))
+		}
+	}
+	return allErrs
+}
+
+// validateResourceNaming checks that the naming templates of the cluster can
+// be rendered, that the rendered names fit in the OpenStack name limits, and
+// that templates of resources which exist several times per cluster or per
+// machine render a distinct name for each of them.
+func validateResourceNaming(openStackCluster *infrav1.OpenStackCluster) field.ErrorList {
+	var allErrs field.ErrorList
+
+	resourceNaming := openStackCluster.Spec.ResourceNaming
+	if resourceNaming == nil {
+		return allErrs
+	}
+
+	clusterData := names.GetClusterResourceNameData(openStackCluster)
+	withRole := func(data names.ResourceNameData, role string) names.ResourceNameData {
+		data.Role = role
+		return data
+	}
+	// Render the templates of resources belonging to a machine with the
+	// longest machine names allowed by Cluster API.
+	machineData := func(machineName string, index int, role string) names.ResourceNameData {
+		data := withRole(clusterData, role)
+		data.MachineName = strings.Repeat(machineName, validation.DNS1123LabelMaxLength)
+		data.Index = index
+		return data
+	}
+
+	fldPath := field.NewPath("spec", "resourceNaming")
+	for _, tmpl := range []struct {
+		name      string
+		template  *string
+		data      []names.ResourceNameData
+		distinct  string
+		maxLength int
+	}{
+		{"network", resourceNaming.Network, []names.ResourceNameData{clusterData}, "", maxResourceNameLength},
+		{"subnet", resourceNaming.Subnet, []names.ResourceNameData{clusterData}, "", maxResourceNameLength},
+		{"router", resourceNaming.Router, []names.ResourceNameData{clusterData}, "", maxResourceNameLength},
+		{
+			"securityGroup", resourceNaming.SecurityGroup,
+			[]names.ResourceNameData{withRole(clusterData, "controlplane"), withRole(clusterData, "worker"), withRole(clusterData, "bastion")},
+			"roles", maxResourceNameLength,
+		},
+		{"loadBalancer", resourceNaming.LoadBalancer, []names.ResourceNameData{clusterData}, "", maxResourceNameLength},
+		{
+			"port", resourceNaming.Port,
+			[]names.ResourceNameData{machineData("m", 0, "a"), machineData("m", 1, "b"), machineData("n", 0, "a")},
+			"machines or ports", maxResourceNameLength,
+		},
+		{
+			"volume", resourceNaming.Volume,
+			[]names.ResourceNameData{machineData("m", 0, "root"), machineData("m", 0, "etcd"), machineData("n", 0, "root")},
+			"machines or volumes", maxResourceNameLength,
+		},
+		{"bastion", resourceNaming.Bastion, []names.ResourceNameData{clusterData}, "", validation.DNS1123LabelMaxLength},
+	} {
+		if tmpl.template == nil {
+			continue
+		}
+		rendered := make(map[string]struct{}, len(tmpl.data))
+		for _, data := range tmpl.data {
+			name, err := names.GetResourceName(tmpl.template, data, "")
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child(tmpl.name), *tmpl.template, err.Error()))
+				break
 			}
-			if rule.RemoteGroupID != nil && (rule.RemoteManagedGroups != nil || rule.RemoteIPPrefix != nil) {
-				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "managedSecurityGroups", "allNodesSecurityGroupRules"), "remoteGroupID cannot be used with remoteManagedGroups or remoteIPPrefix"))
+			if len(name) > tmpl.maxLength {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child(tmpl.name), *tmpl.template, fmt.Sprintf("rendered name %q is longer than %d characters", name, tmpl.maxLength)))
+				break
 			}
-			if rule.RemoteIPPrefix != nil && (rule.RemoteManagedGroups != nil || rule.RemoteGroupID != nil) {
-				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "managedSecurityGroups", "allNodesSecurityGroupRules"), "remoteIPPrefix cannot be used with remoteManagedGroups or remoteGroupID"))
+			if _, ok := rendered[name]; ok {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child(tmpl.name), *tmpl.template, fmt.Sprintf("renders the same name %q for different %s", name, tmpl.distinct)))
+				break
 			}
+			rendered[name] = struct{}{}
 		}
 	}
 
-	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
+	return allErrs
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
@@ -84,6 +221,15 @@ func (*openStackClusterWebhook) ValidateUpdate(_ context.Context, oldObjRaw, new
 		return nil, err
 	}
 
+	// The UID the OpenStack resources are tagged with cannot be changed once it has been recorded.
+	if oldUID := oldObj.Annotations[infrav1.ClusterUIDAnnotation]; oldUID != "" && newObj.Annotations[infrav1.ClusterUIDAnnotation] != oldUID {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("metadata", "annotations", infrav1.ClusterUIDAnnotation), "cannot be changed once set"))
+	}
+
+	if newObj.Spec.ManagedRouter != nil && newObj.Spec.Router != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "managedRouter"), "managedRouter cannot be used with router"))
+	}
+
 	// Allow changes to Spec.IdentityRef
 	oldObj.Spec.IdentityRef = infrav1.OpenStackIdentityReference{}
 	newObj.Spec.IdentityRef = infrav1.OpenStackIdentityReference{}
@@ -115,24 +261,64 @@ func (*openStackClusterWebhook) ValidateUpdate(_ context.Context, oldObjRaw, new
 		}
 	}
 
+	allErrs = append(allErrs, validateBastion(newObj.Spec.Bastion)...)
+
 	// Allow changes to the bastion spec.
 	oldObj.Spec.Bastion = &infrav1.Bastion{}
 	newObj.Spec.Bastion = &infrav1.Bastion{}
 
-	// Allow changes to the managed allNodesSecurityGroupRules.
+	// Allow changes to the managed security group rules.
 	if newObj.Spec.ManagedSecurityGroups != nil {
 		oldObj.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules = []infrav1.SecurityGroupRuleSpec{}
 		newObj.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules = []infrav1.SecurityGroupRuleSpec{}
+		oldObj.Spec.ManagedSecurityGroups.ControlPlaneNodesSecurityGroupRules = []infrav1.SecurityGroupRuleSpec{}
+		newObj.Spec.ManagedSecurityGroups.ControlPlaneNodesSecurityGroupRules = []infrav1.SecurityGroupRuleSpec{}
+		oldObj.Spec.ManagedSecurityGroups.WorkerNodesSecurityGroupRules = []infrav1.SecurityGroupRuleSpec{}
+		newObj.Spec.ManagedSecurityGroups.WorkerNodesSecurityGroupRules = []infrav1.SecurityGroupRuleSpec{}
+		oldObj.Spec.ManagedSecurityGroups.BastionSecurityGroupRules = []infrav1.SecurityGroupRuleSpec{}
+		newObj.Spec.ManagedSecurityGroups.BastionSecurityGroupRules = []infrav1.SecurityGroupRuleSpec{}
 
 		// Allow change to the allowAllInClusterTraffic.
 		oldObj.Spec.ManagedSecurityGroups.AllowAllInClusterTraffic = false
 		newObj.Spec.ManagedSecurityGroups.AllowAllInClusterTraffic = false
+
+		// Allow change to the stateful attribute.
+		oldObj.Spec.ManagedSecurityGroups.Stateful = nil
+		newObj.Spec.ManagedSecurityGroups.Stateful = nil
 	}
 
 	// Allow changes on AllowedCIDRs
 	if newObj.Spec.APIServerLoadBalancer != nil && oldObj.Spec.APIServerLoadBalancer != nil {
 		oldObj.Spec.APIServerLoadBalancer.AllowedCIDRs = []string{}
 		newObj.Spec.APIServerLoadBalancer.AllowedCIDRs = []string{}
+
+		// Allow changes to the listener settings, except the pool protocol
+		// which cannot be changed on an existing pool.
+		oldObj.Spec.APIServerLoadBalancer.Listeners = getListenerPoolProtocols(oldObj.Spec.APIServerLoadBalancer.Listeners)
+		newObj.Spec.APIServerLoadBalancer.Listeners = getListenerPoolProtocols(newObj.Spec.APIServerLoadBalancer.Listeners)
+	}
+
+	// Allow changes to the MTU of the network.
+	oldObj.Spec.NetworkMTU = nil
+	newObj.Spec.NetworkMTU = nil
+
+	// Allow changes to the DNS nameservers and allocation pools of the managed subnets.
+	for _, obj := range []*infrav1.OpenStackCluster{oldObj, newObj} {
+		for i := range obj.Spec.ManagedSubnets {
+			obj.Spec.ManagedSubnets[i].DNSNameservers = nil
+			obj.Spec.ManagedSubnets[i].AllocationPools = nil
+		}
+	}
+
+	// Allow changes to the SNAT setting and the extra routes of the managed router.
+	for _, obj := range []*infrav1.OpenStackCluster{oldObj, newObj} {
+		if obj.Spec.ManagedRouter != nil {
+			obj.Spec.ManagedRouter.EnableSNAT = nil
+			obj.Spec.ManagedRouter.ExtraRoutes = nil
+			if reflect.DeepEqual(*obj.Spec.ManagedRouter, infrav1.ManagedRouterOptions{}) {
+				obj.Spec.ManagedRouter = nil
+			}
+		}
 	}
 
 	// Allow changes to the availability zones.
@@ -157,6 +343,21 @@ func (*openStackClusterWebhook) ValidateUpdate(_ context.Context, oldObjRaw, new
 	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
 }
 
+// getListenerPoolProtocols returns the listeners which do not use the default
+// pool protocol, with all other settings cleared.
+func getListenerPoolProtocols(listeners []infrav1.APIServerLoadBalancerListener) []infrav1.APIServerLoadBalancerListener {
+	var poolProtocols []infrav1.APIServerLoadBalancerListener
+	for _, listener := range listeners {
+		if listener.PoolProtocol != "" && listener.PoolProtocol != "TCP" {
+			poolProtocols = append(poolProtocols, infrav1.APIServerLoadBalancerListener{
+				Port:         listener.Port,
+				PoolProtocol: listener.PoolProtocol,
+			})
+		}
+	}
+	return poolProtocols
+}
+
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
 func (*openStackClusterWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
 	return nil, nil
@@ -21,7 +21,10 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 )
@@ -114,6 +117,61 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Adding OpenStackCluster.Spec.Bastion.PortForwarding with OpenStackCluster.Spec.Bastion.FloatingIP is not allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Bastion: &infrav1.Bastion{
+						Enabled:    true,
+						FloatingIP: "203.0.113.10",
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Bastion: &infrav1.Bastion{
+						Enabled:    true,
+						FloatingIP: "203.0.113.10",
+						PortForwarding: &infrav1.BastionPortForwarding{
+							FloatingIP: "203.0.113.20",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.ManagedSecurityGroups.Stateful is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						Stateful: pointer.Bool(false),
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Changing security group rules on the OpenStackCluster.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
@@ -158,6 +216,59 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Adding per-role rules to the OpenStackCluster.Spec.ManagedSecurityGroups is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						ControlPlaneNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:           "kubernetes-api",
+								Direction:      "ingress",
+								PortRangeMin:   pointer.Int(6443),
+								PortRangeMax:   pointer.Int(6443),
+								Protocol:       pointer.String("tcp"),
+								RemoteIPPrefix: pointer.String("10.0.0.0/8"),
+							},
+						},
+						WorkerNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:                "bgp",
+								Direction:           "ingress",
+								PortRangeMin:        pointer.Int(179),
+								PortRangeMax:        pointer.Int(179),
+								Protocol:            pointer.String("tcp"),
+								RemoteManagedGroups: []infrav1.ManagedSecurityGroupName{"worker"},
+							},
+						},
+						BastionSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:           "ssh",
+								Direction:      "ingress",
+								PortRangeMin:   pointer.Int(22),
+								PortRangeMax:   pointer.Int(22),
+								Protocol:       pointer.String("tcp"),
+								RemoteIPPrefix: pointer.String("192.0.2.0/24"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Changing CIDRs on the OpenStackCluster.Spec.APIServerLoadBalancer.AllowedCIDRs is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
@@ -193,6 +304,70 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.Listeners settings is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: pointer.Bool(true),
+						Listeners: []infrav1.APIServerLoadBalancerListener{
+							{Port: 6443, PoolProtocol: "PROXY", TimeoutClientData: pointer.Int(50000)},
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: pointer.Bool(true),
+						Listeners: []infrav1.APIServerLoadBalancerListener{
+							{Port: 6443, PoolProtocol: "PROXY", TimeoutClientData: pointer.Int(3600000), AllowedCIDRs: []string{"192.168.0.0/16"}},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.Listeners pool protocol is not allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: pointer.Bool(true),
+						Listeners: []infrav1.APIServerLoadBalancerListener{
+							{Port: 6443},
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: pointer.Bool(true),
+						Listeners: []infrav1.APIServerLoadBalancerListener{
+							{Port: 6443, PoolProtocol: "PROXY"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Adding OpenStackCluster.Spec.ControlPlaneAvailabilityZones is allowed",
 			oldTemplate: &infrav1.OpenStackCluster{
@@ -496,6 +671,138 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Changing OpenStackCluster.Spec.NetworkMTU is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					NetworkMTU: pointer.Int(1450),
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					NetworkMTU: pointer.Int(1500),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.ManagedSubnets DNSNameservers and AllocationPools is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR:           "10.0.0.0/24",
+							DNSNameservers: []string{"8.8.8.8"},
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR:            "10.0.0.0/24",
+							DNSNameservers:  []string{"1.1.1.1", "8.8.4.4"},
+							AllocationPools: []infrav1.AllocationPool{{Start: "10.0.0.10", End: "10.0.0.100"}},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.ManagedSubnets CIDR is not allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR: "10.0.0.0/24",
+						},
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR: "10.1.0.0/24",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Adding OpenStackCluster.Spec.ManagedRouter SNAT setting and extra routes is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedRouter: &infrav1.ManagedRouterOptions{
+						EnableSNAT:  pointer.Bool(false),
+						ExtraRoutes: []infrav1.RouterRoute{{Destination: "192.168.100.0/24", NextHop: "10.0.0.254"}},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Adding OpenStackCluster.Spec.ManagedRouter with an existing router is not allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					Router: &infrav1.RouterFilter{Name: "router"},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					Router: &infrav1.RouterFilter{Name: "router"},
+					ManagedRouter: &infrav1.ManagedRouterOptions{
+						EnableSNAT: pointer.Bool(false),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.ManagedRouter HA is not allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedRouter: &infrav1.ManagedRouterOptions{
+						HA: pointer.Bool(false),
+					},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedRouter: &infrav1.ManagedRouterOptions{
+						HA:         pointer.Bool(true),
+						EnableSNAT: pointer.Bool(false),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:        "Setting the cluster UID annotation is allowed",
+			oldTemplate: &infrav1.OpenStackCluster{},
+			newTemplate: &infrav1.OpenStackCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{infrav1.ClusterUIDAnnotation: "5b6a1ce0-cc4c-4d0e-9b8f-7dc0b9c2d5b3"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing the cluster UID annotation is not allowed",
+			oldTemplate: &infrav1.OpenStackCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{infrav1.ClusterUIDAnnotation: "5b6a1ce0-cc4c-4d0e-9b8f-7dc0b9c2d5b3"},
+				},
+			},
+			newTemplate: &infrav1.OpenStackCluster{},
+			wantErr:     true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -533,6 +840,121 @@ func TestOpenStackCluster_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "OpenStackCluster.Spec.Bastion.PortForwarding on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Bastion: &infrav1.Bastion{
+						Enabled:      true,
+						AllowedCIDRs: []string{"192.0.2.0/24"},
+						PortForwarding: &infrav1.BastionPortForwarding{
+							FloatingIP:   "203.0.113.20",
+							ExternalPort: pointer.Int(2222),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "OpenStackCluster.Spec.Bastion.PortForwarding with OpenStackCluster.Spec.Bastion.FloatingIP on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Bastion: &infrav1.Bastion{
+						Enabled:    true,
+						FloatingIP: "203.0.113.10",
+						PortForwarding: &infrav1.BastionPortForwarding{
+							FloatingIP: "203.0.113.20",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.DNSRecords with an IP address as OpenStackCluster.Spec.ControlPlaneEndpoint.Host on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ControlPlaneEndpoint: &clusterv1.APIEndpoint{
+						Host: "203.0.113.10",
+						Port: 6443,
+					},
+					DNSRecords: &infrav1.DNSRecords{
+						Zone: infrav1.DNSZoneFilter{Name: "example.com."},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.DNSRecords with a host name as OpenStackCluster.Spec.ControlPlaneEndpoint.Host on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ControlPlaneEndpoint: &clusterv1.APIEndpoint{
+						Host: "api.example.com",
+						Port: 6443,
+					},
+					DNSRecords: &infrav1.DNSRecords{
+						Zone: infrav1.DNSZoneFilter{Name: "example.com."},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "OpenStackCluster.Spec.Bastion.Instance.Ports with a fixed IP from an IP address pool on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Bastion: &infrav1.Bastion{
+						Enabled: true,
+						Instance: infrav1.OpenStackMachineSpec{
+							Ports: []infrav1.PortOpts{{
+								FixedIPs: []infrav1.FixedIP{{
+									IPAddressPoolRef: &corev1.TypedLocalObjectReference{Kind: "OpenStackFixedIPPool", Name: "pool"},
+								}},
+							}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.Bastion.AllowedCIDRs with an invalid CIDR on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					Bastion: &infrav1.Bastion{
+						Enabled:      true,
+						AllowedCIDRs: []string{"192.0.2.0/24", "192.0.2.1"},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "OpenStackCluster.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules with correct spec on create",
 			template: &infrav1.OpenStackCluster{
@@ -581,6 +1003,169 @@ func TestOpenStackCluster_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "OpenStackCluster.Spec.ManagedSecurityGroups.AllNodesSecurityGroupRules with remoteAddressGroup and remoteIPPrefix on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						AllNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:               "foobar",
+								Direction:          "ingress",
+								RemoteIPPrefix:     pointer.String("10.0.0.0/8"),
+								RemoteAddressGroup: &infrav1.AddressGroupFilter{Name: "corporate"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.ManagedSecurityGroups.WorkerNodesSecurityGroupRules with mutually exclusive fields on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+						WorkerNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+							{
+								Name:           "bgp",
+								Direction:      "ingress",
+								PortRangeMin:   pointer.Int(179),
+								PortRangeMax:   pointer.Int(179),
+								Protocol:       pointer.String("tcp"),
+								RemoteGroupID:  pointer.String("foobar"),
+								RemoteIPPrefix: pointer.String("10.0.0.0/8"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.ManagedRouter with an existing router on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					Router: &infrav1.RouterFilter{
+						Name: "router",
+					},
+					ManagedRouter: &infrav1.ManagedRouterOptions{
+						EnableSNAT: pointer.Bool(false),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.APIServerVIP on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerVIP: &infrav1.APIServerVIP{
+						Enabled: pointer.Bool(true),
+					},
+					APIServerFixedIP: pointer.String("10.6.0.10"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "OpenStackCluster.Spec.APIServerVIP with OpenStackCluster.Spec.APIServerLoadBalancer on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServerVIP: &infrav1.APIServerVIP{
+						Enabled: pointer.Bool(true),
+					},
+					APIServerLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: pointer.Bool(true),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.ResourceNaming with valid templates on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ResourceNaming: &infrav1.ResourceNaming{
+						Network: pointer.String("{{ .ClusterNamespace }}-{{ .ClusterName }}-network"),
+						Port:    pointer.String("{{ .MachineName }}-{{ .Index }}"),
+						Bastion: pointer.String("{{ .ClusterName }}-jump"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "OpenStackCluster.Spec.ResourceNaming with an invalid template on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ResourceNaming: &infrav1.ResourceNaming{
+						Router: pointer.String("{{ .Unknown }}"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.ResourceNaming with a port name which may be too long on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ResourceNaming: &infrav1.ResourceNaming{
+						Port: pointer.String("{{ .MachineName }}{{ .MachineName }}{{ .MachineName }}{{ .MachineName }}{{ .MachineName }}"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.ResourceNaming with a security group template without .Role on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ResourceNaming: &infrav1.ResourceNaming{
+						SecurityGroup: pointer.String("{{ .ClusterName }}-secgroup"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.ResourceNaming with a volume template without .Role on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ResourceNaming: &infrav1.ResourceNaming{
+						Volume: pointer.String("{{ .MachineName }}-volume"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.ResourceNaming with a port template without .MachineName on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ResourceNaming: &infrav1.ResourceNaming{
+						Port: pointer.String("{{ .ClusterName }}-{{ .Index }}"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "OpenStackCluster.Spec.ResourceNaming with distinct security group and volume templates on create",
+			template: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ResourceNaming: &infrav1.ResourceNaming{
+						SecurityGroup: pointer.String("{{ .ClusterName }}-{{ .Role }}"),
+						Volume:        pointer.String("{{ .MachineName }}-{{ .Role }}"),
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,107 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-openstackclusterfloatingippool,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=openstackclusterfloatingippools,versions=v1alpha2,name=validation.openstackclusterfloatingippool.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+func SetupOpenStackClusterFloatingIPPoolWebhook(mgr manager.Manager) error {
+	return builder.WebhookManagedBy(mgr).
+		For(&infrav1alpha2.OpenStackClusterFloatingIPPool{}).
+		WithValidator(&openStackClusterFloatingIPPoolWebhook{}).
+		Complete()
+}
+
+type openStackClusterFloatingIPPoolWebhook struct{}
+
+// Compile-time assertion that openStackClusterFloatingIPPoolWebhook implements webhook.CustomValidator.
+var _ webhook.CustomValidator = &openStackClusterFloatingIPPoolWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackClusterFloatingIPPoolWebhook) ValidateCreate(_ context.Context, objRaw runtime.Object) (admission.Warnings, error) {
+	newObj, err := castToOpenStackClusterFloatingIPPool(objRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	allErrs := validateClusterFloatingIPPoolSpec(&newObj.Spec)
+	_, err = aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
+	return getClusterFloatingIPPoolWarnings(&newObj.Spec), err
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackClusterFloatingIPPoolWebhook) ValidateUpdate(_ context.Context, _, newObjRaw runtime.Object) (admission.Warnings, error) {
+	newObj, err := castToOpenStackClusterFloatingIPPool(newObjRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	allErrs := validateClusterFloatingIPPoolSpec(&newObj.Spec)
+	_, err = aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
+	return getClusterFloatingIPPoolWarnings(&newObj.Spec), err
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackClusterFloatingIPPoolWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateClusterFloatingIPPoolSpec checks the spec shared with OpenStackFloatingIPPool, and that the namespace of the
+// identity and the namespace selector are valid.
+func validateClusterFloatingIPPoolSpec(spec *infrav1alpha2.OpenStackClusterFloatingIPPoolSpec) field.ErrorList {
+	allErrs := validateFloatingIPPoolSpec(&spec.OpenStackFloatingIPPoolSpec)
+
+	if spec.IdentityRef != nil && spec.IdentityRefNamespace == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "identityRefNamespace"), "must be set when identityRef is set"))
+	}
+
+	if spec.NamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, field.NewPath("spec", "namespaceSelector"))...)
+	}
+
+	return allErrs
+}
+
+// getClusterFloatingIPPoolWarnings warns about a pool without a namespace selector, which serves no IPAddressClaims.
+func getClusterFloatingIPPoolWarnings(spec *infrav1alpha2.OpenStackClusterFloatingIPPoolSpec) admission.Warnings {
+	if spec.NamespaceSelector == nil {
+		return admission.Warnings{"spec.namespaceSelector is not set, so the pool serves no IPAddressClaims; set it to {} to serve all namespaces"}
+	}
+	return nil
+}
+
+func castToOpenStackClusterFloatingIPPool(obj runtime.Object) (*infrav1alpha2.OpenStackClusterFloatingIPPool, error) {
+	cast, ok := obj.(*infrav1alpha2.OpenStackClusterFloatingIPPool)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpenStackClusterFloatingIPPool but got a %T", obj)
+	}
+	return cast, nil
+}
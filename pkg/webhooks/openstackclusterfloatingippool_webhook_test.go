@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+func TestOpenStackClusterFloatingIPPool_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     infrav1alpha2.OpenStackClusterFloatingIPPoolSpec
+		wantErr  bool
+		wantWarn bool
+	}{
+		{
+			name: "Identity with its namespace and a namespace selector are allowed",
+			spec: infrav1alpha2.OpenStackClusterFloatingIPPoolSpec{
+				OpenStackFloatingIPPoolSpec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+					IdentityRef: &infrav1.OpenStackIdentityReference{Name: "cloud-config", CloudName: "openstack"},
+				},
+				IdentityRefNamespace: "capo-system",
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"team": "a"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Identity without its namespace is rejected",
+			spec: infrav1alpha2.OpenStackClusterFloatingIPPoolSpec{
+				OpenStackFloatingIPPoolSpec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+					IdentityRef: &infrav1.OpenStackIdentityReference{Name: "cloud-config", CloudName: "openstack"},
+				},
+			},
+			wantErr:  true,
+			wantWarn: true,
+		},
+		{
+			name: "Empty namespace selector is allowed",
+			spec: infrav1alpha2.OpenStackClusterFloatingIPPoolSpec{
+				NamespaceSelector: &metav1.LabelSelector{},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Missing namespace selector is allowed with a warning",
+			spec:     infrav1alpha2.OpenStackClusterFloatingIPPoolSpec{},
+			wantErr:  false,
+			wantWarn: true,
+		},
+		{
+			name: "Invalid namespace selector is rejected",
+			spec: infrav1alpha2.OpenStackClusterFloatingIPPoolSpec{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: metav1.LabelSelectorOpIn}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid spec shared with OpenStackFloatingIPPool is rejected",
+			spec: infrav1alpha2.OpenStackClusterFloatingIPPoolSpec{
+				OpenStackFloatingIPPoolSpec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+					IPRanges: []infrav1alpha2.IPRange{{Start: "203.0.113.20", End: "203.0.113.10"}},
+				},
+			},
+			wantErr:  true,
+			wantWarn: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pool := &infrav1alpha2.OpenStackClusterFloatingIPPool{Spec: tt.spec}
+			webhook := &openStackClusterFloatingIPPoolWebhook{}
+			warn, err := webhook.ValidateCreate(context.TODO(), pool)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			if tt.wantWarn {
+				g.Expect(warn).NotTo(BeEmpty())
+			} else {
+				g.Expect(warn).To(BeEmpty())
+			}
+		})
+	}
+}
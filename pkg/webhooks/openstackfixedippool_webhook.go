@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1alpha1-openstackfixedippool,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=openstackfixedippools,versions=v1alpha1,name=validation.openstackfixedippool.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+func SetupOpenStackFixedIPPoolWebhook(mgr manager.Manager) error {
+	return builder.WebhookManagedBy(mgr).
+		For(&infrav1alpha1.OpenStackFixedIPPool{}).
+		WithValidator(&openStackFixedIPPoolWebhook{}).
+		Complete()
+}
+
+type openStackFixedIPPoolWebhook struct{}
+
+// Compile-time assertion that openStackFixedIPPoolWebhook implements webhook.CustomValidator.
+var _ webhook.CustomValidator = &openStackFixedIPPoolWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackFixedIPPoolWebhook) ValidateCreate(_ context.Context, objRaw runtime.Object) (admission.Warnings, error) {
+	newObj, err := castToOpenStackFixedIPPool(objRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	allErrs := validateFixedIPPoolSpec(&newObj.Spec)
+	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackFixedIPPoolWebhook) ValidateUpdate(_ context.Context, oldObjRaw, newObjRaw runtime.Object) (admission.Warnings, error) {
+	oldObj, err := castToOpenStackFixedIPPool(oldObjRaw)
+	if err != nil {
+		return nil, err
+	}
+	newObj, err := castToOpenStackFixedIPPool(newObjRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	allErrs := validateFixedIPPoolSpec(&newObj.Spec)
+
+	// The network and the subnet are resolved only once
+	if !reflect.DeepEqual(oldObj.Spec.Network, newObj.Spec.Network) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "network"), "cannot be modified"))
+	}
+	if !reflect.DeepEqual(oldObj.Spec.Subnet, newObj.Spec.Subnet) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "subnet"), "cannot be modified"))
+	}
+
+	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackFixedIPPoolWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateFixedIPPoolSpec checks that the IP ranges of the pool are valid and, if the subnet filter specifies a CIDR,
+// within the subnet of the pool.
+func validateFixedIPPoolSpec(spec *infrav1alpha1.OpenStackFixedIPPoolSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var ranges []addrRange
+	for i, ipRange := range spec.IPRanges {
+		r, errs := validateIPRange(field.NewPath("spec", "ipRanges").Index(i), ipRange.Start, ipRange.End)
+		allErrs = append(allErrs, errs...)
+		if r != nil {
+			ranges = append(ranges, *r)
+		}
+	}
+
+	if spec.Subnet.CIDR == "" {
+		return allErrs
+	}
+	prefix, err := netip.ParsePrefix(spec.Subnet.CIDR)
+	if err != nil {
+		return append(allErrs, field.Invalid(field.NewPath("spec", "subnet", "cidr"), spec.Subnet.CIDR, "must be a valid CIDR"))
+	}
+	for i, r := range ranges {
+		if !prefix.Contains(r.start) || !prefix.Contains(r.end) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "ipRanges").Index(i), fmt.Sprintf("%s-%s", r.start, r.end), "must be within the subnet of the pool"))
+		}
+	}
+
+	return allErrs
+}
+
+func castToOpenStackFixedIPPool(obj runtime.Object) (*infrav1alpha1.OpenStackFixedIPPool, error) {
+	cast, ok := obj.(*infrav1alpha1.OpenStackFixedIPPool)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpenStackFixedIPPool but got a %T", obj)
+	}
+	return cast, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
+)
+
+func TestOpenStackFixedIPPool_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    infrav1alpha1.OpenStackFixedIPPoolSpec
+		wantErr bool
+	}{
+		{
+			name: "IP ranges within the subnet CIDR are allowed",
+			spec: infrav1alpha1.OpenStackFixedIPPoolSpec{
+				Subnet:   infrav1alpha7.SubnetFilter{CIDR: "10.0.0.0/24"},
+				IPRanges: []infrav1alpha1.IPRange{{Start: "10.0.0.100", End: "10.0.0.199"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IP ranges are not checked against a subnet filter without CIDR",
+			spec: infrav1alpha1.OpenStackFixedIPPoolSpec{
+				Subnet:   infrav1alpha7.SubnetFilter{Name: "nodes"},
+				IPRanges: []infrav1alpha1.IPRange{{Start: "10.0.0.100", End: "10.0.0.199"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IP range ending before its start is rejected",
+			spec: infrav1alpha1.OpenStackFixedIPPoolSpec{
+				IPRanges: []infrav1alpha1.IPRange{{Start: "10.0.0.199", End: "10.0.0.100"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IP range outside of the subnet CIDR is rejected",
+			spec: infrav1alpha1.OpenStackFixedIPPoolSpec{
+				Subnet:   infrav1alpha7.SubnetFilter{CIDR: "10.0.0.0/24"},
+				IPRanges: []infrav1alpha1.IPRange{{Start: "10.0.0.100", End: "10.0.1.100"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid subnet CIDR is rejected",
+			spec: infrav1alpha1.OpenStackFixedIPPoolSpec{
+				Subnet: infrav1alpha7.SubnetFilter{CIDR: "10.0.0.0"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pool := &infrav1alpha1.OpenStackFixedIPPool{Spec: tt.spec}
+			webhook := &openStackFixedIPPoolWebhook{}
+			warn, err := webhook.ValidateCreate(context.TODO(), pool)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-openstackfloatingippool,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=openstackfloatingippools,versions=v1alpha2,name=validation.openstackfloatingippool.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+func SetupOpenStackFloatingIPPoolWebhook(mgr manager.Manager) error {
+	return builder.WebhookManagedBy(mgr).
+		For(&infrav1alpha2.OpenStackFloatingIPPool{}).
+		WithValidator(&openStackFloatingIPPoolWebhook{}).
+		Complete()
+}
+
+type openStackFloatingIPPoolWebhook struct{}
+
+// Compile-time assertion that openStackFloatingIPPoolWebhook implements webhook.CustomValidator.
+var _ webhook.CustomValidator = &openStackFloatingIPPoolWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackFloatingIPPoolWebhook) ValidateCreate(_ context.Context, objRaw runtime.Object) (admission.Warnings, error) {
+	newObj, err := castToOpenStackFloatingIPPool(objRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	allErrs := validateFloatingIPPoolSpec(&newObj.Spec)
+	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackFloatingIPPoolWebhook) ValidateUpdate(_ context.Context, _, newObjRaw runtime.Object) (admission.Warnings, error) {
+	newObj, err := castToOpenStackFloatingIPPool(newObjRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	allErrs := validateFloatingIPPoolSpec(&newObj.Spec)
+	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackFloatingIPPoolWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateFloatingIPPoolSpec checks that the IP ranges of the pool are valid, and that the pre-allocated floating ips
+// are within the IP ranges and the subnets of the pool. Subnets can only be checked if all subnet filters specify a
+// CIDR, otherwise the controller rejects pre-allocated floating ips outside of the subnets.
+func validateFloatingIPPoolSpec(spec *infrav1alpha2.OpenStackFloatingIPPoolSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var ranges []addrRange
+	for i, ipRange := range spec.IPRanges {
+		r, errs := validateIPRange(field.NewPath("spec", "ipRanges").Index(i), ipRange.Start, ipRange.End)
+		allErrs = append(allErrs, errs...)
+		if r != nil {
+			ranges = append(ranges, *r)
+		}
+	}
+
+	checkSubnets := len(spec.FloatingIPSubnets) > 0
+	var subnetPrefixes []netip.Prefix
+	for i, subnet := range spec.FloatingIPSubnets {
+		if subnet.CIDR == "" {
+			checkSubnets = false
+			continue
+		}
+		prefix, err := netip.ParsePrefix(subnet.CIDR)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "floatingIPSubnets").Index(i).Child("cidr"), subnet.CIDR, "must be a valid CIDR"))
+			checkSubnets = false
+			continue
+		}
+		subnetPrefixes = append(subnetPrefixes, prefix)
+	}
+
+	for i, ip := range spec.PreAllocatedFloatingIPs {
+		fldPath := field.NewPath("spec", "preAllocatedFloatingIPs").Index(i)
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, ip, "must be a valid IP address"))
+			continue
+		}
+
+		inRanges := len(spec.IPRanges) == 0
+		for _, r := range ranges {
+			if addr.Compare(r.start) >= 0 && addr.Compare(r.end) <= 0 {
+				inRanges = true
+				break
+			}
+		}
+		if !inRanges {
+			allErrs = append(allErrs, field.Invalid(fldPath, ip, "must be within the IP ranges of the pool"))
+			continue
+		}
+
+		inSubnets := !checkSubnets
+		for _, prefix := range subnetPrefixes {
+			if prefix.Contains(addr) {
+				inSubnets = true
+				break
+			}
+		}
+		if !inSubnets {
+			allErrs = append(allErrs, field.Invalid(fldPath, ip, "must be within the floating IP subnets of the pool"))
+		}
+	}
+
+	return allErrs
+}
+
+// addrRange is an inclusive range of IP addresses.
+type addrRange struct {
+	start, end netip.Addr
+}
+
+// validateIPRange checks that an IP range is valid, and returns the range if it is.
+func validateIPRange(fldPath *field.Path, startIP, endIP string) (*addrRange, field.ErrorList) {
+	start, err := netip.ParseAddr(startIP)
+	if err != nil {
+		return nil, field.ErrorList{field.Invalid(fldPath.Child("start"), startIP, "must be a valid IP address")}
+	}
+	end, err := netip.ParseAddr(endIP)
+	if err != nil {
+		return nil, field.ErrorList{field.Invalid(fldPath.Child("end"), endIP, "must be a valid IP address")}
+	}
+	if start.Is4() != end.Is4() {
+		return nil, field.ErrorList{field.Invalid(fldPath, fmt.Sprintf("%s-%s", startIP, endIP), "start and end must be of the same IP family")}
+	}
+	if start.Compare(end) > 0 {
+		return nil, field.ErrorList{field.Invalid(fldPath.Child("end"), endIP, "must not be lower than start")}
+	}
+	return &addrRange{start: start, end: end}, nil
+}
+
+func castToOpenStackFloatingIPPool(obj runtime.Object) (*infrav1alpha2.OpenStackFloatingIPPool, error) {
+	cast, ok := obj.(*infrav1alpha2.OpenStackFloatingIPPool)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpenStackFloatingIPPool but got a %T", obj)
+	}
+	return cast, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+func TestOpenStackFloatingIPPool_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    infrav1alpha2.OpenStackFloatingIPPoolSpec
+		wantErr bool
+	}{
+		{
+			name: "IP ranges and pre-allocated floating IPs within them are allowed",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				IPRanges:                []infrav1alpha2.IPRange{{Start: "203.0.113.10", End: "203.0.113.20"}},
+				PreAllocatedFloatingIPs: []string{"203.0.113.10", "203.0.113.20"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IP range with an invalid address is rejected",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				IPRanges: []infrav1alpha2.IPRange{{Start: "203.0.113.10", End: "203.0.113"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IP range with mixed IP families is rejected",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				IPRanges: []infrav1alpha2.IPRange{{Start: "203.0.113.10", End: "2001:db8::10"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IP range ending before its start is rejected",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				IPRanges: []infrav1alpha2.IPRange{{Start: "203.0.113.20", End: "203.0.113.10"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Pre-allocated floating IP outside of the IP ranges is rejected",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				IPRanges:                []infrav1alpha2.IPRange{{Start: "203.0.113.10", End: "203.0.113.20"}},
+				PreAllocatedFloatingIPs: []string{"203.0.113.21"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Pre-allocated floating IP outside of the subnet CIDRs is rejected",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				FloatingIPSubnets:       []infrav1.SubnetFilter{{CIDR: "203.0.113.0/26"}},
+				PreAllocatedFloatingIPs: []string{"203.0.113.64"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Pre-allocated floating IP is not checked against subnet filters without CIDR",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				FloatingIPSubnets:       []infrav1.SubnetFilter{{CIDR: "203.0.113.0/26"}, {Name: "partner"}},
+				PreAllocatedFloatingIPs: []string{"198.51.100.10"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid pre-allocated floating IP is rejected",
+			spec: infrav1alpha2.OpenStackFloatingIPPoolSpec{
+				PreAllocatedFloatingIPs: []string{"not-an-ip"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pool := &infrav1alpha2.OpenStackFloatingIPPool{Spec: tt.spec}
+			webhook := &openStackFloatingIPPoolWebhook{}
+			warn, err := webhook.ValidateCreate(context.TODO(), pool)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
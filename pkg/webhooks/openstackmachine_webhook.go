@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
 
 // +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-openstackmachine,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=openstackmachines,versions=v1beta1,name=validation.openstackmachine.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
@@ -62,6 +63,10 @@ func (*openStackMachineWebhook) ValidateCreate(_ context.Context, objRaw runtime
 		}
 	}
 
+	allErrs = append(allErrs, validateAdditionalBlockDevices(field.NewPath("spec", "additionalBlockDevices"), newObj.Spec.AdditionalBlockDevices)...)
+
+	allErrs = append(allErrs, validatePortFixedIPs(field.NewPath("spec", "ports"), newObj.Spec.Ports, true)...)
+
 	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
 }
 
@@ -87,6 +92,8 @@ func (*openStackMachineWebhook) ValidateUpdate(_ context.Context, oldObjRaw, new
 
 	var allErrs field.ErrorList
 
+	allErrs = append(allErrs, validatePortFixedIPs(field.NewPath("spec", "ports"), newObj.Spec.Ports, true)...)
+
 	newOpenStackMachineSpec := newOpenStackMachine["spec"].(map[string]interface{})
 	oldOpenStackMachineSpec := oldOpenStackMachine["spec"].(map[string]interface{})
 
@@ -118,6 +125,73 @@ func (*openStackMachineWebhook) ValidateDelete(_ context.Context, _ runtime.Obje
 	return nil, nil
 }
 
+// validateAdditionalBlockDevices checks that existing volumes are referenced by block devices of type ExistingVolume only,
+// and that their name templates can be rendered without the machine name.
+func validateAdditionalBlockDevices(fldPath *field.Path, blockDevices []infrav1.AdditionalBlockDevice) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, blockDevice := range blockDevices {
+		fldPath := fldPath.Index(i).Child("storage", "existingVolume")
+		existingVolume := blockDevice.Storage.ExistingVolume
+		if blockDevice.Storage.Type != infrav1.ExistingVolumeBlockDevice {
+			if existingVolume != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("can only be set when type is %s", infrav1.ExistingVolumeBlockDevice)))
+			}
+			continue
+		}
+		if existingVolume == nil {
+			allErrs = append(allErrs, field.Required(fldPath, fmt.Sprintf("must be set when type is %s", infrav1.ExistingVolumeBlockDevice)))
+			continue
+		}
+
+		if existingVolume.NameTemplate != nil {
+			data := names.ResourceNameData{
+				ClusterNamespace: "namespace",
+				ClusterName:      "cluster",
+				Role:             blockDevice.Name,
+				FailureDomain:    "failure-domain",
+			}
+			name, err := names.GetResourceName(existingVolume.NameTemplate, data, "")
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("nameTemplate"), *existingVolume.NameTemplate, err.Error()))
+				continue
+			}
+			// The machine name changes when the machine is replaced, so the volume could never be reattached
+			data.MachineName = "machine"
+			if otherName, err := names.GetResourceName(existingVolume.NameTemplate, data, ""); err != nil || otherName != name {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("nameTemplate"), *existingVolume.NameTemplate, "must not use .MachineName"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validatePortFixedIPs checks that the fixed IPs of the ports do not set both an address and an IP address pool.
+// Addresses are only claimed from IP address pools for OpenStackMachines, so IP address pools are rejected
+// unless allowIPAddressPool is set.
+func validatePortFixedIPs(fldPath *field.Path, ports []infrav1.PortOpts, allowIPAddressPool bool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, port := range ports {
+		for j, fixedIP := range port.FixedIPs {
+			if fixedIP.IPAddressPoolRef == nil {
+				continue
+			}
+			fldPath := fldPath.Index(i).Child("fixedIPs").Index(j)
+			if !allowIPAddressPool {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipAddressPoolRef"), "is only supported by OpenStackMachines and OpenStackMachineTemplates"))
+				continue
+			}
+			if fixedIP.IPAddress != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath, "cannot set both ipAddress and ipAddressPoolRef"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func castToOpenStackMachine(obj runtime.Object) (*infrav1.OpenStackMachine, error) {
 	cast, ok := obj.(*infrav1.OpenStackMachine)
 	if !ok {
@@ -0,0 +1,84 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-openstackmachinepool,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=openstackmachinepools,versions=v1beta1,name=validation.openstackmachinepool.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+func SetupOpenStackMachinePoolWebhook(mgr manager.Manager) error {
+	return builder.WebhookManagedBy(mgr).
+		For(&infrav1.OpenStackMachinePool{}).
+		WithValidator(&openStackMachinePoolWebhook{}).
+		Complete()
+}
+
+type openStackMachinePoolWebhook struct{}
+
+// Compile-time assertion that openStackMachinePoolWebhook implements webhook.CustomValidator.
+var _ webhook.CustomValidator = &openStackMachinePoolWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackMachinePoolWebhook) ValidateCreate(_ context.Context, objRaw runtime.Object) (admission.Warnings, error) {
+	newObj, err := castToOpenStackMachinePool(objRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, validateOpenStackMachinePool(newObj))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackMachinePoolWebhook) ValidateUpdate(_ context.Context, _, newObjRaw runtime.Object) (admission.Warnings, error) {
+	newObj, err := castToOpenStackMachinePool(newObjRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	// The template can be changed, in which case the servers of the pool are replaced.
+	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, validateOpenStackMachinePool(newObj))
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (*openStackMachinePoolWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateOpenStackMachinePool checks the template of the servers of the pool.
+func validateOpenStackMachinePool(pool *infrav1.OpenStackMachinePool) field.ErrorList {
+	return validatePortFixedIPs(field.NewPath("spec", "template", "ports"), pool.Spec.Template.Ports, false)
+}
+
+func castToOpenStackMachinePool(obj runtime.Object) (*infrav1.OpenStackMachinePool, error) {
+	cast, ok := obj.(*infrav1.OpenStackMachinePool)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpenStackMachinePool but got a %T", obj)
+	}
+	return cast, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
+)
+
+func TestOpenStackMachinePool_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		ports   []infrav1.PortOpts
+		wantErr bool
+	}{
+		{
+			name: "Fixed IP with an address is allowed",
+			ports: []infrav1.PortOpts{{
+				FixedIPs: []infrav1.FixedIP{{IPAddress: pointer.String("10.0.0.10")}},
+			}},
+			wantErr: false,
+		},
+		{
+			name: "Fixed IP from an IP address pool is rejected",
+			ports: []infrav1.PortOpts{{
+				FixedIPs: []infrav1.FixedIP{{
+					IPAddressPoolRef: &corev1.TypedLocalObjectReference{Kind: "OpenStackFixedIPPool", Name: "pool"},
+				}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pool := &infrav1.OpenStackMachinePool{
+				Spec: infrav1.OpenStackMachinePoolSpec{
+					Template: infrav1.OpenStackMachineSpec{
+						Flavor: "foo",
+						Image:  infrav1.ImageFilter{Name: pointer.String("bar")},
+						Ports:  tt.ports,
+					},
+				},
+			}
+			webhook := &openStackMachinePoolWebhook{}
+
+			warn, err := webhook.ValidateCreate(context.TODO(), pool)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(warn).To(BeEmpty())
+
+			warn, err = webhook.ValidateUpdate(context.TODO(), pool, pool)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
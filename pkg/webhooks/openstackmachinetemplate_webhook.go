@@ -60,6 +60,9 @@ func (*openStackMachineTemplateWebhook) ValidateCreate(_ context.Context, objRaw
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "providerID"), "cannot be set in templates"))
 	}
 
+	allErrs = append(allErrs, validateAdditionalBlockDevices(field.NewPath("spec", "template", "spec", "additionalBlockDevices"), newObj.Spec.Template.Spec.AdditionalBlockDevices)...)
+	allErrs = append(allErrs, validatePortFixedIPs(field.NewPath("spec", "template", "spec", "ports"), newObj.Spec.Template.Spec.Ports, true)...)
+
 	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
 }
 
@@ -81,6 +84,8 @@ func (*openStackMachineTemplateWebhook) ValidateUpdate(ctx context.Context, oldO
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a admission.Request inside context: %v", err))
 	}
 
+	allErrs = append(allErrs, validatePortFixedIPs(field.NewPath("spec", "template", "spec", "ports"), newObj.Spec.Template.Spec.Ports, true)...)
+
 	if !topology.ShouldSkipImmutabilityChecks(req, newObj) &&
 		!reflect.DeepEqual(newObj.Spec.Template.Spec, oldObj.Spec.Template.Spec) {
 		allErrs = append(allErrs,
@@ -22,6 +22,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -170,3 +171,140 @@ func TestOpenStackMachineTemplate_ValidateUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenStackMachineTemplate_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name         string
+		blockDevices []infrav1.AdditionalBlockDevice
+		ports        []infrav1.PortOpts
+		wantErr      bool
+	}{
+		{
+			name: "Existing volume referenced by name template is allowed",
+			blockDevices: []infrav1.AdditionalBlockDevice{{
+				Name:    "etcd",
+				SizeGiB: 10,
+				Storage: infrav1.BlockDeviceStorage{
+					Type: infrav1.ExistingVolumeBlockDevice,
+					ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+						NameTemplate: pointer.String("{{ .ClusterName }}-{{ .Role }}"),
+					},
+				},
+			}},
+			wantErr: false,
+		},
+		{
+			name: "Existing volume with a name template using the failure domain is allowed",
+			blockDevices: []infrav1.AdditionalBlockDevice{{
+				Name:    "etcd",
+				SizeGiB: 10,
+				Storage: infrav1.BlockDeviceStorage{
+					Type: infrav1.ExistingVolumeBlockDevice,
+					ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+						NameTemplate: pointer.String("{{ .ClusterName }}-{{ .Role }}-{{ .FailureDomain }}"),
+					},
+				},
+			}},
+			wantErr: false,
+		},
+		{
+			name: "Existing volume with a name template using the machine name is rejected",
+			blockDevices: []infrav1.AdditionalBlockDevice{{
+				Name:    "etcd",
+				SizeGiB: 10,
+				Storage: infrav1.BlockDeviceStorage{
+					Type: infrav1.ExistingVolumeBlockDevice,
+					ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+						NameTemplate: pointer.String("{{ .MachineName }}-{{ .Role }}"),
+					},
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Existing volume with an invalid name template is rejected",
+			blockDevices: []infrav1.AdditionalBlockDevice{{
+				Name:    "etcd",
+				SizeGiB: 10,
+				Storage: infrav1.BlockDeviceStorage{
+					Type: infrav1.ExistingVolumeBlockDevice,
+					ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+						NameTemplate: pointer.String("{{ .ClusterName"),
+					},
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Existing volume block device without existing volume is rejected",
+			blockDevices: []infrav1.AdditionalBlockDevice{{
+				Name:    "etcd",
+				SizeGiB: 10,
+				Storage: infrav1.BlockDeviceStorage{
+					Type: infrav1.ExistingVolumeBlockDevice,
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Existing volume on a volume block device is rejected",
+			blockDevices: []infrav1.AdditionalBlockDevice{{
+				Name:    "etcd",
+				SizeGiB: 10,
+				Storage: infrav1.BlockDeviceStorage{
+					Type: infrav1.VolumeBlockDevice,
+					ExistingVolume: &infrav1.BlockDeviceExistingVolume{
+						Filter: &infrav1.VolumeFilter{Name: "etcd"},
+					},
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Fixed IP from an IP address pool is allowed",
+			ports: []infrav1.PortOpts{{
+				FixedIPs: []infrav1.FixedIP{{
+					IPAddressPoolRef: &corev1.TypedLocalObjectReference{Kind: "OpenStackFixedIPPool", Name: "pool"},
+				}},
+			}},
+			wantErr: false,
+		},
+		{
+			name: "Fixed IP with both an address and an IP address pool is rejected",
+			ports: []infrav1.PortOpts{{
+				FixedIPs: []infrav1.FixedIP{{
+					IPAddress:        pointer.String("10.0.0.10"),
+					IPAddressPoolRef: &corev1.TypedLocalObjectReference{Kind: "OpenStackFixedIPPool", Name: "pool"},
+				}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			template := &infrav1.OpenStackMachineTemplate{
+				Spec: infrav1.OpenStackMachineTemplateSpec{
+					Template: infrav1.OpenStackMachineTemplateResource{
+						Spec: infrav1.OpenStackMachineSpec{
+							Flavor:                 "foo",
+							Image:                  infrav1.ImageFilter{Name: pointer.String("bar")},
+							AdditionalBlockDevices: tt.blockDevices,
+							Ports:                  tt.ports,
+						},
+					},
+				},
+			}
+			webhook := &openStackMachineTemplateWebhook{}
+			warn, err := webhook.ValidateCreate(context.TODO(), template)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
 )
 
@@ -39,6 +40,10 @@ func RegisterAllWithManager(mgr manager.Manager) []error {
 		{"OpenStackClusterTemplate", SetupOpenStackClusterTemplateWebhook},
 		{"OpenStackMachine", SetupOpenStackMachineWebhook},
 		{"OpenStackMachineTemplate", SetupOpenStackMachineTemplateWebhook},
+		{"OpenStackMachinePool", SetupOpenStackMachinePoolWebhook},
+		{"OpenStackFloatingIPPool", SetupOpenStackFloatingIPPoolWebhook},
+		{"OpenStackClusterFloatingIPPool", SetupOpenStackClusterFloatingIPPoolWebhook},
+		{"OpenStackFixedIPPool", SetupOpenStackFixedIPPoolWebhook},
 	} {
 		if err := webhook.setup(mgr); err != nil {
 			errs = append(errs, fmt.Errorf("creating webhook for %s: %v", webhook.name, err))
@@ -51,6 +56,7 @@ func RegisterAllWithManager(mgr manager.Manager) []error {
 		&infrav1.OpenStackClusterTemplateList{},
 		&infrav1.OpenStackMachineList{},
 		&infrav1.OpenStackMachineTemplateList{},
+		&infrav1alpha2.OpenStackFloatingIPPoolList{},
 	} {
 		if err := builder.WebhookManagedBy(mgr).
 			For(conversionOnlyType).
@@ -219,4 +219,21 @@ var _ = Describe("Filter API validations", func() {
 		}
 		Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "OpenStackMachine creation should succeed")
 	})
+
+	It("should allow ImageRef of ImageFilter to be set", func() {
+		By("Creating a machine")
+		machine.Spec.Image = infrav1.ImageFilter{
+			ImageRef: &infrav1.ResourceReference{Name: "ubuntu"},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "OpenStackMachine creation should succeed")
+	})
+
+	It("should not allow both ImageRef and Name of ImageFilter to be set", func() {
+		By("Creating a machine")
+		machine.Spec.Image = infrav1.ImageFilter{
+			ImageRef: &infrav1.ResourceReference{Name: "ubuntu"},
+			Name:     pointer.String("bar"),
+		}
+		Expect(k8sClient.Create(ctx, machine)).NotTo(Succeed(), "OpenStackMachine creation should fail")
+	})
 })
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1"
@@ -64,6 +65,36 @@ var _ = Describe("OpenStackCluster API validations", func() {
 		Expect(k8sClient.Create(ctx, cluster)).To(Succeed(), "OpenStackCluster creation should succeed")
 	})
 
+	It("should require exactly one of cidr and subnetPool in managedSubnets", func() {
+		By("Creating a cluster with neither cidr nor subnetPool")
+		cluster.Spec.ManagedSubnets = []infrav1.SubnetSpec{{}}
+		Expect(k8sClient.Create(ctx, cluster)).NotTo(Succeed(), "OpenStackCluster creation should fail")
+
+		By("Creating a cluster with both cidr and subnetPool")
+		cluster.Spec.ManagedSubnets = []infrav1.SubnetSpec{{CIDR: "10.0.0.0/24", SubnetPool: &infrav1.SubnetPoolFilter{Name: "pool"}}}
+		Expect(k8sClient.Create(ctx, cluster)).NotTo(Succeed(), "OpenStackCluster creation should fail")
+
+		By("Creating a cluster with a prefixLength and no subnetPool")
+		cluster.Spec.ManagedSubnets = []infrav1.SubnetSpec{{CIDR: "10.0.0.0/24", PrefixLength: pointer.Int(24)}}
+		Expect(k8sClient.Create(ctx, cluster)).NotTo(Succeed(), "OpenStackCluster creation should fail")
+
+		By("Creating a cluster with a subnetPool and a prefixLength")
+		cluster.Spec.ManagedSubnets = []infrav1.SubnetSpec{{SubnetPool: &infrav1.SubnetPoolFilter{Name: "pool"}, PrefixLength: pointer.Int(24)}}
+		Expect(k8sClient.Create(ctx, cluster)).To(Succeed(), "OpenStackCluster creation should succeed")
+	})
+
+	It("should reject a segmentationID for flat managed networks", func() {
+		By("Creating a cluster with a flat provider network and a segmentationID")
+		cluster.Spec.ManagedNetwork = &infrav1.ManagedNetworkOptions{
+			Provider: &infrav1.ProviderNetwork{NetworkType: "flat", PhysicalNetwork: "physnet1", SegmentationID: pointer.Int(100)},
+		}
+		Expect(k8sClient.Create(ctx, cluster)).NotTo(Succeed(), "OpenStackCluster creation should fail")
+
+		By("Creating a cluster with a vlan provider network and a segmentationID")
+		cluster.Spec.ManagedNetwork.Provider.NetworkType = "vlan"
+		Expect(k8sClient.Create(ctx, cluster)).To(Succeed(), "OpenStackCluster creation should succeed")
+	})
+
 	It("should default enabled to true if APIServerLoadBalancer is specified without enabled=true", func() {
 		cluster.Spec.APIServerLoadBalancer = &infrav1.APIServerLoadBalancer{}
 		Expect(k8sClient.Create(ctx, cluster)).To(Succeed(), "OpenStackCluster creation should succeed")
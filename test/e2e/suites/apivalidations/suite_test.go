@@ -39,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1alpha2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha2"
 	infrav1alpha5 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha5"
 	infrav1alpha6 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha6"
 	infrav1alpha7 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha7"
@@ -89,6 +90,7 @@ var _ = BeforeSuite(func() {
 	testScheme = scheme.Scheme
 	for _, f := range []func(*runtime.Scheme) error{
 		infrav1alpha1.AddToScheme,
+		infrav1alpha2.AddToScheme,
 		infrav1alpha5.AddToScheme,
 		infrav1alpha6.AddToScheme,
 		infrav1alpha7.AddToScheme,